@@ -0,0 +1,43 @@
+module gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/cmd
+
+go 1.17
+
+require (
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/ctl v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/http v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/rpc v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/sdlgo v0.8.0
+	google.golang.org/grpc v1.32.0
+)
+
+require (
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common v1.2.7 // indirect
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/consistency v1.2.7 // indirect
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities v1.2.7 // indirect
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/snapshot v1.2.7 // indirect
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/topology v1.2.7 // indirect
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer v1.2.7 // indirect
+)
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common => ../common
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/consistency => ../consistency
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/ctl => ../ctl
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities => ../entities
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/http => ../http
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader => ../reader
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/rpc => ../rpc
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/snapshot => ../snapshot
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/topology => ../topology
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer => ../writer
+
+replace gerrit.o-ran-sc.org/r/ric-plt/sdlgo => gerrit.o-ran-sc.org/r/ric-plt/sdlgo.git v0.8.0
@@ -0,0 +1,47 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package main
+
+import (
+	"flag"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/rpc"
+	"gerrit.o-ran-sc.org/r/ric-plt/sdlgo"
+	"google.golang.org/grpc"
+	"log"
+	"net"
+)
+
+func main() {
+	addr := flag.String("addr", ":50051", "address the gRPC server listens on")
+	flag.Parse()
+	sdl := sdlgo.NewSyncStorage()
+	defer func() {
+		_ = sdl.Close()
+	}()
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("#rnib-grpc - failed to listen on %s: %s", *addr, err)
+	}
+	server := grpc.NewServer()
+	rpc.RegisterRNibServiceServer(server, rpc.GetNewContextRNibServer(reader.GetNewContextRNibReader(sdl), sdl))
+	log.Printf("#rnib-grpc - listening on %s", *addr)
+	log.Fatal(server.Serve(listener))
+}
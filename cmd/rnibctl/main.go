@@ -0,0 +1,47 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package main
+
+import (
+	"fmt"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/ctl"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"gerrit.o-ran-sc.org/r/ric-plt/sdlgo"
+	"os"
+)
+
+func main() {
+	sdl := sdlgo.NewSyncStorage()
+	defer func() {
+		_ = sdl.Close()
+	}()
+	env := &ctl.Environment{
+		Reader:  reader.GetNewRNibReader(sdl),
+		Storage: sdl,
+		In:      os.Stdin,
+		Out:     os.Stdout,
+		Err:     os.Stderr,
+	}
+	err := ctl.Run(env, os.Args[1:])
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,47 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package common
+
+/*
+ContextError is returned when an operation is abandoned because its context was cancelled or its deadline exceeded
+*/
+type ContextError struct {
+	Err error
+}
+
+func NewContextError(error error) error {
+	return &ContextError{Err: error}
+}
+
+func (e ContextError) Error() string {
+	return e.Err.Error()
+}
+
+func (e ContextError) Unwrap() error {
+	return e.Err
+}
+
+func (e ContextError) Code() ErrorCode {
+	return Timeout
+}
+
+func (e ContextError) Is(target error) bool {
+	return isErrorCode(Timeout, target)
+}
@@ -0,0 +1,86 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package common
+
+import "errors"
+
+/*
+ErrorCode classifies the errors returned by rNib, so callers can branch on the kind of failure
+*/
+type ErrorCode int
+
+const (
+	Internal ErrorCode = iota
+	NotFound
+	Validation
+	StorageUnavailable
+	CorruptData
+	Timeout
+)
+
+var errorCodeNames = map[ErrorCode]string{
+	Internal:           "internal error",
+	NotFound:           "not found",
+	Validation:         "validation error",
+	StorageUnavailable: "storage unavailable",
+	CorruptData:        "corrupt data",
+	Timeout:            "timeout",
+}
+
+func (c ErrorCode) String() string {
+	if name, ok := errorCodeNames[c]; ok {
+		return name
+	}
+	return errorCodeNames[Internal]
+}
+
+/*
+Sentinel errors, one per error code, e.g. errors.Is(err, common.ErrNotFound)
+*/
+var (
+	ErrInternal           error = &codeError{code: Internal}
+	ErrNotFound           error = &codeError{code: NotFound}
+	ErrValidation         error = &codeError{code: Validation}
+	ErrStorageUnavailable error = &codeError{code: StorageUnavailable}
+	ErrCorruptData        error = &codeError{code: CorruptData}
+	ErrTimeout            error = &codeError{code: Timeout}
+)
+
+type codeError struct {
+	code ErrorCode
+}
+
+func (e *codeError) Error() string {
+	return e.code.String()
+}
+
+//GetErrorCode returns the code of the first rNib error in the chain of err, or Internal when there is none
+func GetErrorCode(err error) ErrorCode {
+	var coded interface{ Code() ErrorCode }
+	if errors.As(err, &coded) {
+		return coded.Code()
+	}
+	return Internal
+}
+
+func isErrorCode(code ErrorCode, target error) bool {
+	sentinel, ok := target.(*codeError)
+	return ok && sentinel.code == code
+}
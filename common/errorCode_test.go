@@ -0,0 +1,83 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestErrorCodes(t *testing.T) {
+	sdlErr := errors.New("connection refused")
+	tests := []struct {
+		err      error
+		code     ErrorCode
+		sentinel error
+	}{
+		{NewInternalError(sdlErr), Internal, ErrInternal},
+		{NewStorageUnavailableError(sdlErr), StorageUnavailable, ErrStorageUnavailable},
+		{NewCorruptDataError("*entities.NodebInfo", "RAN:gnb_1", sdlErr), CorruptData, ErrCorruptData},
+		{NewResourceNotFoundError("not found"), NotFound, ErrNotFound},
+		{NewResourceNotFoundErrorForKey("*entities.NodebInfo", "RAN:gnb_1", "%s not found", "gnb_1"), NotFound, ErrNotFound},
+		{NewValidationError("invalid"), Validation, ErrValidation},
+		{NewContextError(context.DeadlineExceeded), Timeout, ErrTimeout},
+	}
+	sentinels := []error{ErrInternal, ErrNotFound, ErrValidation, ErrStorageUnavailable, ErrCorruptData, ErrTimeout}
+	for _, test := range tests {
+		assert.Equal(t, test.code, GetErrorCode(test.err))
+		wrapped := fmt.Errorf("wrapped: %w", test.err)
+		assert.Equal(t, test.code, GetErrorCode(wrapped))
+		for _, sentinel := range sentinels {
+			assert.Equal(t, sentinel == test.sentinel, errors.Is(wrapped, sentinel), "%s is %s", test.err, sentinel)
+		}
+	}
+}
+
+func TestErrorCodeString(t *testing.T) {
+	assert.Equal(t, "not found", NotFound.String())
+	assert.Equal(t, "internal error", ErrorCode(100).String())
+	assert.Equal(t, "storage unavailable", ErrStorageUnavailable.Error())
+}
+
+func TestGetErrorCodeOfForeignError(t *testing.T) {
+	assert.Equal(t, Internal, GetErrorCode(errors.New("error")))
+}
+
+func TestUnwrap(t *testing.T) {
+	sdlErr := errors.New("connection refused")
+	assert.True(t, errors.Is(NewStorageUnavailableError(sdlErr), sdlErr))
+	assert.True(t, errors.Is(NewContextError(context.Canceled), context.Canceled))
+}
+
+func TestErrorsAs(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", NewCorruptDataError("*entities.NodebInfo", "RAN:gnb_1", errors.New("proto: bad wiretype")))
+	var internalError *InternalError
+	assert.True(t, errors.As(err, &internalError))
+	assert.Equal(t, "*entities.NodebInfo", internalError.EntityKind)
+	assert.Equal(t, "RAN:gnb_1", internalError.Key)
+	err = NewResourceNotFoundErrorForKey("*entities.Cell", "PCI:gnb_1:01", "cell not found")
+	var notFoundError *ResourceNotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
+	assert.Equal(t, "PCI:gnb_1:01", notFoundError.Key)
+	assert.Equal(t, "cell not found", notFoundError.Error())
+}
@@ -3,6 +3,7 @@ module gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common
 go 1.17
 
 require (
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities v1.2.7
 	github.com/pkg/errors v0.8.1
 	github.com/stretchr/testify v1.3.0
 )
@@ -10,4 +11,7 @@ require (
 require (
 	github.com/davecgh/go-spew v1.1.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	google.golang.org/protobuf v1.23.0 // indirect
 )
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities => ../entities
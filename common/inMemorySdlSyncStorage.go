@@ -0,0 +1,429 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+/*
+InMemorySdlSyncStorage is an ISdlSyncStorage keeping all namespaces in process memory.
+It follows the semantics of the redis backed SDL storage: values are read back as strings, groups are sets of strings
+sharing the key space with the values, and published events are delivered asynchronously to the subscribed callbacks.
+*/
+type InMemorySdlSyncStorage struct {
+	mutex         sync.Mutex
+	namespaces    map[string]*inMemoryNamespace
+	subscriptions map[string][]*inMemorySubscription
+}
+
+type inMemoryNamespace struct {
+	values map[string]string
+	groups map[string]map[string]struct{}
+}
+
+type inMemorySubscription struct {
+	cb     func(string, ...string)
+	mutex  sync.Mutex
+	cond   *sync.Cond
+	queue  [][]string
+	closed bool
+}
+
+//NewInMemorySdlSyncStorage returns an empty in-memory storage
+func NewInMemorySdlSyncStorage() *InMemorySdlSyncStorage {
+	return &InMemorySdlSyncStorage{
+		namespaces:    map[string]*inMemoryNamespace{},
+		subscriptions: map[string][]*inMemorySubscription{},
+	}
+}
+
+func (s *InMemorySdlSyncStorage) SubscribeChannel(ns string, cb func(string, ...string), channels ...string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, channel := range channels {
+		subscription := &inMemorySubscription{cb: cb}
+		subscription.cond = sync.NewCond(&subscription.mutex)
+		name := buildChannelName(ns, channel)
+		s.subscriptions[name] = append(s.subscriptions[name], subscription)
+		go subscription.deliver()
+	}
+	return nil
+}
+
+func (s *InMemorySdlSyncStorage) UnsubscribeChannel(ns string, channels ...string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, channel := range channels {
+		name := buildChannelName(ns, channel)
+		for _, subscription := range s.subscriptions[name] {
+			subscription.close()
+		}
+		delete(s.subscriptions, name)
+	}
+	return nil
+}
+
+func (s *InMemorySdlSyncStorage) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for name, subscriptions := range s.subscriptions {
+		for _, subscription := range subscriptions {
+			subscription.close()
+		}
+		delete(s.subscriptions, name)
+	}
+	return nil
+}
+
+func (s *InMemorySdlSyncStorage) SetAndPublish(ns string, channelsAndEvents []string, pairs ...interface{}) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := validateChannelsAndEvents(channelsAndEvents); err != nil {
+		return err
+	}
+	if err := s.set(ns, pairs); err != nil {
+		return err
+	}
+	s.publish(ns, channelsAndEvents)
+	return nil
+}
+
+func (s *InMemorySdlSyncStorage) Set(ns string, pairs ...interface{}) error {
+	return s.SetAndPublish(ns, nil, pairs...)
+}
+
+func (s *InMemorySdlSyncStorage) Get(ns string, keys []string) (map[string]interface{}, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	data := make(map[string]interface{}, len(keys))
+	namespace := s.namespace(ns)
+	for _, key := range keys {
+		if value, ok := namespace.values[key]; ok {
+			data[key] = value
+		} else {
+			data[key] = nil
+		}
+	}
+	return data, nil
+}
+
+func (s *InMemorySdlSyncStorage) SetIfAndPublish(ns string, channelsAndEvents []string, key string, oldData, newData interface{}) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := validateChannelsAndEvents(channelsAndEvents); err != nil {
+		return false, err
+	}
+	namespace := s.namespace(ns)
+	value, ok := namespace.values[key]
+	if !ok || value != toStorageString(oldData) {
+		return false, nil
+	}
+	namespace.values[key] = toStorageString(newData)
+	s.publish(ns, channelsAndEvents)
+	return true, nil
+}
+
+func (s *InMemorySdlSyncStorage) SetIf(ns string, key string, oldData, newData interface{}) (bool, error) {
+	return s.SetIfAndPublish(ns, nil, key, oldData, newData)
+}
+
+func (s *InMemorySdlSyncStorage) SetIfNotExistsAndPublish(ns string, channelsAndEvents []string, key string, data interface{}) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := validateChannelsAndEvents(channelsAndEvents); err != nil {
+		return false, err
+	}
+	namespace := s.namespace(ns)
+	if namespace.exists(key) {
+		return false, nil
+	}
+	namespace.values[key] = toStorageString(data)
+	s.publish(ns, channelsAndEvents)
+	return true, nil
+}
+
+func (s *InMemorySdlSyncStorage) SetIfNotExists(ns string, key string, data interface{}) (bool, error) {
+	return s.SetIfNotExistsAndPublish(ns, nil, key, data)
+}
+
+func (s *InMemorySdlSyncStorage) RemoveAndPublish(ns string, channelsAndEvents []string, keys []string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := validateChannelsAndEvents(channelsAndEvents); err != nil {
+		return err
+	}
+	namespace := s.namespace(ns)
+	for _, key := range keys {
+		namespace.remove(key)
+	}
+	s.publish(ns, channelsAndEvents)
+	return nil
+}
+
+func (s *InMemorySdlSyncStorage) Remove(ns string, keys []string) error {
+	return s.RemoveAndPublish(ns, nil, keys)
+}
+
+func (s *InMemorySdlSyncStorage) RemoveIfAndPublish(ns string, channelsAndEvents []string, key string, data interface{}) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := validateChannelsAndEvents(channelsAndEvents); err != nil {
+		return false, err
+	}
+	namespace := s.namespace(ns)
+	value, ok := namespace.values[key]
+	if !ok || value != toStorageString(data) {
+		return false, nil
+	}
+	delete(namespace.values, key)
+	s.publish(ns, channelsAndEvents)
+	return true, nil
+}
+
+func (s *InMemorySdlSyncStorage) RemoveIf(ns string, key string, data interface{}) (bool, error) {
+	return s.RemoveIfAndPublish(ns, nil, key, data)
+}
+
+func (s *InMemorySdlSyncStorage) GetAll(ns string) ([]string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	namespace := s.namespace(ns)
+	keys := make([]string, 0, len(namespace.values)+len(namespace.groups))
+	for key := range namespace.values {
+		keys = append(keys, key)
+	}
+	for group := range namespace.groups {
+		keys = append(keys, group)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *InMemorySdlSyncStorage) RemoveAll(ns string) error {
+	return s.RemoveAllAndPublish(ns, nil)
+}
+
+func (s *InMemorySdlSyncStorage) RemoveAllAndPublish(ns string, channelsAndEvents []string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := validateChannelsAndEvents(channelsAndEvents); err != nil {
+		return err
+	}
+	delete(s.namespaces, ns)
+	s.publish(ns, channelsAndEvents)
+	return nil
+}
+
+func (s *InMemorySdlSyncStorage) AddMember(ns string, group string, member ...interface{}) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	namespace := s.namespace(ns)
+	if _, ok := namespace.values[group]; ok {
+		return fmt.Errorf("key %s does not hold a group", group)
+	}
+	members := namespace.groups[group]
+	if members == nil {
+		members = map[string]struct{}{}
+		namespace.groups[group] = members
+	}
+	for _, m := range flatten(member) {
+		members[toStorageString(m)] = struct{}{}
+	}
+	return nil
+}
+
+func (s *InMemorySdlSyncStorage) RemoveMember(ns string, group string, member ...interface{}) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	namespace := s.namespace(ns)
+	members := namespace.groups[group]
+	for _, m := range flatten(member) {
+		delete(members, toStorageString(m))
+	}
+	if members != nil && len(members) == 0 {
+		delete(namespace.groups, group)
+	}
+	return nil
+}
+
+func (s *InMemorySdlSyncStorage) RemoveGroup(ns string, group string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.namespace(ns).groups, group)
+	return nil
+}
+
+func (s *InMemorySdlSyncStorage) GetMembers(ns string, group string) ([]string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	members := s.namespace(ns).groups[group]
+	data := make([]string, 0, len(members))
+	for m := range members {
+		data = append(data, m)
+	}
+	sort.Strings(data)
+	return data, nil
+}
+
+func (s *InMemorySdlSyncStorage) IsMember(ns string, group string, member interface{}) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, ok := s.namespace(ns).groups[group][toStorageString(member)]
+	return ok, nil
+}
+
+func (s *InMemorySdlSyncStorage) GroupSize(ns string, group string) (int64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return int64(len(s.namespace(ns).groups[group])), nil
+}
+
+func (s *InMemorySdlSyncStorage) namespace(ns string) *inMemoryNamespace {
+	namespace, ok := s.namespaces[ns]
+	if !ok {
+		namespace = &inMemoryNamespace{values: map[string]string{}, groups: map[string]map[string]struct{}{}}
+		s.namespaces[ns] = namespace
+	}
+	return namespace
+}
+
+func (s *InMemorySdlSyncStorage) set(ns string, pairs []interface{}) error {
+	flatPairs := flatten(pairs)
+	if len(flatPairs)%2 != 0 {
+		return errors.New("key/value pairs doesn't match")
+	}
+	namespace := s.namespace(ns)
+	for i := 0; i < len(flatPairs); i += 2 {
+		key, ok := flatPairs[i].(string)
+		if !ok {
+			return fmt.Errorf("key %v is not a string", flatPairs[i])
+		}
+		delete(namespace.groups, key)
+		namespace.values[key] = toStorageString(flatPairs[i+1])
+	}
+	return nil
+}
+
+// publish queues the events for the subscribers of the channels, the caller must hold the storage lock
+func (s *InMemorySdlSyncStorage) publish(ns string, channelsAndEvents []string) {
+	var channels []string
+	events := map[string][]string{}
+	for i := 0; i < len(channelsAndEvents); i += 2 {
+		channel := channelsAndEvents[i]
+		if _, ok := events[channel]; !ok {
+			channels = append(channels, channel)
+		}
+		events[channel] = append(events[channel], channelsAndEvents[i+1])
+	}
+	for _, channel := range channels {
+		for _, subscription := range s.subscriptions[buildChannelName(ns, channel)] {
+			subscription.push(append([]string{channel}, events[channel]...))
+		}
+	}
+}
+
+func (n *inMemoryNamespace) exists(key string) bool {
+	if _, ok := n.values[key]; ok {
+		return true
+	}
+	_, ok := n.groups[key]
+	return ok
+}
+
+func (n *inMemoryNamespace) remove(key string) {
+	delete(n.values, key)
+	delete(n.groups, key)
+}
+
+func (sub *inMemorySubscription) push(message []string) {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+	sub.queue = append(sub.queue, message)
+	sub.cond.Signal()
+}
+
+func (sub *inMemorySubscription) close() {
+	sub.mutex.Lock()
+	defer sub.mutex.Unlock()
+	sub.closed = true
+	sub.cond.Signal()
+}
+
+func (sub *inMemorySubscription) deliver() {
+	for {
+		sub.mutex.Lock()
+		for len(sub.queue) == 0 && !sub.closed {
+			sub.cond.Wait()
+		}
+		if sub.closed {
+			sub.mutex.Unlock()
+			return
+		}
+		message := sub.queue[0]
+		sub.queue = sub.queue[1:]
+		sub.mutex.Unlock()
+		sub.cb(message[0], message[1:]...)
+	}
+}
+
+func buildChannelName(ns string, channel string) string {
+	return fmt.Sprintf("{%s},%s", ns, channel)
+}
+
+func validateChannelsAndEvents(channelsAndEvents []string) error {
+	if len(channelsAndEvents)%2 != 0 {
+		return errors.New("channels and events must be given in pairs")
+	}
+	return nil
+}
+
+func flatten(values []interface{}) []interface{} {
+	var flat []interface{}
+	for _, v := range values {
+		switch t := v.(type) {
+		case []interface{}:
+			flat = append(flat, flatten(t)...)
+		case map[string]interface{}:
+			for key, value := range t {
+				flat = append(flat, key, value)
+			}
+		default:
+			flat = append(flat, v)
+		}
+	}
+	return flat
+}
+
+func toStorageString(value interface{}) string {
+	switch t := value.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(t)
+	}
+}
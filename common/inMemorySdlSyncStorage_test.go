@@ -0,0 +1,198 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package common
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+const testNamespace = "namespace"
+
+func TestInMemorySetAndGet(t *testing.T) {
+	s := NewInMemorySdlSyncStorage()
+	err := s.Set(testNamespace, "key1", []byte("data1"), []interface{}{"key2", "data2"})
+	assert.Nil(t, err)
+	data, err := s.Get(testNamespace, []string{"key1", "key2", "key3"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"key1": "data1", "key2": "data2", "key3": nil}, data)
+	data, err = s.Get("other", []string{"key1"})
+	assert.Nil(t, err)
+	assert.Nil(t, data["key1"])
+}
+
+func TestInMemorySetOddPairsFailure(t *testing.T) {
+	s := NewInMemorySdlSyncStorage()
+	err := s.Set(testNamespace, "key1", "data1", "key2")
+	assert.NotNil(t, err)
+}
+
+func TestInMemorySetIf(t *testing.T) {
+	s := NewInMemorySdlSyncStorage()
+	ok, err := s.SetIf(testNamespace, "key", "old", "new")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	ok, err = s.SetIfNotExists(testNamespace, "key", "old")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	ok, _ = s.SetIfNotExists(testNamespace, "key", "other")
+	assert.False(t, ok)
+	ok, _ = s.SetIf(testNamespace, "key", []byte("other"), "new")
+	assert.False(t, ok)
+	ok, _ = s.SetIf(testNamespace, "key", []byte("old"), "new")
+	assert.True(t, ok)
+	data, _ := s.Get(testNamespace, []string{"key"})
+	assert.Equal(t, "new", data["key"])
+}
+
+func TestInMemoryRemoveIf(t *testing.T) {
+	s := NewInMemorySdlSyncStorage()
+	_ = s.Set(testNamespace, "key", "data")
+	ok, err := s.RemoveIf(testNamespace, "key", "other")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	ok, _ = s.RemoveIf(testNamespace, "key", "data")
+	assert.True(t, ok)
+	keys, _ := s.GetAll(testNamespace)
+	assert.Empty(t, keys)
+}
+
+func TestInMemoryGroups(t *testing.T) {
+	s := NewInMemorySdlSyncStorage()
+	err := s.AddMember(testNamespace, "group", "m2", []byte("m1"), "m3")
+	assert.Nil(t, err)
+	members, _ := s.GetMembers(testNamespace, "group")
+	assert.Equal(t, []string{"m1", "m2", "m3"}, members)
+	size, _ := s.GroupSize(testNamespace, "group")
+	assert.Equal(t, int64(3), size)
+	ok, _ := s.IsMember(testNamespace, "group", []byte("m2"))
+	assert.True(t, ok)
+	_ = s.RemoveMember(testNamespace, "group", "m2")
+	ok, _ = s.IsMember(testNamespace, "group", "m2")
+	assert.False(t, ok)
+	_ = s.RemoveGroup(testNamespace, "group")
+	size, _ = s.GroupSize(testNamespace, "group")
+	assert.Equal(t, int64(0), size)
+}
+
+func TestInMemoryGroupsShareKeySpace(t *testing.T) {
+	s := NewInMemorySdlSyncStorage()
+	_ = s.Set(testNamespace, "key", "data")
+	_ = s.AddMember(testNamespace, "group", "m1")
+	err := s.AddMember(testNamespace, "key", "m1")
+	assert.NotNil(t, err)
+	ok, _ := s.SetIfNotExists(testNamespace, "group", "data")
+	assert.False(t, ok)
+	keys, _ := s.GetAll(testNamespace)
+	assert.Equal(t, []string{"group", "key"}, keys)
+	data, _ := s.Get(testNamespace, []string{"group"})
+	assert.Nil(t, data["group"])
+	_ = s.Remove(testNamespace, []string{"group", "key"})
+	keys, _ = s.GetAll(testNamespace)
+	assert.Empty(t, keys)
+}
+
+func TestInMemoryRemoveAll(t *testing.T) {
+	s := NewInMemorySdlSyncStorage()
+	_ = s.Set(testNamespace, "key", "data")
+	_ = s.Set("other", "key", "data")
+	_ = s.RemoveAll(testNamespace)
+	keys, _ := s.GetAll(testNamespace)
+	assert.Empty(t, keys)
+	keys, _ = s.GetAll("other")
+	assert.Equal(t, []string{"key"}, keys)
+}
+
+type receivedEvent struct {
+	channel string
+	events  []string
+}
+
+func receiveEvent(t *testing.T, received chan receivedEvent) receivedEvent {
+	select {
+	case r := <-received:
+		return r
+	case <-time.After(time.Second):
+		t.Fatal("#inMemorySdlSyncStorage_test.receiveEvent - no event received")
+	}
+	return receivedEvent{}
+}
+
+func TestInMemoryPublish(t *testing.T) {
+	s := NewInMemorySdlSyncStorage()
+	received := make(chan receivedEvent, 10)
+	cb := func(channel string, events ...string) {
+		received <- receivedEvent{channel: channel, events: events}
+	}
+	err := s.SubscribeChannel(testNamespace, cb, "ch1", "ch2")
+	assert.Nil(t, err)
+
+	_ = s.SetAndPublish(testNamespace, []string{"ch1", "ev1", "ch2", "ev2", "ch1", "ev3"}, "key", "data")
+	_ = s.SetAndPublish("other", []string{"ch1", "ignored"}, "key", "data")
+	_, _ = s.RemoveIfAndPublish(testNamespace, []string{"ch2", "ev4"}, "key", "data")
+	_, _ = s.RemoveIfAndPublish(testNamespace, []string{"ch2", "ignored"}, "key", "data")
+
+	events := map[string][][]string{}
+	for i := 0; i < 3; i++ {
+		r := receiveEvent(t, received)
+		events[r.channel] = append(events[r.channel], r.events)
+	}
+	assert.Equal(t, [][]string{{"ev1", "ev3"}}, events["ch1"])
+	assert.Equal(t, [][]string{{"ev2"}, {"ev4"}}, events["ch2"])
+
+	_ = s.UnsubscribeChannel(testNamespace, "ch1")
+	_ = s.SetAndPublish(testNamespace, []string{"ch1", "ignored", "ch2", "ev5"}, "key", "data")
+	r := receiveEvent(t, received)
+	assert.Equal(t, "ch2", r.channel)
+	assert.Equal(t, []string{"ev5"}, r.events)
+	assert.Nil(t, s.Close())
+}
+
+func TestInMemoryPublishToEverySubscriber(t *testing.T) {
+	s := NewInMemorySdlSyncStorage()
+	first := make(chan receivedEvent, 10)
+	second := make(chan receivedEvent, 10)
+	assert.Nil(t, s.SubscribeChannel(testNamespace, func(channel string, events ...string) {
+		first <- receivedEvent{channel: channel, events: events}
+	}, "ch1"))
+	assert.Nil(t, s.SubscribeChannel(testNamespace, func(channel string, events ...string) {
+		second <- receivedEvent{channel: channel, events: events}
+	}, "ch1"))
+
+	_ = s.SetAndPublish(testNamespace, []string{"ch1", "ev1"}, "key", "data")
+	assert.Equal(t, []string{"ev1"}, receiveEvent(t, first).events)
+	assert.Equal(t, []string{"ev1"}, receiveEvent(t, second).events)
+
+	_ = s.UnsubscribeChannel(testNamespace, "ch1")
+	_ = s.SetAndPublish(testNamespace, []string{"ch1", "ignored"}, "key", "data")
+	time.Sleep(10 * time.Millisecond)
+	assert.Empty(t, first)
+	assert.Empty(t, second)
+	assert.Nil(t, s.Close())
+}
+
+func TestInMemoryPublishOddChannelsAndEventsFailure(t *testing.T) {
+	s := NewInMemorySdlSyncStorage()
+	err := s.SetAndPublish(testNamespace, []string{"ch1"}, "key", "data")
+	assert.NotNil(t, err)
+	data, _ := s.Get(testNamespace, []string{"key"})
+	assert.Nil(t, data["key"])
+}
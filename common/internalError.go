@@ -20,14 +20,43 @@
 
 package common
 
+/*
+InternalError is returned when rNib fails for a reason other than the caller's input.
+Its code tells storage failures (StorageUnavailable) and undecodable entities (CorruptData) apart from other failures (Internal)
+*/
 type InternalError struct{
 	Err error
+	EntityKind string
+	Key string
+	code ErrorCode
 }
 
 func NewInternalError(error error) error {
 	return &InternalError{Err:error}
 }
 
+//NewStorageUnavailableError returns an InternalError for a failed storage operation
+func NewStorageUnavailableError(error error) error {
+	return &InternalError{Err:error, code:StorageUnavailable}
+}
+
+//NewCorruptDataError returns an InternalError for an entity stored under key that cannot be decoded
+func NewCorruptDataError(entityKind string, key string, error error) error {
+	return &InternalError{Err:error, EntityKind:entityKind, Key:key, code:CorruptData}
+}
+
 func (e InternalError) Error() string {
 	return e.Err.Error()
 }
+
+func (e InternalError) Unwrap() error {
+	return e.Err
+}
+
+func (e InternalError) Code() ErrorCode {
+	return e.code
+}
+
+func (e InternalError) Is(target error) bool {
+	return isErrorCode(e.code, target)
+}
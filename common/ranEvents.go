@@ -0,0 +1,56 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	RanManipulationMessageChannel = "RAN_MANIPULATION"
+	StateChangeMessageChannel     = "RAN_CONNECTION_STATUS_CHANGE"
+)
+
+const (
+	RanAddedEvent        = "ADDED"
+	RanUpdatedEvent      = "UPDATED"
+	RanDeletedEvent      = "DELETED"
+	RanConnectedEvent    = "CONNECTED"
+	RanDisconnectedEvent = "DISCONNECTED"
+)
+
+/*
+BuildRanEvent builds the event published on the RAN channels for the given nodeb inventory name
+*/
+func BuildRanEvent(inventoryName string, event string) string {
+	return fmt.Sprintf("%s_%s", inventoryName, event)
+}
+
+/*
+ParseRanEvent splits an event published on the RAN channels into the nodeb inventory name and the event kind
+*/
+func ParseRanEvent(ranEvent string) (string, string, error) {
+	i := strings.LastIndex(ranEvent, "_")
+	if i <= 0 || i == len(ranEvent)-1 {
+		return "", "", NewValidationErrorf("#utils.ParseRanEvent - invalid RAN event received: %s", ranEvent)
+	}
+	return ranEvent[:i], ranEvent[i+1:], nil
+}
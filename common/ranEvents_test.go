@@ -0,0 +1,44 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package common
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBuildRanEvent(t *testing.T) {
+	assert.Equal(t, "name_CONNECTED", BuildRanEvent("name", RanConnectedEvent))
+	assert.Equal(t, "name_DELETED", BuildRanEvent("name", RanDeletedEvent))
+}
+
+func TestParseRanEvent(t *testing.T) {
+	inventoryName, event, err := ParseRanEvent("gnb_734_733_b5c67788_CONNECTED")
+	assert.Nil(t, err)
+	assert.Equal(t, "gnb_734_733_b5c67788", inventoryName)
+	assert.Equal(t, RanConnectedEvent, event)
+}
+
+func TestParseRanEventFailure(t *testing.T) {
+	for _, ranEvent := range []string{"", "CONNECTED", "_CONNECTED", "name_"} {
+		_, _, err := ParseRanEvent(ranEvent)
+		assert.IsType(t, &ValidationError{}, err)
+	}
+}
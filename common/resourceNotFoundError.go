@@ -24,6 +24,8 @@ import "fmt"
 
 type ResourceNotFoundError struct{
 	message string
+	EntityKind string
+	Key string
 }
 
 func NewResourceNotFoundError(msg string) error {
@@ -34,6 +36,19 @@ func NewResourceNotFoundErrorf(fmtMsg string, a ...interface{}) error {
 	return &ResourceNotFoundError{message:fmt.Sprintf(fmtMsg, a...)}
 }
 
+//NewResourceNotFoundErrorForKey returns a ResourceNotFoundError for the entity of the given kind missing under key
+func NewResourceNotFoundErrorForKey(entityKind string, key string, fmtMsg string, a ...interface{}) error {
+	return &ResourceNotFoundError{message:fmt.Sprintf(fmtMsg, a...), EntityKind:entityKind, Key:key}
+}
+
 func (e ResourceNotFoundError) Error() string {
 	return e.message
+}
+
+func (e ResourceNotFoundError) Code() ErrorCode {
+	return NotFound
+}
+
+func (e ResourceNotFoundError) Is(target error) bool {
+	return isErrorCode(NotFound, target)
 }
\ No newline at end of file
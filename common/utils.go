@@ -22,11 +22,27 @@ package common
 
 import (
 	"fmt"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"strings"
 )
 
 //SDL namespace used by the RNIB
 const rnibNamespace = "e2Manager"
 
+//Prefixes of the RNIB keys holding an entity per inventory name, E2T address, PLMN or tracking area
+const (
+	NodebKeyPrefix              = "RAN:"
+	RanLoadInformationKeyPrefix = "LOAD:"
+	E2TInstanceKeyPrefix        = "E2TInstance:"
+	PlmnCellsKeyPrefix          = "PLMN_CELLS:"
+	TacCellsKeyPrefix           = "TAC_CELLS:"
+)
+
+//GetBatchSize is the number of keys read by a single Get when reading a whole namespace
+const GetBatchSize = 500
+
+var cellKeyPrefixes = []string{"CELL:", "NRCELL:", "PCI:", "NRCGI:", "ECGI:"}
+
 /*
 ValidateAndBuildCellIdKey builds key according to the specified format returns the resulting string
 */
@@ -54,7 +70,7 @@ func ValidateAndBuildNodeBNameKey(inventoryName string) (string, error) {
 	if inventoryName == "" {
 		return "", NewValidationError("#utils.ValidateAndBuildNodeBNameKey - an empty inventory name received")
 	}
-	return fmt.Sprintf("RAN:%s", inventoryName), nil
+	return NodebKeyPrefix + inventoryName, nil
 }
 
 /*
@@ -97,13 +113,55 @@ func ValidateAndBuildCellNamePciKey(inventoryName string, pci uint32) (string, e
 	return fmt.Sprintf("PCI:%s:%02x", inventoryName, pci), nil
 }
 
+/*
+ValidateAndBuildNrCgiKey builds the key of the NR cell stored under its NR CGI, returns the resulting string
+*/
+func ValidateAndBuildNrCgiKey(nrCgi string) (string, error) {
+	if nrCgi == "" {
+		return "", NewValidationError("#utils.ValidateAndBuildNrCgiKey - an empty NR CGI received")
+	}
+	return fmt.Sprintf("NRCGI:%s", nrCgi), nil
+}
+
+/*
+ValidateAndBuildEcgiKey builds the key of the LTE cell stored under its ECGI, returns the resulting string
+*/
+func ValidateAndBuildEcgiKey(ecgi string) (string, error) {
+	if ecgi == "" {
+		return "", NewValidationError("#utils.ValidateAndBuildEcgiKey - an empty ECGI received")
+	}
+	return fmt.Sprintf("ECGI:%s", ecgi), nil
+}
+
+/*
+ValidateAndBuildPlmnCellsKey builds the key of the group of the cell keys serving the PLMN, returns the resulting string.
+The hex digits of the PLMN identity are lower cased.
+*/
+func ValidateAndBuildPlmnCellsKey(plmnId string) (string, error) {
+	if plmnId == "" {
+		return "", NewValidationError("#utils.ValidateAndBuildPlmnCellsKey - an empty plmnId received")
+	}
+	return PlmnCellsKeyPrefix + strings.ToLower(plmnId), nil
+}
+
+/*
+ValidateAndBuildTacCellsKey builds the key of the group of the cell keys in the tracking area, returns the resulting string.
+The hex digits of the tracking area code are lower cased.
+*/
+func ValidateAndBuildTacCellsKey(tac string) (string, error) {
+	if tac == "" {
+		return "", NewValidationError("#utils.ValidateAndBuildTacCellsKey - an empty tac received")
+	}
+	return TacCellsKeyPrefix + strings.ToLower(tac), nil
+}
+
 func ValidateAndBuildRanLoadInformationKey(inventoryName string) (string, error) {
 
 	if inventoryName == "" {
 		return "", NewValidationError("#utils.ValidateAndBuildRanLoadInformationKey - an empty inventory name received")
 	}
 
-	return fmt.Sprintf("LOAD:%s", inventoryName), nil
+	return RanLoadInformationKeyPrefix + inventoryName, nil
 }
 
 func ValidateAndBuildE2TInstanceKey(address string) (string, error) {
@@ -112,7 +170,7 @@ func ValidateAndBuildE2TInstanceKey(address string) (string, error) {
 		return "", NewValidationError("#utils.ValidateAndBuildE2TInstanceKey - an empty E2T address received")
 	}
 
-	return fmt.Sprintf("E2TInstance:%s", address), nil
+	return E2TInstanceKeyPrefix + address, nil
 }
 
 func BuildGeneralConfigurationKey() string {
@@ -131,6 +189,65 @@ func MapE2TAddressesToKeys(addresses []string) []string {
 	return keys
 }
 
+/*
+IsNbIdentitySetKey tells whether the key is the set of the nodeb identities of a node type, named after the node type
+*/
+func IsNbIdentitySetKey(key string) bool {
+	_, ok := entities.Node_Type_value[key]
+	return ok
+}
+
+/*
+IsCellIndexKey tells whether the key is a PLMN or tracking area group of cell keys
+*/
+func IsCellIndexKey(key string) bool {
+	return strings.HasPrefix(key, PlmnCellsKeyPrefix) || strings.HasPrefix(key, TacCellsKeyPrefix)
+}
+
+/*
+IsGroupKey tells whether the key holds a set of members rather than a value: an identity set or a cell index
+*/
+func IsGroupKey(key string) bool {
+	return IsNbIdentitySetKey(key) || IsCellIndexKey(key)
+}
+
+/*
+IsNodeBIdKey tells whether the key is a nodeb global Id key, as built by ValidateAndBuildNodeBIdKey
+*/
+func IsNodeBIdKey(key string) bool {
+	for nodeType := range entities.Node_Type_value {
+		if strings.HasPrefix(key, nodeType+":") {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+IsCellKey tells whether the key holds a cell entity, stored under its cell id, PCI, NR CGI or ECGI
+*/
+func IsCellKey(key string) bool {
+	for _, prefix := range cellKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+ValueToBytes returns the bytes of a value read from SDL, which returns the values as strings
+*/
+func ValueToBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	}
+	return nil, fmt.Errorf("unexpected value type %T", value)
+}
+
 //GetRNibNamespace returns namespace used by the RNIB in SDL.
 func GetRNibNamespace() string {
 	return rnibNamespace
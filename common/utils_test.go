@@ -55,7 +55,7 @@ func TestValidateAndBuildNodeBIdKeySuccess(t *testing.T) {
 	plmnId := "bbbb"
 	nbId := "cccc"
 	delimiter := ":"
-	key, err := ValidateAndBuildNodeBIdKey(nodeType, plmnId, nbId)
+	key, err := ValidateAndBuildNodeBIdKey(nodeType, plmnId, nbId, "", "")
 	if err != nil{
 		t.Errorf("#utils_test.TestValidateAndBuildNodeBIdKey - failed to validate key parameter")
 	}
@@ -90,7 +90,7 @@ func TestValidateAndBuildNodeBNameKeyNameValidationFailure(t *testing.T) {
 func TestValidateAndBuildNodeBIdKeyNodeTypeValidationFailure(t *testing.T) {
 	plmnId := "dddd"
 	nbId := "eeee"
-	_, err := ValidateAndBuildNodeBIdKey("", plmnId, nbId)
+	_, err := ValidateAndBuildNodeBIdKey("", plmnId, nbId, "", "")
 	assert.NotNil(t, err)
 	assert.IsType(t, &ValidationError{}, err)
 	assert.Equal(t, "#utils.ValidateAndBuildNodeBIdKey - an empty node type received", err.Error())
@@ -99,7 +99,7 @@ func TestValidateAndBuildNodeBIdKeyNodeTypeValidationFailure(t *testing.T) {
 func TestValidateAndBuildNodeBIdKeyPlmnIdValidationFailure(t *testing.T) {
 	nodeType := "ffff"
 	nbId := "aaaa"
-	_, err := ValidateAndBuildNodeBIdKey(nodeType, "", nbId)
+	_, err := ValidateAndBuildNodeBIdKey(nodeType, "", nbId, "", "")
 	assert.NotNil(t, err)
 	assert.IsType(t, &ValidationError{}, err)
 	assert.Equal(t, "#utils.ValidateAndBuildNodeBIdKey - an empty plmnId received", err.Error())
@@ -108,7 +108,7 @@ func TestValidateAndBuildNodeBIdKeyPlmnIdValidationFailure(t *testing.T) {
 func TestValidateAndBuildNodeBIdKeyNbIdValidationFailure(t *testing.T) {
 	nodeType := "bbbb"
 	plmnId := "cccc"
-	_, err := ValidateAndBuildNodeBIdKey(nodeType, plmnId, "")
+	_, err := ValidateAndBuildNodeBIdKey(nodeType, plmnId, "", "", "")
 	assert.NotNil(t, err)
 	assert.IsType(t, &ValidationError{}, err)
 	assert.Equal(t, "#utils.ValidateAndBuildNodeBIdKey - an empty nbId received", err.Error())
@@ -148,4 +148,68 @@ func TestValidateAndBuildRanLoadInformationKeyFailure(t *testing.T) {
 	_, err := ValidateAndBuildRanLoadInformationKey(name)
 	assert.NotNil(t, err)
 	assert.IsType(t, &ValidationError{}, err)
-}
\ No newline at end of file
+}
+func TestValidateAndBuildCellIndexKeysSuccess(t *testing.T) {
+	key, err := ValidateAndBuildNrCgiKey("02f829:4a952a0a50")
+	assert.Nil(t, err)
+	assert.Equal(t, "NRCGI:02f829:4a952a0a50", key)
+	key, err = ValidateAndBuildEcgiKey("02f829:007a81f0")
+	assert.Nil(t, err)
+	assert.Equal(t, "ECGI:02f829:007a81f0", key)
+	key, err = ValidateAndBuildPlmnCellsKey("02f829")
+	assert.Nil(t, err)
+	assert.Equal(t, "PLMN_CELLS:02f829", key)
+	key, err = ValidateAndBuildPlmnCellsKey("02F829")
+	assert.Nil(t, err)
+	assert.Equal(t, "PLMN_CELLS:02f829", key)
+	key, err = ValidateAndBuildTacCellsKey("00AB")
+	assert.Nil(t, err)
+	assert.Equal(t, "TAC_CELLS:00ab", key)
+}
+
+func TestValidateAndBuildCellIndexKeysValidationFailure(t *testing.T) {
+	for _, build := range []func(string) (string, error){ValidateAndBuildNrCgiKey, ValidateAndBuildEcgiKey, ValidateAndBuildPlmnCellsKey, ValidateAndBuildTacCellsKey} {
+		_, err := build("")
+		assert.IsType(t, &ValidationError{}, err)
+	}
+}
+
+func TestKeyClassification(t *testing.T) {
+	tests := []struct {
+		key        string
+		group      bool
+		nodebIdKey bool
+		cellKey    bool
+	}{
+		{key: "GNB", group: true},
+		{key: "PLMN_CELLS:02f829", group: true},
+		{key: "TAC_CELLS:0001", group: true},
+		{key: "GNB:02f829:4a952a0a", nodebIdKey: true},
+		{key: "ENB:02f829:007a80", nodebIdKey: true},
+		{key: "NRCELL:02f829:4a952a0a50", cellKey: true},
+		{key: "PCI:gnb_1:05", cellKey: true},
+		{key: "ECGI:02f829:007a80f0", cellKey: true},
+		{key: "RAN:gnb_1"},
+		{key: "LOAD:gnb_1"},
+		{key: "GENERAL"},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.group, IsGroupKey(tc.key), tc.key)
+		assert.Equal(t, tc.nodebIdKey, IsNodeBIdKey(tc.key), tc.key)
+		assert.Equal(t, tc.cellKey, IsCellKey(tc.key), tc.key)
+	}
+	assert.True(t, IsNbIdentitySetKey("ENB"))
+	assert.False(t, IsNbIdentitySetKey("TAC_CELLS:0001"))
+	assert.True(t, IsCellIndexKey("TAC_CELLS:0001"))
+}
+
+func TestValueToBytes(t *testing.T) {
+	data, err := ValueToBytes("value")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), data)
+	data, err = ValueToBytes([]byte("value"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), data)
+	_, err = ValueToBytes(nil)
+	assert.NotNil(t, err)
+}
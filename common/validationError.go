@@ -36,4 +36,12 @@ func NewValidationErrorf(fmtMsg string, a ...interface{}) error {
 
 func (e ValidationError) Error() string {
 	return e.message
+}
+
+func (e ValidationError) Code() ErrorCode {
+	return Validation
+}
+
+func (e ValidationError) Is(target error) bool {
+	return isErrorCode(Validation, target)
 }
\ No newline at end of file
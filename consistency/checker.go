@@ -0,0 +1,628 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+/*
+Package consistency cross-checks the R-NIB keys holding the same data: the nodeb entities under their inventory name
+and global Id keys, the identity sets, the cell keys and indexes, the load information and the E2T instances.
+The nodeb entities stored under the RAN:<inventory name> keys are the reference the other keys are checked against.
+*/
+package consistency
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer"
+	"github.com/golang/protobuf/proto"
+	"sort"
+	"strings"
+)
+
+type IssueKind string
+
+const (
+	// UndecodableValue is a value which does not decode as the entity its key holds
+	UndecodableValue IssueKind = "undecodable-value"
+	// InvalidEntity is a nodeb entity whose keys cannot be built
+	InvalidEntity IssueKind = "invalid-entity"
+	// UnknownKey is a key no R-NIB entity is stored under
+	UnknownKey IssueKind = "unknown-key"
+	// OrphanKey is a global Id, cell or load information key no nodeb owns
+	OrphanKey IssueKind = "orphan-key"
+	// MissingKey is a global Id or cell key of a nodeb which is not set
+	MissingKey IssueKind = "missing-key"
+	// ConflictingKey is a global Id or cell key several nodebs or cells claim
+	ConflictingKey IssueKind = "conflicting-key"
+	// MismatchedValue is a key or an identity holding other data than its nodeb
+	MismatchedValue IssueKind = "mismatched-value"
+	// OrphanMember is an identity or cell index member no nodeb owns
+	OrphanMember IssueKind = "orphan-member"
+	// MissingMember is a nodeb identity or cell key missing from its set
+	MissingMember IssueKind = "missing-member"
+	// MissingE2TInstance is an E2T address, listed or associated with a nodeb, without E2T instance entity
+	MissingE2TInstance IssueKind = "missing-e2t-instance"
+	// UnlistedE2TInstance is an E2T instance entity whose address is not listed in E2TAddresses
+	UnlistedE2TInstance IssueKind = "unlisted-e2t-instance"
+	// E2TAssociationMismatch is a RAN missing from the associated RAN list of its E2T instance, or listed by another one
+	E2TAssociationMismatch IssueKind = "e2t-association-mismatch"
+)
+
+/*
+Issue is an inconsistency found in the R-NIB. Key is the key holding the inconsistent data and Member the group member,
+if any. Repairable issues are repaired by CheckAndRepair, Repaired tells whether the repair was applied.
+*/
+type Issue struct {
+	Kind       IssueKind `json:"kind"`
+	Key        string    `json:"key"`
+	Member     string    `json:"member,omitempty"`
+	Message    string    `json:"message"`
+	Repairable bool      `json:"repairable"`
+	Repaired   bool      `json:"repaired"`
+	repair     func() (bool, error)
+}
+
+/*
+Report lists the issues found, ordered by key and member, among the keys of the R-NIB namespace
+*/
+type Report struct {
+	Keys   int     `json:"keys"`
+	Issues []Issue `json:"issues"`
+}
+
+type checker struct {
+	storage   common.ISdlSyncStorage
+	ns        string
+	keys      int
+	valueKeys []string
+	values    map[string]interface{}
+	groups    map[string][]string
+	nodebs    map[string]*entities.NodebInfo
+	// unresolved holds the nodebs which do not decode or whose keys cannot be built, so the keys they own are unknown
+	unresolved map[string]bool
+	issues     []Issue
+}
+
+type ownedKey struct {
+	owners []string
+	data   []byte
+}
+
+/*
+Check reads every key of the R-NIB namespace and reports the issues found, without modifying the storage
+*/
+func Check(storage common.ISdlSyncStorage) (*Report, error) {
+	c, err := load(storage)
+	if err != nil {
+		return nil, err
+	}
+	return c.check(), nil
+}
+
+/*
+CheckAndRepair reports the issues found like Check and repairs the repairable ones. Keys are set and removed with SetIf,
+SetIfNotExists and RemoveIf against the values read, so that a key written meanwhile is left alone and its issue
+reported as not repaired. Set members are added and removed unconditionally.
+Keys and members which may belong to a nodeb that does not decode or whose keys cannot be built are never removed.
+*/
+func CheckAndRepair(storage common.ISdlSyncStorage) (*Report, error) {
+	c, err := load(storage)
+	if err != nil {
+		return nil, err
+	}
+	report := c.check()
+	for i := range report.Issues {
+		issue := &report.Issues[i]
+		if issue.repair == nil {
+			continue
+		}
+		issue.Repaired, err = issue.repair()
+		if err != nil {
+			return report, common.NewStorageUnavailableError(err)
+		}
+	}
+	return report, nil
+}
+
+func load(storage common.ISdlSyncStorage) (*checker, error) {
+	c := &checker{
+		storage:    storage,
+		ns:         common.GetRNibNamespace(),
+		values:     map[string]interface{}{},
+		groups:     map[string][]string{},
+		nodebs:     map[string]*entities.NodebInfo{},
+		unresolved: map[string]bool{},
+		issues:     []Issue{},
+	}
+	keys, err := storage.GetAll(c.ns)
+	if err != nil {
+		return nil, common.NewStorageUnavailableError(err)
+	}
+	c.keys = len(keys)
+	for _, key := range keys {
+		if !common.IsGroupKey(key) {
+			c.valueKeys = append(c.valueKeys, key)
+			continue
+		}
+		members, err := storage.GetMembers(c.ns, key)
+		if err != nil {
+			return nil, common.NewStorageUnavailableError(err)
+		}
+		c.groups[key] = members
+	}
+	sort.Strings(c.valueKeys)
+	for start := 0; start < len(c.valueKeys); start += common.GetBatchSize {
+		end := start + common.GetBatchSize
+		if end > len(c.valueKeys) {
+			end = len(c.valueKeys)
+		}
+		values, err := storage.Get(c.ns, c.valueKeys[start:end])
+		if err != nil {
+			return nil, common.NewStorageUnavailableError(err)
+		}
+		for key, value := range values {
+			if value != nil {
+				c.values[key] = value
+			}
+		}
+	}
+	return c, nil
+}
+
+func (c *checker) check() *Report {
+	c.loadNodebs()
+	owned := c.buildOwnedKeys()
+	c.checkValueKeys(owned)
+	c.checkOwnedKeys(owned)
+	c.checkIdentitySets()
+	c.checkCellIndexes()
+	c.checkE2TInstances()
+	sort.SliceStable(c.issues, func(i, j int) bool {
+		if c.issues[i].Key != c.issues[j].Key {
+			return c.issues[i].Key < c.issues[j].Key
+		}
+		return c.issues[i].Member < c.issues[j].Member
+	})
+	return &Report{Keys: c.keys, Issues: c.issues}
+}
+
+func (c *checker) report(issue Issue, repair func() (bool, error)) {
+	issue.repair = repair
+	issue.Repairable = repair != nil
+	c.issues = append(c.issues, issue)
+}
+
+func (c *checker) loadNodebs() {
+	for _, key := range c.valueKeys {
+		value, ok := c.values[key]
+		if !ok || !strings.HasPrefix(key, common.NodebKeyPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(key, common.NodebKeyPrefix)
+		nodebInfo := &entities.NodebInfo{}
+		if err := unmarshalProto(value, nodebInfo); err != nil {
+			c.report(Issue{Kind: UndecodableValue, Key: key, Message: err.Error()}, nil)
+			c.unresolved[name] = true
+			continue
+		}
+		if nodebInfo.GetRanName() != name {
+			c.report(Issue{Kind: MismatchedValue, Key: key, Message: fmt.Sprintf("holds nodeb %s", nodebInfo.GetRanName())}, nil)
+		}
+		c.nodebs[name] = nodebInfo
+	}
+}
+
+/*
+reportUnowned reports a key or member no nodeb owns, without its removal repair when some nodeb does not decode
+or its keys cannot be built, as the key or member may be one of its own
+*/
+func (c *checker) reportUnowned(issue Issue, removal func() (bool, error)) {
+	if len(c.unresolved) == 0 {
+		c.report(issue, removal)
+		return
+	}
+	names := make([]string, 0, len(c.unresolved))
+	for name := range c.unresolved {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	issue.Message = fmt.Sprintf("%s, not removed as it may belong to nodeb %s", issue.Message, strings.Join(names, ", "))
+	c.report(issue, nil)
+}
+
+// reportNotFound reports a key or member of a nodeb not found, without its removal repair when the nodeb does not decode
+func (c *checker) reportNotFound(name string, issue Issue, removal func() (bool, error)) {
+	if c.unresolved[name] {
+		issue.Message = fmt.Sprintf("%s, not removed as nodeb %s does not decode", issue.Message, name)
+		removal = nil
+	}
+	c.report(issue, removal)
+}
+
+func (c *checker) nodebNames() []string {
+	names := make([]string, 0, len(c.nodebs))
+	for name := range c.nodebs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// buildOwnedKeys returns the global Id and cell keys of every nodeb with the data the writer would store there
+func (c *checker) buildOwnedKeys() map[string]*ownedKey {
+	owned := map[string]*ownedKey{}
+	for _, name := range c.nodebNames() {
+		keyValues, err := writer.BuildNodebKeyValues(c.nodebs[name])
+		if err != nil {
+			c.report(Issue{Kind: InvalidEntity, Key: common.NodebKeyPrefix + name, Message: err.Error()}, nil)
+			c.unresolved[name] = true
+			continue
+		}
+		for key, data := range keyValues {
+			if strings.HasPrefix(key, common.NodebKeyPrefix) {
+				continue
+			}
+			if o, ok := owned[key]; ok {
+				o.owners = append(o.owners, name)
+				continue
+			}
+			owned[key] = &ownedKey{owners: []string{name}, data: data}
+		}
+	}
+	return owned
+}
+
+func (c *checker) checkValueKeys(owned map[string]*ownedKey) {
+	for _, key := range c.valueKeys {
+		value, ok := c.values[key]
+		if !ok {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(key, common.NodebKeyPrefix):
+			// The nodeb entities are decoded by loadNodebs
+		case common.IsNodeBIdKey(key):
+			c.checkOwnedValue(key, value, owned[key], &entities.NodebInfo{}, &entities.NodebInfo{})
+		case common.IsCellKey(key):
+			c.checkOwnedValue(key, value, owned[key], &entities.Cell{}, &entities.Cell{})
+		case strings.HasPrefix(key, common.RanLoadInformationKeyPrefix):
+			c.checkLoadInformation(key, value)
+		case strings.HasPrefix(key, common.E2TInstanceKeyPrefix), key == writer.E2TAddressesKey:
+			// The E2T entities are decoded by checkE2TInstances
+		case key == common.BuildGeneralConfigurationKey():
+			if err := unmarshalJson(value, &entities.GeneralConfiguration{}); err != nil {
+				c.report(Issue{Kind: UndecodableValue, Key: key, Message: err.Error()}, nil)
+			}
+		default:
+			c.report(Issue{Kind: UnknownKey, Key: key, Message: "no R-NIB entity is stored under this key"}, nil)
+		}
+	}
+}
+
+func (c *checker) checkOwnedValue(key string, value interface{}, owner *ownedKey, stored proto.Message, expected proto.Message) {
+	err := unmarshalProto(value, stored)
+	switch {
+	case owner == nil:
+		message := "no nodeb owns this key"
+		if err != nil {
+			message = fmt.Sprintf("%s, its value does not decode: %s", message, err)
+		}
+		c.reportUnowned(Issue{Kind: OrphanKey, Key: key, Message: message}, c.removeIf(key, value))
+	case len(owner.owners) > 1:
+		// Reported by checkOwnedKeys
+	case err != nil:
+		c.report(Issue{Kind: UndecodableValue, Key: key, Message: fmt.Sprintf("%s, nodeb %s owns this key", err, owner.owners[0])}, c.setIf(key, value, owner.data))
+	default:
+		if err := proto.Unmarshal(owner.data, expected); err == nil && !proto.Equal(stored, expected) {
+			c.report(Issue{Kind: MismatchedValue, Key: key, Message: fmt.Sprintf("differs from nodeb %s", owner.owners[0])}, c.setIf(key, value, owner.data))
+		}
+	}
+}
+
+func (c *checker) checkOwnedKeys(owned map[string]*ownedKey) {
+	keys := make([]string, 0, len(owned))
+	for key := range owned {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		owner := owned[key]
+		if len(owner.owners) > 1 {
+			c.report(Issue{Kind: ConflictingKey, Key: key, Message: fmt.Sprintf("claimed by several cells or nodebs: %s", strings.Join(owner.owners, ", "))}, nil)
+			continue
+		}
+		if _, ok := c.values[key]; !ok {
+			c.report(Issue{Kind: MissingKey, Key: key, Message: fmt.Sprintf("not set for nodeb %s", owner.owners[0])}, c.setIfNotExists(key, owner.data))
+		}
+	}
+}
+
+func (c *checker) checkLoadInformation(key string, value interface{}) {
+	name := strings.TrimPrefix(key, common.RanLoadInformationKeyPrefix)
+	err := unmarshalProto(value, &entities.RanLoadInformation{})
+	if _, ok := c.nodebs[name]; !ok {
+		c.reportNotFound(name, Issue{Kind: OrphanKey, Key: key, Message: fmt.Sprintf("nodeb %s not found", name)}, c.removeIf(key, value))
+		return
+	}
+	if err != nil {
+		c.report(Issue{Kind: UndecodableValue, Key: key, Message: err.Error()}, nil)
+	}
+}
+
+func (c *checker) checkIdentitySets() {
+	identified := map[string]bool{}
+	for _, group := range identitySetKeys() {
+		for _, member := range c.groups[group] {
+			identity := &entities.NbIdentity{}
+			if err := proto.Unmarshal([]byte(member), identity); err != nil {
+				c.report(Issue{Kind: UndecodableValue, Key: group, Member: hex.EncodeToString([]byte(member)), Message: err.Error()}, nil)
+				continue
+			}
+			name := identity.GetInventoryName()
+			nodebInfo, ok := c.nodebs[name]
+			switch {
+			case !ok:
+				c.reportNotFound(name, Issue{Kind: OrphanMember, Key: group, Member: name, Message: fmt.Sprintf("nodeb %s not found", name)}, c.removeMember(group, member))
+			case nodebInfo.GetNodeType().String() != group:
+				c.report(Issue{Kind: OrphanMember, Key: group, Member: name, Message: fmt.Sprintf("nodeb %s is a %s", name, nodebInfo.GetNodeType())}, c.removeMember(group, member))
+			default:
+				identified[name] = true
+				if !proto.Equal(identity.GetGlobalNbId(), nodebInfo.GetGlobalNbId()) || identity.GetConnectionStatus() != nodebInfo.GetConnectionStatus() {
+					updated := proto.Clone(identity).(*entities.NbIdentity)
+					updated.GlobalNbId = nodebInfo.GetGlobalNbId()
+					updated.ConnectionStatus = nodebInfo.GetConnectionStatus()
+					c.report(Issue{Kind: MismatchedValue, Key: group, Member: name, Message: fmt.Sprintf("global Id or connection status differs from nodeb %s", name)}, c.replaceMember(group, member, updated))
+				}
+			}
+		}
+	}
+	for _, name := range c.nodebNames() {
+		if identified[name] {
+			continue
+		}
+		nodebInfo := c.nodebs[name]
+		identity := &entities.NbIdentity{InventoryName: name, GlobalNbId: nodebInfo.GetGlobalNbId(), ConnectionStatus: nodebInfo.GetConnectionStatus()}
+		group := nodebInfo.GetNodeType().String()
+		c.report(Issue{Kind: MissingMember, Key: group, Member: name, Message: fmt.Sprintf("no identity for nodeb %s", name)}, c.replaceMember(group, "", identity))
+	}
+}
+
+func (c *checker) checkCellIndexes() {
+	expected := map[string]map[string]bool{}
+	for _, name := range c.nodebNames() {
+		for group, cellKeys := range writer.BuildNodebCellIndexMembers(c.nodebs[name]) {
+			if expected[group] == nil {
+				expected[group] = map[string]bool{}
+			}
+			for _, cellKey := range cellKeys {
+				expected[group][cellKey] = true
+			}
+		}
+	}
+	for _, group := range sortedGroupKeys(c.groups) {
+		if !common.IsCellIndexKey(group) {
+			continue
+		}
+		for _, member := range c.groups[group] {
+			if expected[group][member] {
+				delete(expected[group], member)
+				continue
+			}
+			message := fmt.Sprintf("no nodeb serves cell %s in this PLMN or tracking area", member)
+			if _, ok := c.values[member]; !ok {
+				message = fmt.Sprintf("cell key %s not found", member)
+			}
+			c.reportUnowned(Issue{Kind: OrphanMember, Key: group, Member: member, Message: message}, c.removeMember(group, member))
+		}
+	}
+	for group, cellKeys := range expected {
+		for cellKey := range cellKeys {
+			c.report(Issue{Kind: MissingMember, Key: group, Member: cellKey, Message: "cell not indexed"}, c.addMember(group, cellKey))
+		}
+	}
+}
+
+func (c *checker) checkE2TInstances() {
+	var addresses []string
+	addressesValue, listed := c.values[writer.E2TAddressesKey]
+	if listed {
+		if err := unmarshalJson(addressesValue, &addresses); err != nil {
+			c.report(Issue{Kind: UndecodableValue, Key: writer.E2TAddressesKey, Message: err.Error()}, nil)
+			listed = false
+		}
+	}
+	instances := map[string]*entities.E2TInstance{}
+	for _, key := range c.valueKeys {
+		value, ok := c.values[key]
+		if !ok || !strings.HasPrefix(key, common.E2TInstanceKeyPrefix) {
+			continue
+		}
+		address := strings.TrimPrefix(key, common.E2TInstanceKeyPrefix)
+		instance := &entities.E2TInstance{}
+		if err := unmarshalJson(value, instance); err != nil {
+			c.report(Issue{Kind: UndecodableValue, Key: key, Message: err.Error()}, nil)
+			continue
+		}
+		if instance.Address != address {
+			c.report(Issue{Kind: MismatchedValue, Key: key, Message: fmt.Sprintf("holds E2T instance %s", instance.Address)}, nil)
+		}
+		instances[address] = instance
+		c.checkAssociatedRans(key, value, instance, address)
+	}
+	if listed {
+		isListed := map[string]bool{}
+		for _, address := range addresses {
+			isListed[address] = true
+			if _, ok := instances[address]; !ok {
+				c.report(Issue{Kind: MissingE2TInstance, Key: writer.E2TAddressesKey, Member: address, Message: fmt.Sprintf("E2T instance %s not found", address)}, nil)
+			}
+		}
+		for address := range instances {
+			if !isListed[address] {
+				c.report(Issue{Kind: UnlistedE2TInstance, Key: common.E2TInstanceKeyPrefix + address, Message: fmt.Sprintf("%s not listed in %s", address, writer.E2TAddressesKey)}, nil)
+			}
+		}
+	}
+	for _, name := range c.nodebNames() {
+		address := c.nodebs[name].GetAssociatedE2TInstanceAddress()
+		if _, ok := instances[address]; address != "" && !ok {
+			c.report(Issue{Kind: MissingE2TInstance, Key: common.NodebKeyPrefix + name, Member: address, Message: fmt.Sprintf("nodeb %s is associated with E2T instance %s which is not found", name, address)}, nil)
+		}
+	}
+}
+
+// checkAssociatedRans compares the associated RAN list of the E2T instance with the nodebs associated with its address
+func (c *checker) checkAssociatedRans(key string, value interface{}, instance *entities.E2TInstance, address string) {
+	type mismatch struct {
+		name    string
+		message string
+	}
+	var mismatches []mismatch
+	var ranList []string
+	listed := map[string]bool{}
+	for _, name := range instance.AssociatedRanList {
+		nodebInfo, ok := c.nodebs[name]
+		switch {
+		case !ok && c.unresolved[name]:
+			// The nodeb may be associated with this E2T instance, it is kept listed
+			listed[name] = true
+			ranList = append(ranList, name)
+		case !ok:
+			mismatches = append(mismatches, mismatch{name, fmt.Sprintf("associated RAN %s not found", name)})
+		case nodebInfo.GetAssociatedE2TInstanceAddress() != address:
+			mismatches = append(mismatches, mismatch{name, fmt.Sprintf("nodeb %s is associated with E2T instance %q", name, nodebInfo.GetAssociatedE2TInstanceAddress())})
+		default:
+			listed[name] = true
+			ranList = append(ranList, name)
+		}
+	}
+	for _, name := range c.nodebNames() {
+		if c.nodebs[name].GetAssociatedE2TInstanceAddress() == address && !listed[name] {
+			mismatches = append(mismatches, mismatch{name, fmt.Sprintf("nodeb %s is associated with this E2T instance but not listed", name)})
+			ranList = append(ranList, name)
+		}
+	}
+	if len(mismatches) == 0 {
+		return
+	}
+	repaired := *instance
+	repaired.AssociatedRanList = append([]string{}, ranList...)
+	var repair func() (bool, error)
+	if data, err := json.Marshal(&repaired); err == nil {
+		repair = once(c.setIf(key, value, data))
+	}
+	for _, m := range mismatches {
+		c.report(Issue{Kind: E2TAssociationMismatch, Key: key, Member: m.name, Message: m.message}, repair)
+	}
+}
+
+func (c *checker) setIf(key string, oldValue interface{}, data []byte) func() (bool, error) {
+	return func() (bool, error) {
+		return c.storage.SetIf(c.ns, key, oldValue, data)
+	}
+}
+
+func (c *checker) setIfNotExists(key string, data []byte) func() (bool, error) {
+	return func() (bool, error) {
+		return c.storage.SetIfNotExists(c.ns, key, data)
+	}
+}
+
+func (c *checker) removeIf(key string, value interface{}) func() (bool, error) {
+	return func() (bool, error) {
+		return c.storage.RemoveIf(c.ns, key, value)
+	}
+}
+
+func (c *checker) addMember(group string, member string) func() (bool, error) {
+	return func() (bool, error) {
+		return true, c.storage.AddMember(c.ns, group, member)
+	}
+}
+
+func (c *checker) removeMember(group string, member string) func() (bool, error) {
+	return func() (bool, error) {
+		return true, c.storage.RemoveMember(c.ns, group, member)
+	}
+}
+
+// replaceMember returns a repair adding the identity to the group in place of the member, if any
+func (c *checker) replaceMember(group string, member string, identity *entities.NbIdentity) func() (bool, error) {
+	data, err := proto.Marshal(identity)
+	if err != nil {
+		return nil
+	}
+	return func() (bool, error) {
+		if member != "" {
+			if err := c.storage.RemoveMember(c.ns, group, member); err != nil {
+				return false, err
+			}
+		}
+		return true, c.storage.AddMember(c.ns, group, data)
+	}
+}
+
+// once returns a repair applying the given one the first time it is called, issues sharing a repair report its result
+func once(repair func() (bool, error)) func() (bool, error) {
+	var done, repaired bool
+	var err error
+	return func() (bool, error) {
+		if !done {
+			done = true
+			repaired, err = repair()
+		}
+		return repaired, err
+	}
+}
+
+func unmarshalProto(value interface{}, message proto.Message) error {
+	data, err := common.ValueToBytes(value)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(data, message)
+}
+
+func unmarshalJson(value interface{}, entity interface{}) error {
+	data, err := common.ValueToBytes(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, entity)
+}
+
+func identitySetKeys() []string {
+	keys := make([]string, 0, len(entities.Node_Type_name))
+	for _, name := range entities.Node_Type_name {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedGroupKeys(groups map[string][]string) []string {
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,279 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package consistency
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const e2tAddress = "10.0.2.15:3213"
+
+func initStorage(t *testing.T) *common.InMemorySdlSyncStorage {
+	storage := common.NewInMemorySdlSyncStorage()
+	w := writer.GetNewRNibWriter(storage)
+	gnb := &entities.NodebInfo{
+		RanName:                      "gnb_1",
+		NodeType:                     entities.Node_GNB,
+		ConnectionStatus:             entities.ConnectionStatus_CONNECTED,
+		GlobalNbId:                   &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"},
+		AssociatedE2TInstanceAddress: e2tAddress,
+		Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{ServedNrCells: []*entities.ServedNRCell{
+			{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: "02f829:4a952a0a50", NrPci: 5, ServedPlmns: []string{"02f829"}, Stac5G: "000001"}},
+		}}},
+	}
+	enb := &entities.NodebInfo{
+		RanName:          "enb_1",
+		NodeType:         entities.Node_ENB,
+		ConnectionStatus: entities.ConnectionStatus_DISCONNECTED,
+		GlobalNbId:       &entities.GlobalNbId{PlmnId: "02f829", NbId: "007a80"},
+		Configuration: &entities.NodebInfo_Enb{Enb: &entities.Enb{ServedCells: []*entities.ServedCellInfo{
+			{CellId: "02f829:007a80f0", Pci: 7, BroadcastPlmns: []string{"02f829"}, Tac: "0102"},
+		}}},
+	}
+	for _, nb := range []*entities.NodebInfo{gnb, enb} {
+		assert.Nil(t, w.SaveNodeb(nb))
+		assert.Nil(t, w.AddNbIdentity(nb.GetNodeType(), &entities.NbIdentity{InventoryName: nb.GetRanName(), GlobalNbId: nb.GetGlobalNbId(), ConnectionStatus: nb.GetConnectionStatus()}))
+	}
+	e2tInstance := entities.NewE2TInstance(e2tAddress, "e2term")
+	e2tInstance.AssociatedRanList = []string{"gnb_1"}
+	assert.Nil(t, w.SaveE2TInstance(e2tInstance))
+	assert.Nil(t, w.SaveE2TAddresses([]string{e2tAddress}))
+	assert.Nil(t, w.SaveRanLoadInformation("enb_1", &entities.RanLoadInformation{LoadTimestamp: 5}))
+	assert.Nil(t, w.SaveGeneralConfiguration(&entities.GeneralConfiguration{EnableRic: true}))
+	return storage
+}
+
+func issueKinds(report *Report) map[string]IssueKind {
+	kinds := map[string]IssueKind{}
+	for _, issue := range report.Issues {
+		kinds[issue.Key+" "+issue.Member] = issue.Kind
+	}
+	return kinds
+}
+
+func assertConsistent(t *testing.T, storage common.ISdlSyncStorage) {
+	report, err := Check(storage)
+	assert.Nil(t, err)
+	assert.Empty(t, report.Issues)
+}
+
+func TestCheckConsistent(t *testing.T) {
+	storage := initStorage(t)
+	report, err := Check(storage)
+	assert.Nil(t, err)
+	assert.Equal(t, 19, report.Keys)
+	assert.Empty(t, report.Issues)
+}
+
+func TestCheckAndRepairOrphans(t *testing.T) {
+	storage := initStorage(t)
+	assert.Nil(t, storage.Remove(common.GetRNibNamespace(), []string{"RAN:enb_1"}))
+
+	report, err := Check(storage)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]IssueKind{
+		"CELL:02f829:007a80f0 ":                  OrphanKey,
+		"ECGI:02f829:007a80f0 ":                  OrphanKey,
+		"ENB enb_1":                              OrphanMember,
+		"ENB:02f829:007a80 ":                     OrphanKey,
+		"LOAD:enb_1 ":                            OrphanKey,
+		"PCI:enb_1:07 ":                          OrphanKey,
+		"PLMN_CELLS:02f829 CELL:02f829:007a80f0": OrphanMember,
+		"TAC_CELLS:0102 CELL:02f829:007a80f0":    OrphanMember,
+	}, issueKinds(report))
+	for _, issue := range report.Issues {
+		assert.True(t, issue.Repairable, issue.Key)
+		assert.False(t, issue.Repaired, issue.Key)
+	}
+
+	report, err = CheckAndRepair(storage)
+	assert.Nil(t, err)
+	assert.Len(t, report.Issues, 8)
+	for _, issue := range report.Issues {
+		assert.True(t, issue.Repaired, issue.Key)
+	}
+	assertConsistent(t, storage)
+	members, err := storage.GetMembers(common.GetRNibNamespace(), "PLMN_CELLS:02f829")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"NRCELL:02f829:4a952a0a50"}, members)
+}
+
+func TestCheckAndRepairMismatches(t *testing.T) {
+	storage := initStorage(t)
+	ns := common.GetRNibNamespace()
+	otherCell, _ := proto.Marshal(&entities.Cell{Type: entities.Cell_LTE_CELL, Cell: &entities.Cell_ServedCellInfo{ServedCellInfo: &entities.ServedCellInfo{CellId: "02f829:007a80f0", Pci: 8}}})
+	assert.Nil(t, storage.Set(ns, "CELL:02f829:007a80f0", otherCell, "ECGI:02f829:007a80f0", "garbage"))
+	assert.Nil(t, storage.Remove(ns, []string{"PCI:gnb_1:05"}))
+	assert.Nil(t, storage.RemoveMember(ns, "TAC_CELLS:000001", "NRCELL:02f829:4a952a0a50"))
+	identity, _ := proto.Marshal(&entities.NbIdentity{InventoryName: "enb_1", GlobalNbId: &entities.GlobalNbId{PlmnId: "02f829", NbId: "007a80"}, ConnectionStatus: entities.ConnectionStatus_DISCONNECTED})
+	staleIdentity, _ := proto.Marshal(&entities.NbIdentity{InventoryName: "enb_1", ConnectionStatus: entities.ConnectionStatus_CONNECTED, HealthCheckTimestampSent: 3})
+	assert.Nil(t, storage.RemoveMember(ns, "ENB", identity))
+	assert.Nil(t, storage.AddMember(ns, "ENB", staleIdentity))
+
+	report, err := Check(storage)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]IssueKind{
+		"CELL:02f829:007a80f0 ": MismatchedValue,
+		"ECGI:02f829:007a80f0 ": UndecodableValue,
+		"ENB enb_1":             MismatchedValue,
+		"PCI:gnb_1:05 ":         MissingKey,
+		"TAC_CELLS:000001 NRCELL:02f829:4a952a0a50": MissingMember,
+	}, issueKinds(report))
+
+	_, err = CheckAndRepair(storage)
+	assert.Nil(t, err)
+	assertConsistent(t, storage)
+	members, err := storage.GetMembers(ns, "ENB")
+	assert.Nil(t, err)
+	assert.Len(t, members, 1)
+	repairedIdentity := &entities.NbIdentity{}
+	assert.Nil(t, proto.Unmarshal([]byte(members[0]), repairedIdentity))
+	assert.Equal(t, int64(3), repairedIdentity.GetHealthCheckTimestampSent())
+	assert.Equal(t, entities.ConnectionStatus_DISCONNECTED, repairedIdentity.GetConnectionStatus())
+}
+
+func TestCheckAndRepairE2TAssociations(t *testing.T) {
+	storage := initStorage(t)
+	w := writer.GetNewRNibWriter(storage)
+	e2tInstance := entities.NewE2TInstance(e2tAddress, "e2term")
+	e2tInstance.AssociatedRanList = []string{"enb_1", "ghost"}
+	assert.Nil(t, w.SaveE2TInstance(e2tInstance))
+
+	report, err := Check(storage)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]IssueKind{
+		"E2TInstance:10.0.2.15:3213 enb_1": E2TAssociationMismatch,
+		"E2TInstance:10.0.2.15:3213 ghost": E2TAssociationMismatch,
+		"E2TInstance:10.0.2.15:3213 gnb_1": E2TAssociationMismatch,
+	}, issueKinds(report))
+
+	report, err = CheckAndRepair(storage)
+	assert.Nil(t, err)
+	for _, issue := range report.Issues {
+		assert.True(t, issue.Repaired, issue.Member)
+	}
+	assertConsistent(t, storage)
+}
+
+func TestCheckReportOnlyIssues(t *testing.T) {
+	storage := initStorage(t)
+	ns := common.GetRNibNamespace()
+	assert.Nil(t, storage.Set(ns, "RAN:broken", "garbage", "UNRELATED", "value", writer.E2TAddressesKey, `["10.0.2.15:3213","10.0.2.16:3213"]`))
+	assert.Nil(t, storage.Set(ns, "E2TInstance:10.0.2.17:3213", `{"address":"10.0.2.17:3213","associatedRanList":[]}`))
+
+	report, err := CheckAndRepair(storage)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]IssueKind{
+		"E2TAddresses 10.0.2.16:3213": MissingE2TInstance,
+		"E2TInstance:10.0.2.17:3213 ": UnlistedE2TInstance,
+		"RAN:broken ":                 UndecodableValue,
+		"UNRELATED ":                  UnknownKey,
+	}, issueKinds(report))
+	for _, issue := range report.Issues {
+		assert.False(t, issue.Repairable, issue.Key)
+		assert.False(t, issue.Repaired, issue.Key)
+	}
+}
+
+func TestCheckAndRepairKeepsKeysOfUnresolvedNodeb(t *testing.T) {
+	invalidGnb := &entities.NodebInfo{
+		RanName:                      "gnb_1",
+		NodeType:                     entities.Node_GNB,
+		ConnectionStatus:             entities.ConnectionStatus_CONNECTED,
+		GlobalNbId:                   &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"},
+		AssociatedE2TInstanceAddress: e2tAddress,
+		Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{ServedNrCells: []*entities.ServedNRCell{
+			{ServedNrCellInformation: &entities.ServedNRCellInformation{NrPci: 5}},
+		}}},
+	}
+	invalidGnbData, _ := proto.Marshal(invalidGnb)
+	tests := []struct {
+		name    string
+		value   interface{}
+		ranKind IssueKind
+	}{
+		{name: "undecodable", value: "garbage", ranKind: UndecodableValue},
+		{name: "invalid", value: invalidGnbData, ranKind: InvalidEntity},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			storage := initStorage(t)
+			ns := common.GetRNibNamespace()
+			assert.Nil(t, storage.Set(ns, "RAN:gnb_1", tc.value))
+			keys, err := storage.GetAll(ns)
+			assert.Nil(t, err)
+
+			report, err := CheckAndRepair(storage)
+			assert.Nil(t, err)
+			kinds := issueKinds(report)
+			assert.Equal(t, tc.ranKind, kinds["RAN:gnb_1 "])
+			assert.Equal(t, OrphanKey, kinds["GNB:02f829:4a952a0a "])
+			assert.Equal(t, OrphanKey, kinds["NRCELL:02f829:4a952a0a50 "])
+			assert.Equal(t, OrphanKey, kinds["PCI:gnb_1:05 "])
+			assert.Equal(t, OrphanMember, kinds["PLMN_CELLS:02f829 NRCELL:02f829:4a952a0a50"])
+			for _, issue := range report.Issues {
+				assert.False(t, issue.Repairable, issue.Key)
+				assert.False(t, issue.Repaired, issue.Key)
+			}
+			remainingKeys, err := storage.GetAll(ns)
+			assert.Nil(t, err)
+			assert.ElementsMatch(t, keys, remainingKeys)
+			members, err := storage.GetMembers(ns, "PLMN_CELLS:02f829")
+			assert.Nil(t, err)
+			assert.Contains(t, members, "NRCELL:02f829:4a952a0a50")
+			members, err = storage.GetMembers(ns, "GNB")
+			assert.Nil(t, err)
+			assert.Len(t, members, 1)
+		})
+	}
+}
+
+type concurrentStorage struct {
+	*common.InMemorySdlSyncStorage
+	beforeRemoveIf func()
+}
+
+func (s *concurrentStorage) RemoveIf(ns string, key string, data interface{}) (bool, error) {
+	s.beforeRemoveIf()
+	return s.InMemorySdlSyncStorage.RemoveIf(ns, key, data)
+}
+
+func TestCheckAndRepairSkipsKeysWrittenMeanwhile(t *testing.T) {
+	storage := &concurrentStorage{InMemorySdlSyncStorage: initStorage(t)}
+	ns := common.GetRNibNamespace()
+	assert.Nil(t, storage.Set(ns, "LOAD:gone", "garbage"))
+	storage.beforeRemoveIf = func() {
+		assert.Nil(t, storage.Set(ns, "LOAD:gone", "rewritten"))
+	}
+
+	report, err := CheckAndRepair(storage)
+	assert.Nil(t, err)
+	assert.Len(t, report.Issues, 1)
+	assert.Equal(t, OrphanKey, report.Issues[0].Kind)
+	assert.False(t, report.Issues[0].Repaired)
+	values, err := storage.Get(ns, []string{"LOAD:gone"})
+	assert.Nil(t, err)
+	assert.Equal(t, "rewritten", values["LOAD:gone"])
+}
@@ -3,20 +3,26 @@ module gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/creader
 go 1.17
 
 require (
-	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common v1.0.35
-	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities v1.0.35
-	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader v1.0.35
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader v1.2.7
 	gerrit.o-ran-sc.org/r/ric-plt/sdlgo v0.8.0
+	github.com/golang/protobuf v1.4.2
+	google.golang.org/protobuf v1.23.0
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.0 // indirect
 	github.com/go-redis/redis v6.15.9+incompatible // indirect
-	github.com/golang/protobuf v1.4.2 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/stretchr/objx v0.1.0 // indirect
 	github.com/stretchr/testify v1.3.0 // indirect
-	google.golang.org/protobuf v1.23.0 // indirect
 )
 
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common => ../common
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities => ../entities
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader => ../reader
+
 replace gerrit.o-ran-sc.org/r/ric-plt/sdlgo => gerrit.o-ran-sc.org/r/ric-plt/sdlgo.git v0.8.0
@@ -25,56 +25,260 @@ import (
 	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
 	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
 	"gerrit.o-ran-sc.org/r/ric-plt/sdlgo"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
 	"unsafe"
 )
 
-//#include <string.h>
+/*
+#include <stdlib.h>
+
+// Error codes reported in the error_code field of every response
+typedef enum {
+	RNIB_OK = 0,
+	RNIB_NOT_FOUND = 1,
+	RNIB_VALIDATION_ERROR = 2,
+	RNIB_INTERNAL_ERROR = 3,
+	RNIB_NOT_OPEN = 4,
+	RNIB_STORAGE_UNAVAILABLE = 5,
+	RNIB_CORRUPT_DATA = 6,
+	RNIB_TIMEOUT = 7
+} rnib_error_code;
+*/
 import "C"
 
-var sdl common.ISdlInstance
+var sdl common.ISdlSyncStorage
 var instance reader.RNibReader
 
+var marshalOptions = protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true}
+
 type response struct {
-	GnbList  []*entities.NbIdentity `json:"gnb_list"`
-	ErrorMsg string                 `json:"error_msg,omitempty"`
+	ErrorCode int    `json:"error_code"`
+	ErrorMsg  string `json:"error_msg,omitempty"`
 }
 
+// openSdl opens the R-NIB storage. It must be called before any getter
 //export openSdl
 func openSdl() {
-	sdl = sdlgo.NewSdlInstance("e2Manager", sdlgo.NewDatabase())
-	instance = reader.GetRNibReader(sdl)
+	sdl = sdlgo.NewSyncStorage()
+	instance = reader.GetNewRNibReader(sdl)
 }
 
+// closeSdl closes the R-NIB storage
 //export closeSdl
 func closeSdl() {
-	_ = sdl.Close()
+	if sdl != nil {
+		_ = sdl.Close()
+	}
+	sdl = nil
+	instance = nil
+}
+
+// freeRnibResponse releases a response returned by any of the getters
+//export freeRnibResponse
+func freeRnibResponse(response *C.char) {
+	C.free(unsafe.Pointer(response))
+}
+
+// getNodeb returns {"error_code": ..., "nodeb": {...}}
+//export getNodeb
+func getNodeb(inventoryName *C.char) *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
+	nodeb, err := instance.GetNodeb(C.GoString(inventoryName))
+	return createCStringResponse("nodeb", nodeb, err)
+}
+
+// getNodebByGlobalNbId returns {"error_code": ..., "nodeb": {...}}. nodeType is 1 for ENB and 2 for GNB
+//export getNodebByGlobalNbId
+func getNodebByGlobalNbId(nodeType C.int, plmnId *C.char, nbId *C.char, cuUpId *C.char, duId *C.char) *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
+	globalNbId := &entities.GlobalNbId{PlmnId: C.GoString(plmnId), NbId: C.GoString(nbId)}
+	nodeb, err := instance.GetNodebByGlobalNbId(entities.Node_Type(nodeType), globalNbId, C.GoString(cuUpId), C.GoString(duId))
+	return createCStringResponse("nodeb", nodeb, err)
+}
+
+// getCellList returns {"error_code": ..., "cells": {...}}
+//export getCellList
+func getCellList(inventoryName *C.char) *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
+	cells, err := instance.GetCellList(C.GoString(inventoryName))
+	return createCStringResponse("cells", cells, err)
+}
+
+// getCell returns {"error_code": ..., "cell": {...}}
+//export getCell
+func getCell(inventoryName *C.char, pci C.uint) *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
+	cell, err := instance.GetCell(C.GoString(inventoryName), uint32(pci))
+	return createCStringResponse("cell", cell, err)
+}
+
+// getCellById returns {"error_code": ..., "cell": {...}}. cellType is 1 for LTE_CELL and 2 for NR_CELL
+//export getCellById
+func getCellById(cellType C.int, cellId *C.char) *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
+	cell, err := instance.GetCellById(entities.Cell_Type(cellType), C.GoString(cellId))
+	return createCStringResponse("cell", cell, err)
 }
 
+// getListGnbIds returns {"error_code": ..., "gnb_list": [...]}
 //export getListGnbIds
-func getListGnbIds() unsafe.Pointer {
+func getListGnbIds() *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
 	listGnbIds, err := instance.GetListGnbIds()
-	res := &response{}
+	return createCStringResponse("gnb_list", listGnbIds, err)
+}
 
-	if err != nil {
-		res.ErrorMsg = err.Error()
+// getListEnbIds returns {"error_code": ..., "enb_list": [...]}
+//export getListEnbIds
+func getListEnbIds() *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
+	listEnbIds, err := instance.GetListEnbIds()
+	return createCStringResponse("enb_list", listEnbIds, err)
+}
+
+// getListNodebIds returns {"error_code": ..., "nodeb_list": [...]}
+//export getListNodebIds
+func getListNodebIds() *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
+	listNodebIds, err := instance.GetListNodebIds()
+	return createCStringResponse("nodeb_list", listNodebIds, err)
+}
+
+// getRanLoadInformation returns {"error_code": ..., "ran_load_information": {...}}
+//export getRanLoadInformation
+func getRanLoadInformation(inventoryName *C.char) *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
+	loadInfo, err := instance.GetRanLoadInformation(C.GoString(inventoryName))
+	return createCStringResponse("ran_load_information", loadInfo, err)
+}
+
+// getE2TInstance returns {"error_code": ..., "e2t_instance": {...}}
+//export getE2TInstance
+func getE2TInstance(address *C.char) *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
+	e2tInstance, err := instance.GetE2TInstance(C.GoString(address))
+	return createCStringResponse("e2t_instance", e2tInstance, err)
+}
+
+// getE2TInstances returns {"error_code": ..., "e2t_instances": [...]} for the given array of count addresses
+//export getE2TInstances
+func getE2TInstances(addresses **C.char, count C.int) *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
+	goAddresses := make([]string, 0, int(count))
+	if count > 0 {
+		for _, address := range (*[1 << 28]*C.char)(unsafe.Pointer(addresses))[:count:count] {
+			goAddresses = append(goAddresses, C.GoString(address))
+		}
+	}
+	e2tInstances, err := instance.GetE2TInstances(goAddresses)
+	return createCStringResponse("e2t_instances", e2tInstances, err)
+}
+
+// getE2TAddresses returns {"error_code": ..., "e2t_addresses": [...]}
+//export getE2TAddresses
+func getE2TAddresses() *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
+	}
+	addresses, err := instance.GetE2TAddresses()
+	return createCStringResponse("e2t_addresses", addresses, err)
+}
 
-		return createCBytesResponse(res)
+// getGeneralConfiguration returns {"error_code": ..., "general_configuration": {...}}
+//export getGeneralConfiguration
+func getGeneralConfiguration() *C.char {
+	if instance == nil {
+		return createNotOpenResponse()
 	}
+	config, err := instance.GetGeneralConfiguration()
+	return createCStringResponse("general_configuration", config, err)
+}
 
-	if listGnbIds != nil {
-		res.GnbList = listGnbIds
+func errorCode(err error) int {
+	switch common.GetErrorCode(err) {
+	case common.NotFound:
+		return C.RNIB_NOT_FOUND
+	case common.Validation:
+		return C.RNIB_VALIDATION_ERROR
+	case common.StorageUnavailable:
+		return C.RNIB_STORAGE_UNAVAILABLE
+	case common.CorruptData:
+		return C.RNIB_CORRUPT_DATA
+	case common.Timeout:
+		return C.RNIB_TIMEOUT
 	}
+	return C.RNIB_INTERNAL_ERROR
+}
+
+func marshalResult(result interface{}) (json.RawMessage, error) {
+	switch r := result.(type) {
+	case proto.Message:
+		return marshalOptions.Marshal(proto.MessageV2(r))
+	case []*entities.NbIdentity:
+		list := make([]json.RawMessage, 0, len(r))
+		for _, nbIdentity := range r {
+			data, err := marshalOptions.Marshal(nbIdentity)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, data)
+		}
+		return json.Marshal(list)
+	}
+	return json.Marshal(result)
+}
 
-	return createCBytesResponse(res)
+func createNotOpenResponse() *C.char {
+	return createCStringFromResponse(map[string]interface{}{
+		"error_code": C.RNIB_NOT_OPEN,
+		"error_msg":  "#rNibReader_c - openSdl was not called",
+	})
+}
+
+func createCStringResponse(name string, result interface{}, err error) *C.char {
+	if err != nil {
+		return createCStringFromResponse(&response{ErrorCode: errorCode(err), ErrorMsg: err.Error()})
+	}
+	data, err := marshalResult(result)
+	if err != nil {
+		return createCStringFromResponse(&response{ErrorCode: C.RNIB_INTERNAL_ERROR, ErrorMsg: err.Error()})
+	}
+	return createCStringFromResponse(map[string]interface{}{
+		"error_code": C.RNIB_OK,
+		name:         data,
+	})
 }
 
-func createCBytesResponse(res *response) unsafe.Pointer {
+func createCStringFromResponse(res interface{}) *C.char {
 	byteResponse, err := json.Marshal(res)
 	if err != nil {
 		return nil
 	}
 
-	return C.CBytes(byteResponse)
+	return C.CString(string(byteResponse))
 }
 
 func main() {}
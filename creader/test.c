@@ -24,8 +24,8 @@
 int main() {
     printf("Using rnibreader lib from C:\n");
 
-    open();
-    void *result = getListGnbIds();
+    openSdl();
+    char *result = getListGnbIds();
 
     if(result == NULL){
 
@@ -33,11 +33,23 @@ int main() {
         return 1;
     }
 
-    printf("getListGnbIds response: %s\n", (char *)result);
+    printf("getListGnbIds response: %s\n", result);
 
-    close();
+    freeRnibResponse(result);
 
-    free(result);
+    result = getNodeb("gnb_1");
+
+    if(result == NULL){
+
+        printf("ERROR: no data from getNodeb\n");
+        return 1;
+    }
+
+    printf("getNodeb response: %s\n", result);
+
+    freeRnibResponse(result);
+
+    closeSdl();
 
     return 0;
-}
\ No newline at end of file
+}
@@ -0,0 +1,99 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package ctl
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/consistency"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/topology"
+	"strconv"
+	"strings"
+)
+
+func pciCheck(c *commandContext, args []string) error {
+	positional, err := parseInterspersed(newFlagSet(c, "pci check"), args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return errUsage
+	}
+	report, err := topology.AnalyzePci(c.Reader)
+	if err != nil {
+		return err
+	}
+	return printResult(c, report, func() table {
+		t := table{{"ISSUE", "CELL", "NAME", "PCI", "ARFCN", "NEIGHBOURS"}}
+		for _, collision := range report.Collisions {
+			t = append(t, pciRow("collision", collision.Cell, collision.Cell.Pci, collision.Cell.Arfcn, []topology.PciCell{collision.Neighbour}))
+		}
+		for _, confusion := range report.Confusions {
+			t = append(t, pciRow("confusion", confusion.Cell, confusion.Pci, confusion.Arfcn, confusion.Neighbours))
+		}
+		return t
+	})
+}
+
+func pciRow(issue string, cell topology.PciCell, pci uint32, arfcn uint64, neighbours []topology.PciCell) []string {
+	cgis := make([]string, 0, len(neighbours))
+	for _, neighbour := range neighbours {
+		cgis = append(cgis, neighbour.Cgi)
+	}
+	return []string{issue, cell.Cgi, cell.InventoryName, strconv.FormatUint(uint64(pci), 10), strconv.FormatUint(arfcn, 10), strings.Join(cgis, ",")}
+}
+
+func rnibCheck(c *commandContext, args []string) error {
+	fs := newFlagSet(c, "rnib check")
+	repair := fs.Bool("repair", false, "repair the repairable issues")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return errUsage
+	}
+	check := consistency.Check
+	if *repair {
+		check = consistency.CheckAndRepair
+	}
+	report, err := check(c.Storage)
+	if err != nil {
+		return err
+	}
+	return printResult(c, report, func() table {
+		t := table{{"KIND", "KEY", "MEMBER", "MESSAGE", "REPAIR"}}
+		for _, issue := range report.Issues {
+			t = append(t, []string{string(issue.Kind), issue.Key, issue.Member, issue.Message, repairStatus(issue, *repair)})
+		}
+		return t
+	})
+}
+
+func repairStatus(issue consistency.Issue, repair bool) string {
+	switch {
+	case !issue.Repairable:
+		return ""
+	case !repair:
+		return "available"
+	case issue.Repaired:
+		return "repaired"
+	}
+	return "skipped"
+}
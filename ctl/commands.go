@@ -0,0 +1,286 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package ctl
+
+import (
+	"fmt"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/golang/protobuf/proto"
+	"strconv"
+	"strings"
+)
+
+func nodebGet(c *commandContext, args []string) error {
+	fs := newFlagSet(c, "nodeb get")
+	nodeType := fs.String("type", "", "node type of the global nb id: gnb or enb")
+	plmnId := fs.String("plmn", "", "plmn id of the global nb id")
+	nbId := fs.String("nbid", "", "nb id of the global nb id")
+	cuupId := fs.String("cuup", "", "gNB-CU-UP id")
+	duId := fs.String("du", "", "gNB-DU id")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	var nb *entities.NodebInfo
+	if len(positional) == 1 {
+		nb, err = c.Reader.GetNodeb(positional[0])
+	} else if len(positional) == 0 && *nodeType != "" {
+		var t entities.Node_Type
+		t, err = parseNodeType(*nodeType)
+		if err != nil {
+			return err
+		}
+		nb, err = c.Reader.GetNodebByGlobalNbId(t, &entities.GlobalNbId{PlmnId: *plmnId, NbId: *nbId}, *cuupId, *duId)
+	} else {
+		return errUsage
+	}
+	if err != nil {
+		return err
+	}
+	return printResult(c, nb, func() table {
+		return nodebTable(nb)
+	})
+}
+
+func nodebList(c *commandContext, args []string) error {
+	fs := newFlagSet(c, "nodeb list")
+	nodeType := fs.String("type", "", "list only the given node type: gnb or enb")
+	_, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	var types []entities.Node_Type
+	if *nodeType == "" {
+		types = []entities.Node_Type{entities.Node_ENB, entities.Node_GNB}
+	} else {
+		t, err := parseNodeType(*nodeType)
+		if err != nil {
+			return err
+		}
+		types = []entities.Node_Type{t}
+	}
+	var ids []proto.Message
+	t := table{{"NAME", "TYPE", "PLMN ID", "NB ID", "CONNECTION STATUS"}}
+	for _, nt := range types {
+		var list []*entities.NbIdentity
+		if nt == entities.Node_ENB {
+			list, err = c.Reader.GetListEnbIds()
+		} else {
+			list, err = c.Reader.GetListGnbIds()
+		}
+		if err != nil {
+			return err
+		}
+		for _, id := range list {
+			ids = append(ids, id)
+			t = append(t, []string{id.GetInventoryName(), nt.String(), id.GetGlobalNbId().GetPlmnId(), id.GetGlobalNbId().GetNbId(), id.GetConnectionStatus().String()})
+		}
+	}
+	return printResult(c, ids, func() table {
+		return t
+	})
+}
+
+func cellGet(c *commandContext, args []string) error {
+	fs := newFlagSet(c, "cell get")
+	nrCellId := fs.String("nr", "", "NR cell id")
+	lteCellId := fs.String("lte", "", "LTE cell id")
+	pci := fs.String("pci", "", "cell pci of the given nodeb")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	var cell *entities.Cell
+	switch {
+	case *nrCellId != "" && len(positional) == 0:
+		cell, err = c.Reader.GetCellById(entities.Cell_NR_CELL, *nrCellId)
+	case *lteCellId != "" && len(positional) == 0:
+		cell, err = c.Reader.GetCellById(entities.Cell_LTE_CELL, *lteCellId)
+	case *pci != "" && len(positional) == 1:
+		var value uint64
+		value, err = strconv.ParseUint(*pci, 0, 32)
+		if err != nil {
+			return fmt.Errorf("invalid pci: %s", *pci)
+		}
+		cell, err = c.Reader.GetCell(positional[0], uint32(value))
+	default:
+		return errUsage
+	}
+	if err != nil {
+		return err
+	}
+	return printResult(c, cell, func() table {
+		t := table{cellHeader()}
+		if cell.GetServedNrCell() != nil {
+			return append(t, nrCellRow(cell.GetServedNrCell()))
+		}
+		return append(t, lteCellRow(cell.GetServedCellInfo()))
+	})
+}
+
+func cellList(c *commandContext, args []string) error {
+	positional, err := parseInterspersed(newFlagSet(c, "cell list"), args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errUsage
+	}
+	cells, err := c.Reader.GetCellList(positional[0])
+	if err != nil {
+		return err
+	}
+	return printResult(c, cells, func() table {
+		t := table{cellHeader()}
+		for _, cell := range cells.GetServedCellInfos().GetServedCells() {
+			t = append(t, lteCellRow(cell))
+		}
+		for _, cell := range cells.GetServedNrCells().GetServedCells() {
+			t = append(t, nrCellRow(cell))
+		}
+		return t
+	})
+}
+
+func e2tGet(c *commandContext, args []string) error {
+	positional, err := parseInterspersed(newFlagSet(c, "e2t get"), args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errUsage
+	}
+	e2tInstance, err := c.Reader.GetE2TInstance(positional[0])
+	if err != nil {
+		return err
+	}
+	return printResult(c, e2tInstance, func() table {
+		return table{e2tHeader(), e2tRow(e2tInstance)}
+	})
+}
+
+func e2tList(c *commandContext, args []string) error {
+	_, err := parseInterspersed(newFlagSet(c, "e2t list"), args)
+	if err != nil {
+		return err
+	}
+	addresses, err := c.Reader.GetE2TAddresses()
+	if err != nil {
+		return err
+	}
+	e2tInstances := []*entities.E2TInstance{}
+	if len(addresses) > 0 {
+		e2tInstances, err = c.Reader.GetE2TInstances(addresses)
+		if err != nil {
+			return err
+		}
+	}
+	return printResult(c, e2tInstances, func() table {
+		t := table{e2tHeader()}
+		for _, e2tInstance := range e2tInstances {
+			t = append(t, e2tRow(e2tInstance))
+		}
+		return t
+	})
+}
+
+func loadGet(c *commandContext, args []string) error {
+	positional, err := parseInterspersed(newFlagSet(c, "load get"), args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errUsage
+	}
+	loadInfo, err := c.Reader.GetRanLoadInformation(positional[0])
+	if err != nil {
+		return err
+	}
+	return printResult(c, loadInfo, func() table {
+		t := table{{"CELL ID", "UL INTERFERENCE OVERLOAD INDICATIONS", "UL HIGH INTERFERENCE INFOS", "LOAD TIMESTAMP"}}
+		for _, cell := range loadInfo.GetCellLoadInfos() {
+			t = append(t, []string{cell.GetCellId(), strconv.Itoa(len(cell.GetUlInterferenceOverloadIndications())), strconv.Itoa(len(cell.GetUlHighInterferenceInfos())), strconv.FormatUint(loadInfo.GetLoadTimestamp(), 10)})
+		}
+		return t
+	})
+}
+
+func configGet(c *commandContext, args []string) error {
+	_, err := parseInterspersed(newFlagSet(c, "config get"), args)
+	if err != nil {
+		return err
+	}
+	config, err := c.Reader.GetGeneralConfiguration()
+	if err != nil {
+		return err
+	}
+	return printResult(c, config, func() table {
+		return table{{"ENABLE RIC"}, {strconv.FormatBool(config.EnableRic)}}
+	})
+}
+
+func parseNodeType(nodeType string) (entities.Node_Type, error) {
+	t, ok := entities.Node_Type_value[strings.ToUpper(nodeType)]
+	if !ok || t == int32(entities.Node_UNKNOWN) {
+		return entities.Node_UNKNOWN, fmt.Errorf("invalid node type: %s", nodeType)
+	}
+	return entities.Node_Type(t), nil
+}
+
+func nodebTable(nb *entities.NodebInfo) table {
+	cellCount := len(nb.GetEnb().GetServedCells()) + len(nb.GetGnb().GetServedNrCells())
+	return table{
+		{"FIELD", "VALUE"},
+		{"Name", nb.GetRanName()},
+		{"Node type", nb.GetNodeType().String()},
+		{"Connection status", nb.GetConnectionStatus().String()},
+		{"Address", fmt.Sprintf("%s:%d", nb.GetIp(), nb.GetPort())},
+		{"PLMN id", nb.GetGlobalNbId().GetPlmnId()},
+		{"NB id", nb.GetGlobalNbId().GetNbId()},
+		{"gNB node type", nb.GetGnbNodeType()},
+		{"CU-UP id", nb.GetCuUpId()},
+		{"DU id", nb.GetDuId()},
+		{"E2T instance", nb.GetAssociatedE2TInstanceAddress()},
+		{"Setup from network", strconv.FormatBool(nb.GetSetupFromNetwork())},
+		{"Served cells", strconv.Itoa(cellCount)},
+		{"RAN functions", strconv.Itoa(len(nb.GetGnb().GetRanFunctions()))},
+	}
+}
+
+func cellHeader() []string {
+	return []string{"TYPE", "CELL ID", "PCI", "TAC", "PLMNS"}
+}
+
+func lteCellRow(cell *entities.ServedCellInfo) []string {
+	return []string{entities.Cell_LTE_CELL.String(), cell.GetCellId(), strconv.FormatUint(uint64(cell.GetPci()), 10), cell.GetTac(), strings.Join(cell.GetBroadcastPlmns(), ",")}
+}
+
+func nrCellRow(cell *entities.ServedNRCell) []string {
+	info := cell.GetServedNrCellInformation()
+	return []string{entities.Cell_NR_CELL.String(), info.GetCellId(), strconv.FormatUint(uint64(info.GetNrPci()), 10), info.GetStac5G(), strings.Join(info.GetServedPlmns(), ",")}
+}
+
+func e2tHeader() []string {
+	return []string{"ADDRESS", "POD NAME", "STATE", "ASSOCIATED RANS"}
+}
+
+func e2tRow(e2tInstance *entities.E2TInstance) []string {
+	return []string{e2tInstance.Address, e2tInstance.PodName, string(e2tInstance.State), strings.Join(e2tInstance.AssociatedRanList, ",")}
+}
@@ -0,0 +1,37 @@
+module gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/ctl
+
+go 1.17
+
+require (
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/consistency v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/snapshot v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/topology v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer v1.2.7
+	github.com/golang/protobuf v1.4.2
+	github.com/stretchr/testify v1.3.0
+	google.golang.org/protobuf v1.23.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/stretchr/objx v0.1.0 // indirect
+)
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common => ../common
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/consistency => ../consistency
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities => ../entities
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader => ../reader
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/snapshot => ../snapshot
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/topology => ../topology
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer => ../writer
@@ -0,0 +1,143 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package ctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	OutputTable = "table"
+	OutputJson  = "json"
+	OutputYaml  = "yaml"
+)
+
+/*
+table is a list of rows printed as aligned columns, the first row being the header
+*/
+type table [][]string
+
+/*
+printResult prints the result according to the requested output format. Protobuf messages and lists of them
+are encoded with protojson, other values with encoding/json. The table builder is only called for table output.
+*/
+func printResult(c *commandContext, result interface{}, buildTable func() table) error {
+	switch c.output {
+	case OutputTable:
+		return printTable(c.Out, buildTable())
+	case OutputJson:
+		data, err := marshalJson(result)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(c.Out, string(data))
+		return err
+	case OutputYaml:
+		data, err := marshalJson(result)
+		if err != nil {
+			return err
+		}
+		data, err = jsonToYaml(data)
+		if err != nil {
+			return err
+		}
+		_, err = c.Out.Write(data)
+		return err
+	}
+	return fmt.Errorf("unknown output format: %s", c.output)
+}
+
+func printTable(w io.Writer, t table) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	for _, row := range t {
+		_, err := fmt.Fprintln(tw, strings.Join(row, "\t"))
+		if err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func marshalJson(result interface{}) ([]byte, error) {
+	raw, err := toRawJson(result)
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	err = json.Indent(&out, raw, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func toRawJson(result interface{}) (json.RawMessage, error) {
+	switch r := result.(type) {
+	case proto.Message:
+		return protojson.Marshal(proto.MessageV2(r))
+	case []proto.Message:
+		list := make([]json.RawMessage, 0, len(r))
+		for _, m := range r {
+			raw, err := toRawJson(m)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, raw)
+		}
+		return json.Marshal(list)
+	case map[string]interface{}:
+		object := make(map[string]json.RawMessage, len(r))
+		for k, v := range r {
+			raw, err := toRawJson(v)
+			if err != nil {
+				return nil, err
+			}
+			object[k] = raw
+		}
+		return json.Marshal(object)
+	}
+	return json.Marshal(result)
+}
+
+func jsonToYaml(data []byte) ([]byte, error) {
+	var node yaml.Node
+	err := yaml.Unmarshal(data, &node)
+	if err != nil {
+		return nil, err
+	}
+	resetStyle(&node)
+	return yaml.Marshal(&node)
+}
+
+// resetStyle drops the flow style and quoting the YAML decoder keeps from the JSON input
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		resetStyle(n)
+	}
+}
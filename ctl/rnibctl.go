@@ -0,0 +1,159 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package ctl
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"io"
+	"sort"
+	"strings"
+)
+
+/*
+Environment holds the R-NIB access and the streams the commands run with
+*/
+type Environment struct {
+	Reader  reader.RNibReader
+	Storage common.ISdlSyncStorage
+	In      io.Reader
+	Out     io.Writer
+	Err     io.Writer
+}
+
+// errUsage is returned by the command handlers when they are given invalid arguments
+var errUsage = errors.New("invalid arguments")
+
+type commandContext struct {
+	*Environment
+	output string
+}
+
+type commandHandler func(c *commandContext, args []string) error
+
+type command struct {
+	usage   string
+	handler commandHandler
+}
+
+var commands = map[string]map[string]command{
+	"nodeb": {
+		"get":  {usage: "nodeb get <inventory name> | nodeb get --type gnb|enb --plmn <plmn id> --nbid <nb id> [--cuup <cu-up id>] [--du <du id>]", handler: nodebGet},
+		"list": {usage: "nodeb list [--type gnb|enb]", handler: nodebList},
+	},
+	"cell": {
+		"get":  {usage: "cell get --nr <cell id> | cell get --lte <cell id> | cell get <inventory name> --pci <pci>", handler: cellGet},
+		"list": {usage: "cell list <inventory name>", handler: cellList},
+	},
+	"e2t": {
+		"get":  {usage: "e2t get <address>", handler: e2tGet},
+		"list": {usage: "e2t list", handler: e2tList},
+	},
+	"load": {
+		"get": {usage: "load get <inventory name>", handler: loadGet},
+	},
+	"config": {
+		"get": {usage: "config get", handler: configGet},
+	},
+	"pci": {
+		"check": {usage: "pci check", handler: pciCheck},
+	},
+	"rnib": {
+		"check": {usage: "rnib check [--repair]", handler: rnibCheck},
+	},
+	"snapshot": {
+		"export": {usage: "snapshot export [--nodeb <inventory name>]... [<file>]", handler: snapshotExport},
+		"import": {usage: "snapshot import [--clear | --nodeb <inventory name>...] [<file>]", handler: snapshotImport},
+	},
+}
+
+/*
+Run executes the rnibctl command line given without the program name
+*/
+func Run(env *Environment, args []string) error {
+	fs := flag.NewFlagSet("rnibctl", flag.ContinueOnError)
+	fs.SetOutput(env.Err)
+	output := fs.String("o", OutputTable, "output format: table, json or yaml")
+	fs.Usage = func() {
+		printUsage(env.Err)
+	}
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() < 2 {
+		printUsage(env.Err)
+		return fmt.Errorf("missing command")
+	}
+	verbs, ok := commands[fs.Arg(0)]
+	if !ok {
+		printUsage(env.Err)
+		return fmt.Errorf("unknown command: %s", fs.Arg(0))
+	}
+	cmd, ok := verbs[fs.Arg(1)]
+	if !ok {
+		printUsage(env.Err)
+		return fmt.Errorf("unknown command: %s %s", fs.Arg(0), fs.Arg(1))
+	}
+	err = cmd.handler(&commandContext{Environment: env, output: *output}, fs.Args()[2:])
+	if err == errUsage {
+		return fmt.Errorf("usage: rnibctl %s", cmd.usage)
+	}
+	return err
+}
+
+func printUsage(w io.Writer) {
+	var usages []string
+	for _, verbs := range commands {
+		for _, cmd := range verbs {
+			usages = append(usages, cmd.usage)
+		}
+	}
+	sort.Strings(usages)
+	_, _ = fmt.Fprintf(w, "Usage: rnibctl [-o table|json|yaml] <command>\n\nCommands:\n  %s\n", strings.Join(usages, "\n  "))
+}
+
+/*
+parseInterspersed parses the command flags wherever they appear among the positional arguments and returns the latter
+*/
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+func newFlagSet(c *commandContext, name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.Err)
+	fs.StringVar(&c.output, "o", c.output, "output format: table, json or yaml")
+	return fs
+}
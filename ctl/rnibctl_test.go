@@ -0,0 +1,260 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package ctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer"
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func initEnvironment(t *testing.T) (*Environment, *bytes.Buffer) {
+	storage := common.NewInMemorySdlSyncStorage()
+	w := writer.GetNewRNibWriter(storage)
+	gnb := &entities.NodebInfo{
+		RanName:          "gnb_1",
+		NodeType:         entities.Node_GNB,
+		ConnectionStatus: entities.ConnectionStatus_CONNECTED,
+		GlobalNbId:       &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"},
+		Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{ServedNrCells: []*entities.ServedNRCell{
+			{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: "nrcell1", NrPci: 5, ServedPlmns: []string{"02f829"}}},
+		}}},
+	}
+	enb := &entities.NodebInfo{
+		RanName:          "enb_1",
+		NodeType:         entities.Node_ENB,
+		ConnectionStatus: entities.ConnectionStatus_DISCONNECTED,
+		GlobalNbId:       &entities.GlobalNbId{PlmnId: "02f829", NbId: "007a80"},
+		Configuration: &entities.NodebInfo_Enb{Enb: &entities.Enb{ServedCells: []*entities.ServedCellInfo{
+			{CellId: "ltecell1", Pci: 7, Tac: "0102"},
+		}}},
+	}
+	for _, nb := range []*entities.NodebInfo{gnb, enb} {
+		assert.Nil(t, w.SaveNodeb(nb))
+		assert.Nil(t, w.AddNbIdentity(nb.GetNodeType(), &entities.NbIdentity{InventoryName: nb.GetRanName(), GlobalNbId: nb.GetGlobalNbId(), ConnectionStatus: nb.GetConnectionStatus()}))
+	}
+	e2tInstance := entities.NewE2TInstance("10.0.2.15:3213", "e2term")
+	e2tInstance.AssociatedRanList = []string{"gnb_1"}
+	assert.Nil(t, w.SaveE2TInstance(e2tInstance))
+	assert.Nil(t, w.SaveE2TAddresses([]string{"10.0.2.15:3213"}))
+	assert.Nil(t, w.SaveRanLoadInformation("enb_1", &entities.RanLoadInformation{LoadTimestamp: 5, CellLoadInfos: []*entities.CellLoadInformation{{CellId: "ltecell1"}}}))
+	assert.Nil(t, w.SaveGeneralConfiguration(&entities.GeneralConfiguration{EnableRic: true}))
+	out := &bytes.Buffer{}
+	return &Environment{Reader: reader.GetNewRNibReader(storage), Storage: storage, Out: out, Err: &bytes.Buffer{}}, out
+}
+
+func TestNodebGetTable(t *testing.T) {
+	env, out := initEnvironment(t)
+	err := Run(env, []string{"nodeb", "get", "gnb_1"})
+	assert.Nil(t, err)
+	assert.Contains(t, out.String(), "Connection status    CONNECTED")
+	assert.Contains(t, out.String(), "Served cells         1")
+}
+
+func TestNodebGetJson(t *testing.T) {
+	env, out := initEnvironment(t)
+	err := Run(env, []string{"nodeb", "get", "gnb_1", "-o", "json"})
+	assert.Nil(t, err)
+	var nb map[string]interface{}
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &nb))
+	assert.Equal(t, "gnb_1", nb["ranName"])
+	assert.Equal(t, "CONNECTED", nb["connectionStatus"])
+}
+
+func TestNodebGetByGlobalNbIdYaml(t *testing.T) {
+	env, out := initEnvironment(t)
+	err := Run(env, []string{"-o", "yaml", "nodeb", "get", "--type", "enb", "--plmn", "02f829", "--nbid", "007a80"})
+	assert.Nil(t, err)
+	assert.Contains(t, out.String(), "ranName: enb_1\n")
+	assert.Contains(t, out.String(), "nbId: 007a80\n")
+	assert.Contains(t, out.String(), "tac: \"0102\"\n")
+}
+
+func TestNodebGetNotFound(t *testing.T) {
+	env, _ := initEnvironment(t)
+	err := Run(env, []string{"nodeb", "get", "missing"})
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+}
+
+func TestNodebGetUsage(t *testing.T) {
+	env, _ := initEnvironment(t)
+	err := Run(env, []string{"nodeb", "get"})
+	assert.NotNil(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "usage: rnibctl nodeb get"))
+}
+
+func TestNodebList(t *testing.T) {
+	env, out := initEnvironment(t)
+	err := Run(env, []string{"nodeb", "list"})
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[1], "enb_1"))
+	assert.True(t, strings.HasPrefix(lines[2], "gnb_1"))
+}
+
+func TestNodebListByTypeJson(t *testing.T) {
+	env, out := initEnvironment(t)
+	err := Run(env, []string{"nodeb", "list", "--type", "gnb", "-o", "json"})
+	assert.Nil(t, err)
+	var ids []map[string]interface{}
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &ids))
+	assert.Len(t, ids, 1)
+	assert.Equal(t, "gnb_1", ids[0]["inventoryName"])
+}
+
+func TestNodebListInvalidType(t *testing.T) {
+	env, _ := initEnvironment(t)
+	err := Run(env, []string{"nodeb", "list", "--type", "xnb"})
+	assert.EqualError(t, err, "invalid node type: xnb")
+}
+
+func TestCellGet(t *testing.T) {
+	env, out := initEnvironment(t)
+	assert.Nil(t, Run(env, []string{"cell", "get", "--nr", "nrcell1"}))
+	assert.Contains(t, out.String(), "nrcell1")
+	out.Reset()
+	assert.Nil(t, Run(env, []string{"cell", "get", "--lte", "ltecell1"}))
+	assert.Contains(t, out.String(), "ltecell1")
+	out.Reset()
+	assert.Nil(t, Run(env, []string{"cell", "get", "gnb_1", "--pci", "5"}))
+	assert.Contains(t, out.String(), "nrcell1")
+	assert.NotNil(t, Run(env, []string{"cell", "get", "gnb_1", "--pci", "x"}))
+}
+
+func TestCellList(t *testing.T) {
+	env, out := initEnvironment(t)
+	err := Run(env, []string{"cell", "list", "enb_1"})
+	assert.Nil(t, err)
+	assert.Contains(t, out.String(), "LTE_CELL   ltecell1   7     0102")
+}
+
+func TestE2tList(t *testing.T) {
+	env, out := initEnvironment(t)
+	assert.Nil(t, Run(env, []string{"e2t", "list"}))
+	assert.Contains(t, out.String(), "10.0.2.15:3213   e2term     ACTIVE   gnb_1")
+	out.Reset()
+	assert.Nil(t, Run(env, []string{"e2t", "get", "10.0.2.15:3213", "-o", "json"}))
+	assert.Contains(t, out.String(), `"podName": "e2term"`)
+}
+
+func TestLoadGet(t *testing.T) {
+	env, out := initEnvironment(t)
+	err := Run(env, []string{"load", "get", "enb_1"})
+	assert.Nil(t, err)
+	assert.Contains(t, out.String(), "ltecell1")
+}
+
+func TestConfigGet(t *testing.T) {
+	env, out := initEnvironment(t)
+	err := Run(env, []string{"-o", "yaml", "config", "get"})
+	assert.Nil(t, err)
+	assert.Equal(t, "enableRic: true\n", out.String())
+}
+
+func TestPciCheck(t *testing.T) {
+	env, out := initEnvironment(t)
+	err := Run(env, []string{"pci", "check"})
+	assert.Nil(t, err)
+	assert.Equal(t, "ISSUE   CELL   NAME   PCI   ARFCN   NEIGHBOURS\n", out.String())
+
+	w := writer.GetNewRNibWriter(env.Storage)
+	enb := &entities.NodebInfo{
+		RanName:  "enb_2",
+		NodeType: entities.Node_ENB,
+		Configuration: &entities.NodebInfo_Enb{Enb: &entities.Enb{ServedCells: []*entities.ServedCellInfo{
+			{CellId: "02f829:007a81f0", Pci: 7, NeighbourInfos: []*entities.NeighbourInformation{{Ecgi: "02f829:007a8200", Pci: 7}}},
+		}}},
+	}
+	assert.Nil(t, w.SaveNodeb(enb))
+	assert.Nil(t, w.AddNbIdentity(entities.Node_ENB, &entities.NbIdentity{InventoryName: "enb_2"}))
+	out.Reset()
+	err = Run(env, []string{"pci", "check", "-o", "json"})
+	assert.Nil(t, err)
+	var report map[string][]interface{}
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &report))
+	assert.Len(t, report["collisions"], 1)
+	assert.Empty(t, report["confusions"])
+	assert.EqualError(t, Run(env, []string{"pci", "check", "enb_2"}), "usage: rnibctl pci check")
+}
+
+func TestUnknownCommand(t *testing.T) {
+	env, _ := initEnvironment(t)
+	assert.NotNil(t, Run(env, []string{"nodeb", "delete", "gnb_1"}))
+	assert.NotNil(t, Run(env, []string{"ran"}))
+	assert.EqualError(t, Run(env, []string{"-o", "xml", "config", "get"}), "unknown output format: xml")
+}
+
+func TestRnibCheck(t *testing.T) {
+	env, out := initEnvironment(t)
+	err := Run(env, []string{"rnib", "check"})
+	assert.Nil(t, err)
+	assert.Contains(t, out.String(), "e2t-association-mismatch   E2TInstance:10.0.2.15:3213   gnb_1")
+	assert.Contains(t, out.String(), "available")
+
+	out.Reset()
+	err = Run(env, []string{"rnib", "check", "--repair", "-o", "json"})
+	assert.Nil(t, err)
+	var report struct {
+		Issues []map[string]interface{} `json:"issues"`
+	}
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &report))
+	assert.Len(t, report.Issues, 1)
+	assert.Equal(t, true, report.Issues[0]["repaired"])
+
+	out.Reset()
+	err = Run(env, []string{"rnib", "check"})
+	assert.Nil(t, err)
+	assert.Equal(t, "KIND   KEY   MEMBER   MESSAGE   REPAIR\n", out.String())
+	assert.EqualError(t, Run(env, []string{"rnib", "check", "now"}), "usage: rnibctl rnib check [--repair]")
+}
+
+func TestSnapshotExportImport(t *testing.T) {
+	env, out := initEnvironment(t)
+	err := Run(env, []string{"snapshot", "export", "--nodeb", "gnb_1"})
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(out.String(), `{"format":"rnib-snapshot","version":1,"namespace":"e2Manager","keys":6}`))
+
+	file := filepath.Join(t.TempDir(), "rnib.jsonl")
+	assert.Nil(t, Run(env, []string{"snapshot", "export", file}))
+	storage := common.NewInMemorySdlSyncStorage()
+	restored := &Environment{Reader: reader.GetNewRNibReader(storage), Storage: storage, In: bytes.NewReader(out.Bytes()), Out: &bytes.Buffer{}, Err: &bytes.Buffer{}}
+	assert.Nil(t, Run(restored, []string{"snapshot", "import"}))
+	_, err = restored.Reader.GetNodeb("gnb_1")
+	assert.Nil(t, err)
+	_, err = restored.Reader.GetNodeb("enb_1")
+	assert.NotNil(t, err)
+
+	assert.Nil(t, Run(restored, []string{"snapshot", "import", "--clear", file}))
+	_, err = restored.Reader.GetNodeb("enb_1")
+	assert.Nil(t, err)
+	restored.In = nil
+	assert.EqualError(t, Run(restored, []string{"snapshot", "import"}), "usage: rnibctl snapshot import [--clear | --nodeb <inventory name>...] [<file>]")
+	assert.EqualError(t, Run(restored, []string{"snapshot", "import", "--clear", "--nodeb", "gnb_1", file}), "usage: rnibctl snapshot import [--clear | --nodeb <inventory name>...] [<file>]")
+	_, err = restored.Reader.GetNodeb("enb_1")
+	assert.Nil(t, err)
+}
@@ -0,0 +1,92 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package ctl
+
+import (
+	"context"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/snapshot"
+	"os"
+	"strings"
+)
+
+// stringList is a flag which may be repeated, each occurrence appending its value
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func snapshotExport(c *commandContext, args []string) error {
+	var options snapshot.Options
+	fs := newFlagSet(c, "snapshot export")
+	fs.Var((*stringList)(&options.Nodebs), "nodeb", "inventory name of a nodeb to restrict the snapshot to, may be repeated")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return errUsage
+	}
+	snapshotter := snapshot.GetNewSnapshotter(c.Storage, options)
+	if len(positional) == 0 {
+		return snapshotter.Export(context.Background(), c.Out)
+	}
+	file, err := os.Create(positional[0])
+	if err != nil {
+		return err
+	}
+	err = snapshotter.Export(context.Background(), file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func snapshotImport(c *commandContext, args []string) error {
+	var options snapshot.Options
+	fs := newFlagSet(c, "snapshot import")
+	fs.Var((*stringList)(&options.Nodebs), "nodeb", "inventory name of a nodeb to restrict the import to, may be repeated")
+	fs.BoolVar(&options.Clear, "clear", false, "remove every R-NIB key before importing, not allowed with --nodeb")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 || (len(positional) == 0 && c.In == nil) || (options.Clear && len(options.Nodebs) > 0) {
+		return errUsage
+	}
+	r := c.In
+	if len(positional) == 1 {
+		file, err := os.Open(positional[0])
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+		r = file
+	}
+	return snapshot.GetNewSnapshotter(c.Storage, options).Import(context.Background(), r)
+}
@@ -0,0 +1,229 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+/*
+aperReader decodes the ASN.1 ALIGNED PER (X.691) primitives used by the E2SM RAN function definitions.
+The first failure is kept in err and turns all the subsequent reads into no-ops returning zero values,
+so decoders read a whole structure and check err once.
+*/
+type aperReader struct {
+	data   []byte
+	offset int
+	err    error
+}
+
+func newAperReader(data []byte) *aperReader {
+	return &aperReader{data: data}
+}
+
+func (r *aperReader) fail(format string, a ...interface{}) {
+	if r.err == nil {
+		r.err = fmt.Errorf("#aperReader - "+format+" at bit offset %d", append(a, r.offset)...)
+	}
+}
+
+func (r *aperReader) readBits(n int) uint64 {
+	if r.err != nil {
+		return 0
+	}
+	if n > 64 {
+		r.fail("cannot read %d bits into an integer", n)
+		return 0
+	}
+	if r.offset+n > len(r.data)*8 {
+		r.fail("unexpected end of data reading %d bits", n)
+		return 0
+	}
+	var value uint64
+	for i := 0; i < n; i++ {
+		bit := r.data[r.offset/8] >> (7 - uint(r.offset%8)) & 1
+		value = value<<1 | uint64(bit)
+		r.offset++
+	}
+	return value
+}
+
+func (r *aperReader) readBool() bool {
+	return r.readBits(1) == 1
+}
+
+func (r *aperReader) align() {
+	r.offset = (r.offset + 7) / 8 * 8
+}
+
+func (r *aperReader) readOctets(n int) []byte {
+	r.align()
+	if r.err != nil {
+		return nil
+	}
+	if r.offset/8+n > len(r.data) {
+		r.fail("unexpected end of data reading %d octets", n)
+		return nil
+	}
+	octets := r.data[r.offset/8 : r.offset/8+n]
+	r.offset += n * 8
+	return octets
+}
+
+// readConstrainedWholeNumber decodes an integer constrained to lb..ub (X.691 11.5.7)
+func (r *aperReader) readConstrainedWholeNumber(lb uint64, ub uint64) uint64 {
+	valueRange := ub - lb + 1
+	var value uint64
+	switch {
+	case valueRange == 1:
+		return lb
+	case valueRange <= 255:
+		value = r.readBits(bits.Len64(valueRange - 1))
+	case valueRange == 256:
+		r.align()
+		value = r.readBits(8)
+	case valueRange <= 65536:
+		r.align()
+		value = r.readBits(16)
+	default:
+		octets := r.readConstrainedWholeNumber(1, uint64((bits.Len64(valueRange-1)+7)/8))
+		r.align()
+		value = r.readBits(int(octets) * 8)
+	}
+	if value > ub-lb {
+		r.fail("value %d exceeds the range %d..%d", lb+value, lb, ub)
+		return 0
+	}
+	return lb + value
+}
+
+// readUnconstrainedLength decodes an octet aligned length determinant (X.691 11.9.3.6 - 11.9.3.7), fragmentation is not supported
+func (r *aperReader) readUnconstrainedLength() int {
+	r.align()
+	first := r.readBits(8)
+	switch {
+	case first&0x80 == 0:
+		return int(first)
+	case first&0xc0 == 0x80:
+		return int(first&0x3f)<<8 | int(r.readBits(8))
+	}
+	r.fail("fragmented lengths are not supported")
+	return 0
+}
+
+// readLength decodes the length of a SEQUENCE OF or string whose size is constrained to lb..ub
+func (r *aperReader) readLength(lb int, ub int, extensible bool) int {
+	if extensible && r.readBool() {
+		return r.readUnconstrainedLength()
+	}
+	if ub >= 65536 {
+		return r.readUnconstrainedLength()
+	}
+	return int(r.readConstrainedWholeNumber(uint64(lb), uint64(ub)))
+}
+
+// readInteger decodes an unconstrained INTEGER
+func (r *aperReader) readInteger() int64 {
+	octets := r.readOctets(r.readUnconstrainedLength())
+	if r.err != nil {
+		return 0
+	}
+	if len(octets) == 0 || len(octets) > 8 {
+		r.fail("unsupported integer length %d", len(octets))
+		return 0
+	}
+	value := int64(int8(octets[0]))
+	for _, octet := range octets[1:] {
+		value = value<<8 | int64(octet)
+	}
+	return value
+}
+
+// readExtensibleInteger decodes an INTEGER (lb..ub, ...)
+func (r *aperReader) readExtensibleInteger(lb uint64, ub uint64) int64 {
+	if r.readBool() {
+		return r.readInteger()
+	}
+	return int64(r.readConstrainedWholeNumber(lb, ub))
+}
+
+/*
+readBitString decodes a BIT STRING (SIZE(lb..ub)) of up to 64 bits into an integer, the first bit the most significant.
+The length determinant is left out for a fixed size, and the bits are octet aligned when ub is above 16 (X.691 16.9 - 16.11).
+*/
+func (r *aperReader) readBitString(lb int, ub int) (uint64, int) {
+	length := int(r.readConstrainedWholeNumber(uint64(lb), uint64(ub)))
+	if ub > 16 {
+		r.align()
+	}
+	return r.readBits(length), length
+}
+
+/*
+readPrintableString decodes a PrintableString (SIZE(lb..ub, ...)). The characters take an octet each in the aligned variant
+and, as ub is above two characters for all the E2SM strings, they are octet aligned.
+*/
+func (r *aperReader) readPrintableString(lb int, ub int) string {
+	return string(r.readOctets(r.readLength(lb, ub, true)))
+}
+
+/*
+readSequencePreamble decodes the extension bit of an extensible SEQUENCE and the presence bitmap of its optional components.
+*/
+func (r *aperReader) readSequencePreamble(extensible bool, optionals int) (bool, []bool) {
+	extended := extensible && r.readBool()
+	present := make([]bool, optionals)
+	for i := range present {
+		present[i] = r.readBool()
+	}
+	return extended, present
+}
+
+/*
+skipExtensions skips the extension additions of a SEQUENCE whose extension bit is set (X.691 19.7 - 19.9).
+Each addition is an open type, so it is skipped without knowing its type.
+*/
+func (r *aperReader) skipExtensions() {
+	var additions int
+	if r.readBool() {
+		additions = r.readUnconstrainedLength()
+	} else {
+		additions = int(r.readBits(6)) + 1
+	}
+	present := make([]bool, 0, additions)
+	for i := 0; i < additions && r.err == nil; i++ {
+		present = append(present, r.readBool())
+	}
+	for _, isPresent := range present {
+		if isPresent {
+			r.readOctets(r.readUnconstrainedLength())
+		}
+	}
+}
+
+// readSequenceOf decodes the length of a SEQUENCE (SIZE(lb..ub)) OF and calls readItem for each of its items
+func (r *aperReader) readSequenceOf(lb int, ub int, readItem func()) {
+	length := r.readLength(lb, ub, false)
+	for i := 0; i < length && r.err == nil; i++ {
+		readItem()
+	}
+}
@@ -0,0 +1,216 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/bits"
+	"testing"
+)
+
+// aperWriter encodes the aligned PER primitives read by aperReader, to build the test definitions
+type aperWriter struct {
+	data   []byte
+	offset int
+}
+
+func (w *aperWriter) writeBits(value uint64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		if w.offset%8 == 0 {
+			w.data = append(w.data, 0)
+		}
+		w.data[len(w.data)-1] |= byte(value>>uint(i)&1) << (7 - uint(w.offset%8))
+		w.offset++
+	}
+}
+
+func (w *aperWriter) writeBool(value bool) {
+	if value {
+		w.writeBits(1, 1)
+	} else {
+		w.writeBits(0, 1)
+	}
+}
+
+func (w *aperWriter) align() {
+	w.offset = (w.offset + 7) / 8 * 8
+}
+
+func (w *aperWriter) writeOctets(octets []byte) {
+	w.align()
+	for _, octet := range octets {
+		w.writeBits(uint64(octet), 8)
+	}
+}
+
+func (w *aperWriter) writeConstrainedWholeNumber(value uint64, lb uint64, ub uint64) {
+	valueRange := ub - lb + 1
+	switch {
+	case valueRange == 1:
+	case valueRange <= 255:
+		w.writeBits(value-lb, bits.Len64(valueRange-1))
+	case valueRange == 256:
+		w.align()
+		w.writeBits(value-lb, 8)
+	case valueRange <= 65536:
+		w.align()
+		w.writeBits(value-lb, 16)
+	default:
+		octets := (bits.Len64(value-lb) + 7) / 8
+		if octets == 0 {
+			octets = 1
+		}
+		w.writeConstrainedWholeNumber(uint64(octets), 1, uint64((bits.Len64(valueRange-1)+7)/8))
+		w.align()
+		w.writeBits(value-lb, octets*8)
+	}
+}
+
+func (w *aperWriter) writeUnconstrainedLength(length int) {
+	w.align()
+	if length < 128 {
+		w.writeBits(uint64(length), 8)
+	} else {
+		w.writeBits(uint64(0x8000|length), 16)
+	}
+}
+
+func (w *aperWriter) writeInteger(value int64) {
+	octets := 1
+	for octets < 8 && (value < -(1<<(uint(octets)*8-1)) || value >= 1<<(uint(octets)*8-1)) {
+		octets++
+	}
+	w.writeUnconstrainedLength(octets)
+	w.align()
+	w.writeBits(uint64(value), octets*8)
+}
+
+func (w *aperWriter) writeExtensibleInteger(value int64, lb uint64, ub uint64) {
+	w.writeBool(false)
+	w.writeConstrainedWholeNumber(uint64(value), lb, ub)
+}
+
+func (w *aperWriter) writePrintableString(value string, lb int, ub int) {
+	w.writeBool(false)
+	w.writeConstrainedWholeNumber(uint64(len(value)), uint64(lb), uint64(ub))
+	w.writeOctets([]byte(value))
+}
+
+func (w *aperWriter) writeBitString(value uint64, length int, lb int, ub int) {
+	w.writeConstrainedWholeNumber(uint64(length), uint64(lb), uint64(ub))
+	if ub > 16 {
+		w.align()
+	}
+	w.writeBits(value, length)
+}
+
+func (w *aperWriter) writeSequenceOfLength(length int, lb int, ub int) {
+	w.writeConstrainedWholeNumber(uint64(length), uint64(lb), uint64(ub))
+}
+
+func (w *aperWriter) writePreamble(extended bool, present ...bool) {
+	w.writeBool(extended)
+	for _, isPresent := range present {
+		w.writeBool(isPresent)
+	}
+}
+
+func TestReadConstrainedWholeNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		lb       uint64
+		ub       uint64
+		expected uint64
+	}{
+		{name: "bit field", data: []byte{0xa0}, lb: 0, ub: 7, expected: 5},
+		{name: "single value", data: []byte{}, lb: 3, ub: 3, expected: 3},
+		{name: "one octet", data: []byte{0x2a}, lb: 0, ub: 255, expected: 42},
+		{name: "two octets", data: []byte{0x01, 0x2b}, lb: 1, ub: 65536, expected: 300},
+		{name: "length prefixed octets", data: []byte{0x80, 0x01, 0x11, 0x6f}, lb: 1, ub: 4294967295, expected: 70000},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newAperReader(tc.data)
+			assert.Equal(t, tc.expected, r.readConstrainedWholeNumber(tc.lb, tc.ub))
+			assert.Nil(t, r.err)
+		})
+	}
+}
+
+func TestReadConstrainedWholeNumberOutOfRange(t *testing.T) {
+	r := newAperReader([]byte{0xff})
+	r.readConstrainedWholeNumber(1, 150)
+	assert.NotNil(t, r.err)
+}
+
+func TestReadInteger(t *testing.T) {
+	assert.EqualValues(t, -1, newAperReader([]byte{0x01, 0xff}).readInteger())
+	assert.EqualValues(t, 128, newAperReader([]byte{0x02, 0x00, 0x80}).readInteger())
+	assert.EqualValues(t, 2, newAperReader([]byte{0x01, 0x02}).readInteger())
+}
+
+func TestReadPrintableString(t *testing.T) {
+	r := newAperReader([]byte{0x01, 0x00, 'K', 'P', 'M'})
+	assert.Equal(t, "KPM", r.readPrintableString(1, 150))
+	assert.Nil(t, r.err)
+}
+
+func TestReadPrintableStringExtendedSize(t *testing.T) {
+	r := newAperReader([]byte{0x80, 0x03, 'K', 'P', 'M'})
+	assert.Equal(t, "KPM", r.readPrintableString(1, 2))
+	assert.Nil(t, r.err)
+}
+
+func TestReadBitString(t *testing.T) {
+	r := newAperReader([]byte{0x00, 0x04, 0xac, 0x94})
+	value, length := r.readBitString(22, 32)
+	assert.EqualValues(t, 0x12b25, value)
+	assert.Equal(t, 22, length)
+	r = newAperReader([]byte{0x80, 0x00, 0x7a, 0x80})
+	assert.True(t, r.readBool())
+	value, length = r.readBitString(20, 20)
+	assert.EqualValues(t, 0x7a8, value)
+	assert.Equal(t, 20, length)
+	assert.Nil(t, r.err)
+}
+
+func TestSkipExtensions(t *testing.T) {
+	w := &aperWriter{}
+	w.writeBool(false)
+	w.writeBits(1, 6)
+	w.writeBool(true)
+	w.writeBool(false)
+	w.writeUnconstrainedLength(2)
+	w.writeOctets([]byte{0xde, 0xad})
+	w.writeInteger(7)
+	r := newAperReader(w.data)
+	r.skipExtensions()
+	assert.EqualValues(t, 7, r.readInteger())
+	assert.Nil(t, r.err)
+}
+
+func TestReadPastEnd(t *testing.T) {
+	r := newAperReader([]byte{0x05, 'a'})
+	assert.Equal(t, "", string(r.readOctets(r.readUnconstrainedLength())))
+	assert.NotNil(t, r.err)
+	assert.EqualValues(t, 0, r.readBits(3))
+}
@@ -0,0 +1,327 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+import (
+	"fmt"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"sync"
+)
+
+// ApPduType tells the elementary procedure message kind of an application protocol PDU
+type ApPduType int
+
+const (
+	InitiatingMessage ApPduType = iota
+	SuccessfulOutcome
+	UnsuccessfulOutcome
+)
+
+func (t ApPduType) String() string {
+	switch t {
+	case InitiatingMessage:
+		return "InitiatingMessage"
+	case SuccessfulOutcome:
+		return "SuccessfulOutcome"
+	case UnsuccessfulOutcome:
+		return "UnsuccessfulOutcome"
+	}
+	return "Unknown"
+}
+
+/*
+ProtocolIe is a protocol IE of an application protocol message. Name and Value are set for the IEs having a registered codec,
+otherwise Value holds the undecoded APER bytes.
+*/
+type ProtocolIe struct {
+	Id          int64
+	Criticality int64
+	Name        string
+	Value       interface{}
+}
+
+/*
+ApPdu is a decoded NGAP, XnAP, E1AP, F1AP, W1AP, S1AP or X2AP PDU, as carried by the request and response parts
+of an E2 node component configuration (e.g. the NG Setup Request and Response).
+*/
+type ApPdu struct {
+	Type          ApPduType
+	ProcedureCode int64
+	Criticality   int64
+	ProtocolIes   []ProtocolIe
+}
+
+// GetValue returns the value of the first protocol IE decoded under name
+func (p *ApPdu) GetValue(name string) (interface{}, bool) {
+	for _, protocolIe := range p.ProtocolIes {
+		if protocolIe.Name == name {
+			return protocolIe.Value, true
+		}
+	}
+	return nil, false
+}
+
+/*
+GlobalNodeId is a decoded X2AP GlobalENB-ID or XnAP GlobalNG-RANNode-ID: the hex string of the PLMN identity octets
+and either the gNB ID or the eNB ID, the eNB type telling macro, home, short or long macro eNBs and ng-eNBs apart.
+*/
+type GlobalNodeId struct {
+	PlmnId string
+	GnbId  *entities.GnbId
+	EnbId  *entities.EnbId
+}
+
+// ProtocolIeCodec decodes the APER encoded value of a protocol IE
+type ProtocolIeCodec struct {
+	Name   string
+	Decode func(value []byte) (interface{}, error)
+}
+
+/*
+DecodedE2NodeComponentConfig is an E2 node component configuration with its request and response parts decoded.
+A part left empty by the E2 node is nil with no error.
+*/
+type DecodedE2NodeComponentConfig struct {
+	Config        *entities.E2NodeComponentConfig
+	InterfaceType entities.E2NodeComponentInterfaceType
+	Request       *ApPdu
+	RequestErr    error
+	Response      *ApPdu
+	ResponseErr   error
+}
+
+/*
+E2NodeComponentDecoder decodes the request and response parts of E2 node component configurations.
+The PDU and its protocol IE container are decoded for every interface, the IE values by the codecs registered per interface and IE id.
+*/
+type E2NodeComponentDecoder struct {
+	mux    sync.RWMutex
+	codecs map[entities.E2NodeComponentInterfaceType]map[int64]ProtocolIeCodec
+}
+
+//GetNewE2NodeComponentDecoder returns reference to an E2NodeComponentDecoder without protocol IE codecs
+func GetNewE2NodeComponentDecoder() *E2NodeComponentDecoder {
+	return &E2NodeComponentDecoder{codecs: map[entities.E2NodeComponentInterfaceType]map[int64]ProtocolIeCodec{}}
+}
+
+//GetDefaultE2NodeComponentDecoder returns reference to an E2NodeComponentDecoder with the codecs of the node name and id IEs,
+//and of the global node id IEs of the X2 and Xn Setup messages
+func GetDefaultE2NodeComponentDecoder() *E2NodeComponentDecoder {
+	decoder := GetNewE2NodeComponentDecoder()
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_ng, 1, ProtocolIeCodec{Name: "AMFName", Decode: decodeNameIe})
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_ng, 82, ProtocolIeCodec{Name: "RANNodeName", Decode: decodeNameIe})
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_e1, 7, ProtocolIeCodec{Name: "gNB-CU-UP-ID", Decode: decodeNodeIdIe})
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_e1, 8, ProtocolIeCodec{Name: "gNB-CU-UP-Name", Decode: decodeNameIe})
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_e1, 9, ProtocolIeCodec{Name: "gNB-CU-CP-Name", Decode: decodeNameIe})
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_f1, 42, ProtocolIeCodec{Name: "gNB-DU-ID", Decode: decodeNodeIdIe})
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_f1, 45, ProtocolIeCodec{Name: "gNB-DU-Name", Decode: decodeNameIe})
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_f1, 82, ProtocolIeCodec{Name: "gNB-CU-Name", Decode: decodeNameIe})
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_s1, 60, ProtocolIeCodec{Name: "eNBname", Decode: decodeNameIe})
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_s1, 61, ProtocolIeCodec{Name: "MMEname", Decode: decodeNameIe})
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_x2, 21, ProtocolIeCodec{Name: "GlobalENB-ID", Decode: decodeGlobalEnbIdIe})
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_xn, 42, ProtocolIeCodec{Name: "GlobalNG-RANnode-ID", Decode: decodeGlobalNgRanNodeIdIe})
+	return decoder
+}
+
+// RegisterProtocolIe adds the codec of the protocol IE id of the interface, replacing any codec registered before for it
+func (d *E2NodeComponentDecoder) RegisterProtocolIe(interfaceType entities.E2NodeComponentInterfaceType, id int64, codec ProtocolIeCodec) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.codecs[interfaceType] == nil {
+		d.codecs[interfaceType] = map[int64]ProtocolIeCodec{}
+	}
+	d.codecs[interfaceType][id] = codec
+}
+
+// Decode decodes the request and response parts of the configuration, a part that cannot be decoded yields a CorruptData InternalError
+func (d *E2NodeComponentDecoder) Decode(config *entities.E2NodeComponentConfig) *DecodedE2NodeComponentConfig {
+	interfaceType := config.InterfaceType()
+	decoded := &DecodedE2NodeComponentConfig{Config: config, InterfaceType: interfaceType}
+	decoded.Request, decoded.RequestErr = d.DecodePart(interfaceType, config.GetE2NodeComponentRequestPart())
+	decoded.Response, decoded.ResponseErr = d.DecodePart(interfaceType, config.GetE2NodeComponentResponsePart())
+	return decoded
+}
+
+// DecodePart decodes an APER encoded PDU of the interface, an empty part yields nil
+func (d *E2NodeComponentDecoder) DecodePart(interfaceType entities.E2NodeComponentInterfaceType, part []byte) (*ApPdu, error) {
+	if len(part) == 0 {
+		return nil, nil
+	}
+	r := newAperReader(part)
+	pdu := readApPdu(r, interfaceType)
+	if r.err != nil {
+		return nil, common.NewCorruptDataError("*entities.E2NodeComponentConfig", interfaceType.String(), r.err)
+	}
+	d.mux.RLock()
+	codecs := d.codecs[interfaceType]
+	d.mux.RUnlock()
+	for i, protocolIe := range pdu.ProtocolIes {
+		codec, ok := codecs[protocolIe.Id]
+		if !ok {
+			continue
+		}
+		value, err := codec.Decode(protocolIe.Value.([]byte))
+		if err != nil {
+			return nil, common.NewCorruptDataError("*entities.E2NodeComponentConfig", interfaceType.String(), err)
+		}
+		pdu.ProtocolIes[i].Name = codec.Name
+		pdu.ProtocolIes[i].Value = value
+	}
+	return pdu, nil
+}
+
+/*
+readApPdu decodes the PDU CHOICE, the elementary procedure message and its protocol IE container, all the application protocols sharing this layout.
+F1AP and W1AP PDUs have a fourth choice-extension alternative instead of an extension marker.
+*/
+func readApPdu(r *aperReader, interfaceType entities.E2NodeComponentInterfaceType) *ApPdu {
+	var pduType uint64
+	switch interfaceType {
+	case entities.E2NodeComponentInterfaceType_f1, entities.E2NodeComponentInterfaceType_w1:
+		pduType = r.readConstrainedWholeNumber(0, 3)
+	default:
+		if r.readBool() {
+			r.fail("extended PDU types are not supported")
+		}
+		pduType = r.readConstrainedWholeNumber(0, 2)
+	}
+	if pduType > uint64(UnsuccessfulOutcome) {
+		r.fail("PDU choice extensions are not supported")
+	}
+	pdu := &ApPdu{
+		Type:          ApPduType(pduType),
+		ProcedureCode: int64(r.readConstrainedWholeNumber(0, 255)),
+		Criticality:   int64(r.readConstrainedWholeNumber(0, 2)),
+	}
+	message := newAperReader(r.readOctets(r.readUnconstrainedLength()))
+	if r.err != nil {
+		return pdu
+	}
+	extended, _ := message.readSequencePreamble(true, 0)
+	message.readSequenceOf(0, 65535, func() {
+		protocolIe := ProtocolIe{
+			Id:          int64(message.readConstrainedWholeNumber(0, 65535)),
+			Criticality: int64(message.readConstrainedWholeNumber(0, 2)),
+		}
+		protocolIe.Value = message.readOctets(message.readUnconstrainedLength())
+		pdu.ProtocolIes = append(pdu.ProtocolIes, protocolIe)
+	})
+	if extended {
+		message.skipExtensions()
+	}
+	r.err = message.err
+	return pdu
+}
+
+// decodeNameIe decodes the node name IEs, all of them PrintableString (SIZE(1..150, ...))
+func decodeNameIe(value []byte) (interface{}, error) {
+	r := newAperReader(value)
+	name := r.readPrintableString(1, 150)
+	return name, r.err
+}
+
+// decodeNodeIdIe decodes the gNB-DU-ID and gNB-CU-UP-ID IEs, both INTEGER (0..68719476735)
+func decodeNodeIdIe(value []byte) (interface{}, error) {
+	r := newAperReader(value)
+	id := int64(r.readConstrainedWholeNumber(0, 68719476735))
+	return id, r.err
+}
+
+/*
+decodeGlobalEnbIdIe decodes the X2AP GlobalENB-ID IE, a SEQUENCE of the PLMN identity and the ENB-ID CHOICE
+of a macro or home eNB ID, extended with the short and long macro eNB IDs. Its extensions are left unread.
+*/
+func decodeGlobalEnbIdIe(value []byte) (interface{}, error) {
+	r := newAperReader(value)
+	r.readSequencePreamble(true, 1)
+	globalNodeId := &GlobalNodeId{PlmnId: readPlmnId(r)}
+	if !r.readBool() {
+		enbTypes := []entities.EnbType{entities.EnbType_MACRO_ENB, entities.EnbType_HOME_ENB}
+		globalNodeId.EnbId = readEnbId(r, enbTypes[r.readConstrainedWholeNumber(0, 1)])
+	} else {
+		enbTypes := []entities.EnbType{entities.EnbType_SHORT_MACRO_ENB, entities.EnbType_LONG_MACRO_ENB}
+		// the extension addition index is a normally small number, followed by the alternative as an open type
+		index := r.readBits(7)
+		if index >= uint64(len(enbTypes)) {
+			r.fail("ENB-ID extension %d is not supported", index)
+			return nil, r.err
+		}
+		extension := newAperReader(r.readOctets(r.readUnconstrainedLength()))
+		globalNodeId.EnbId = readEnbId(extension, enbTypes[index])
+		if r.err == nil {
+			r.err = extension.err
+		}
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return globalNodeId, nil
+}
+
+/*
+decodeGlobalNgRanNodeIdIe decodes the XnAP GlobalNG-RANNode-ID IE, a CHOICE of the GlobalgNB-ID and GlobalngeNB-ID SEQUENCEs,
+each one the PLMN identity and a CHOICE of the node ID. Their extensions are left unread.
+*/
+func decodeGlobalNgRanNodeIdIe(value []byte) (interface{}, error) {
+	r := newAperReader(value)
+	globalNodeId := &GlobalNodeId{}
+	switch r.readConstrainedWholeNumber(0, 2) {
+	case 0:
+		r.readSequencePreamble(true, 1)
+		globalNodeId.PlmnId = readPlmnId(r)
+		if r.readConstrainedWholeNumber(0, 1) != 0 {
+			r.fail("GNB-ID-Choice extensions are not supported")
+			return nil, r.err
+		}
+		id, length := r.readBitString(entities.MinGnbIdLength, entities.MaxGnbIdLength)
+		gnbId := entities.GnbId{Value: uint32(id), Length: length}
+		globalNodeId.GnbId = &gnbId
+	case 1:
+		r.readSequencePreamble(true, 1)
+		globalNodeId.PlmnId = readPlmnId(r)
+		enbTypes := []entities.EnbType{entities.EnbType_MACRO_NG_ENB, entities.EnbType_SHORT_MACRO_NG_ENB, entities.EnbType_LONG_MACRO_NG_ENB}
+		index := r.readConstrainedWholeNumber(0, 3)
+		if index >= uint64(len(enbTypes)) {
+			r.fail("ENB-ID-Choice extensions are not supported")
+			return nil, r.err
+		}
+		globalNodeId.EnbId = readEnbId(r, enbTypes[index])
+	default:
+		r.fail("GlobalNG-RANNode-ID extensions are not supported")
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return globalNodeId, nil
+}
+
+// readPlmnId decodes a PLMN-Identity, OCTET STRING (SIZE(3)), into its hex string
+func readPlmnId(r *aperReader) string {
+	return fmt.Sprintf("%x", r.readOctets(3))
+}
+
+// readEnbId decodes the BIT STRING of an eNB ID of the eNB type, its size fixed by the type
+func readEnbId(r *aperReader, enbType entities.EnbType) *entities.EnbId {
+	length := entities.EnbIdLength(enbType)
+	id, _ := r.readBitString(length, length)
+	return &entities.EnbId{Value: uint32(id), Type: enbType}
+}
@@ -0,0 +1,249 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+import (
+	"errors"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func encodeName(name string) []byte {
+	w := &aperWriter{}
+	w.writePrintableString(name, 1, 150)
+	return w.data
+}
+
+func encodeNodeId(id uint64) []byte {
+	w := &aperWriter{}
+	w.writeConstrainedWholeNumber(id, 0, 68719476735)
+	return w.data
+}
+
+// encodeGlobalEnbId encodes an X2AP GlobalENB-ID, the short and long macro eNB IDs as ENB-ID extension additions
+func encodeGlobalEnbId(plmnId []byte, enbId entities.EnbId) []byte {
+	w := &aperWriter{}
+	w.writePreamble(false, false)
+	w.writeOctets(plmnId)
+	switch enbId.Type {
+	case entities.EnbType_MACRO_ENB, entities.EnbType_HOME_ENB:
+		w.writeBool(false)
+		w.writeConstrainedWholeNumber(uint64(enbId.Type-entities.EnbType_MACRO_ENB), 0, 1)
+		w.writeBitString(uint64(enbId.Value), enbId.Length(), enbId.Length(), enbId.Length())
+	default:
+		extension := &aperWriter{}
+		extension.writeBitString(uint64(enbId.Value), enbId.Length(), enbId.Length(), enbId.Length())
+		w.writeBool(true)
+		w.writeBits(uint64(enbId.Type-entities.EnbType_SHORT_MACRO_ENB), 7)
+		w.writeUnconstrainedLength(len(extension.data))
+		w.writeOctets(extension.data)
+	}
+	return w.data
+}
+
+// encodeGlobalNgRanNodeId encodes an XnAP GlobalNG-RANNode-ID of the gNB ID, or of the ng-eNB ID when gnbId is nil
+func encodeGlobalNgRanNodeId(plmnId []byte, gnbId *entities.GnbId, enbId entities.EnbId) []byte {
+	w := &aperWriter{}
+	if gnbId != nil {
+		w.writeConstrainedWholeNumber(0, 0, 2)
+		w.writePreamble(false, false)
+		w.writeOctets(plmnId)
+		w.writeConstrainedWholeNumber(0, 0, 1)
+		w.writeBitString(uint64(gnbId.Value), gnbId.Length, entities.MinGnbIdLength, entities.MaxGnbIdLength)
+		return w.data
+	}
+	w.writeConstrainedWholeNumber(1, 0, 2)
+	w.writePreamble(false, false)
+	w.writeOctets(plmnId)
+	w.writeConstrainedWholeNumber(uint64(enbId.Type-entities.EnbType_MACRO_NG_ENB), 0, 3)
+	w.writeBitString(uint64(enbId.Value), enbId.Length(), enbId.Length(), enbId.Length())
+	return w.data
+}
+
+// buildApPdu encodes a PDU of the elementary procedure holding the protocol IEs, keyed by id
+func buildApPdu(choiceExtension bool, pduType ApPduType, procedureCode uint64, protocolIes map[uint64][]byte, ids ...uint64) []byte {
+	message := &aperWriter{}
+	message.writePreamble(false)
+	message.writeConstrainedWholeNumber(uint64(len(ids)), 0, 65535)
+	for _, id := range ids {
+		message.writeConstrainedWholeNumber(id, 0, 65535)
+		message.writeConstrainedWholeNumber(0, 0, 2)
+		message.writeUnconstrainedLength(len(protocolIes[id]))
+		message.writeOctets(protocolIes[id])
+	}
+	w := &aperWriter{}
+	if choiceExtension {
+		w.writeConstrainedWholeNumber(uint64(pduType), 0, 3)
+	} else {
+		w.writeBool(false)
+		w.writeConstrainedWholeNumber(uint64(pduType), 0, 2)
+	}
+	w.writeConstrainedWholeNumber(procedureCode, 0, 255)
+	w.writeConstrainedWholeNumber(0, 0, 2)
+	w.writeUnconstrainedLength(len(message.data))
+	w.writeOctets(message.data)
+	return w.data
+}
+
+func TestDecodeNgComponentConfig(t *testing.T) {
+	config := &entities.E2NodeComponentConfig{
+		E2NodeComponentID:           &entities.E2NodeComponentConfig_E2NodeComponentInterfaceTypeNG{E2NodeComponentInterfaceTypeNG: &entities.E2NodeComponentInterfaceNG{AmfName: "amf1"}},
+		E2NodeComponentRequestPart:  buildApPdu(false, InitiatingMessage, 21, map[uint64][]byte{82: encodeName("gnb_1"), 27: {0x00}}, 27, 82),
+		E2NodeComponentResponsePart: buildApPdu(false, SuccessfulOutcome, 21, map[uint64][]byte{1: encodeName("amf1")}, 1),
+	}
+	decoded := GetDefaultE2NodeComponentDecoder().Decode(config)
+	assert.Equal(t, entities.E2NodeComponentInterfaceType_ng, decoded.InterfaceType)
+	assert.Nil(t, decoded.RequestErr)
+	assert.Equal(t, InitiatingMessage, decoded.Request.Type)
+	assert.EqualValues(t, 21, decoded.Request.ProcedureCode)
+	assert.Equal(t, ProtocolIe{Id: 27, Value: []byte{0x00}}, decoded.Request.ProtocolIes[0])
+	ranNodeName, ok := decoded.Request.GetValue("RANNodeName")
+	assert.True(t, ok)
+	assert.Equal(t, "gnb_1", ranNodeName)
+	assert.Nil(t, decoded.ResponseErr)
+	assert.Equal(t, "SuccessfulOutcome", decoded.Response.Type.String())
+	amfName, ok := decoded.Response.GetValue("AMFName")
+	assert.True(t, ok)
+	assert.Equal(t, "amf1", amfName)
+}
+
+func TestDecodeF1ComponentConfig(t *testing.T) {
+	config := &entities.E2NodeComponentConfig{
+		E2NodeComponentInterfaceType: entities.E2NodeComponentInterfaceType_f1,
+		E2NodeComponentRequestPart:   buildApPdu(true, InitiatingMessage, 1, map[uint64][]byte{42: encodeNodeId(68719476735), 45: encodeName("du_1")}, 42, 45),
+	}
+	decoded := GetDefaultE2NodeComponentDecoder().Decode(config)
+	assert.Nil(t, decoded.RequestErr)
+	duId, _ := decoded.Request.GetValue("gNB-DU-ID")
+	assert.EqualValues(t, 68719476735, duId)
+	duName, _ := decoded.Request.GetValue("gNB-DU-Name")
+	assert.Equal(t, "du_1", duName)
+	assert.Nil(t, decoded.Response)
+	assert.Nil(t, decoded.ResponseErr)
+}
+
+func TestDecodeGlobalEnbIdIe(t *testing.T) {
+	plmnId := []byte{0x02, 0xf8, 0x29}
+	macroEnbId := []byte{0x00, 0x02, 0xf8, 0x29, 0x00, 0x00, 0x7a, 0x80}
+	assert.Equal(t, macroEnbId, encodeGlobalEnbId(plmnId, entities.EnbId{Value: 0x7a8, Type: entities.EnbType_MACRO_ENB}))
+	for _, enbId := range []entities.EnbId{
+		{Value: 0x7a8, Type: entities.EnbType_MACRO_ENB},
+		{Value: 0x7a801, Type: entities.EnbType_HOME_ENB},
+		{Value: 0x1ea, Type: entities.EnbType_SHORT_MACRO_ENB},
+		{Value: 0xf50, Type: entities.EnbType_LONG_MACRO_ENB},
+	} {
+		t.Run(enbId.Type.String(), func(t *testing.T) {
+			value, err := decodeGlobalEnbIdIe(encodeGlobalEnbId(plmnId, enbId))
+			assert.Nil(t, err)
+			assert.Equal(t, &GlobalNodeId{PlmnId: "02f829", EnbId: &enbId}, value)
+		})
+	}
+}
+
+func TestDecodeGlobalNgRanNodeIdIe(t *testing.T) {
+	plmnId := []byte{0x02, 0xf8, 0x29}
+	gnbId := entities.GnbId{Value: 0x12b25, Length: 22}
+	assert.Equal(t, []byte{0x00, 0x02, 0xf8, 0x29, 0x00, 0x04, 0xac, 0x94}, encodeGlobalNgRanNodeId(plmnId, &gnbId, entities.EnbId{}))
+	value, err := decodeGlobalNgRanNodeIdIe(encodeGlobalNgRanNodeId(plmnId, &gnbId, entities.EnbId{}))
+	assert.Nil(t, err)
+	assert.Equal(t, &GlobalNodeId{PlmnId: "02f829", GnbId: &gnbId}, value)
+	for _, enbId := range []entities.EnbId{
+		{Value: 0x7a8, Type: entities.EnbType_MACRO_NG_ENB},
+		{Value: 0x1ea, Type: entities.EnbType_SHORT_MACRO_NG_ENB},
+		{Value: 0xf50, Type: entities.EnbType_LONG_MACRO_NG_ENB},
+	} {
+		t.Run(enbId.Type.String(), func(t *testing.T) {
+			value, err := decodeGlobalNgRanNodeIdIe(encodeGlobalNgRanNodeId(plmnId, nil, enbId))
+			assert.Nil(t, err)
+			assert.Equal(t, &GlobalNodeId{PlmnId: "02f829", EnbId: &enbId}, value)
+		})
+	}
+}
+
+func TestDecodeGlobalNodeIdIeChoiceExtension(t *testing.T) {
+	_, err := decodeGlobalNgRanNodeIdIe([]byte{0x80, 0x00})
+	assert.NotNil(t, err)
+	_, err = decodeGlobalEnbIdIe([]byte{0x00, 0x02, 0xf8, 0x29, 0x84, 0x01, 0x00})
+	assert.NotNil(t, err)
+}
+
+func TestDecodeX2AndXnComponentConfigs(t *testing.T) {
+	decoder := GetDefaultE2NodeComponentDecoder()
+	x2Config := &entities.E2NodeComponentConfig{
+		E2NodeComponentInterfaceType: entities.E2NodeComponentInterfaceType_x2,
+		E2NodeComponentRequestPart:   buildApPdu(false, InitiatingMessage, 6, map[uint64][]byte{21: encodeGlobalEnbId([]byte{0x02, 0xf8, 0x29}, entities.EnbId{Value: 0x7a8, Type: entities.EnbType_MACRO_ENB})}, 21),
+	}
+	decoded := decoder.Decode(x2Config)
+	assert.Nil(t, decoded.RequestErr)
+	globalEnbId, ok := decoded.Request.GetValue("GlobalENB-ID")
+	assert.True(t, ok)
+	assert.Equal(t, "007a80", globalEnbId.(*GlobalNodeId).EnbId.String())
+
+	gnbId := entities.GnbId{Value: 0x4a952a0a, Length: 32}
+	xnConfig := &entities.E2NodeComponentConfig{
+		E2NodeComponentInterfaceType: entities.E2NodeComponentInterfaceType_xn,
+		E2NodeComponentResponsePart:  buildApPdu(false, SuccessfulOutcome, 17, map[uint64][]byte{42: encodeGlobalNgRanNodeId([]byte{0x02, 0xf8, 0x29}, &gnbId, entities.EnbId{})}, 42),
+	}
+	decoded = decoder.Decode(xnConfig)
+	assert.Nil(t, decoded.ResponseErr)
+	globalNgRanNodeId, ok := decoded.Response.GetValue("GlobalNG-RANnode-ID")
+	assert.True(t, ok)
+	assert.Equal(t, &GlobalNodeId{PlmnId: "02f829", GnbId: &gnbId}, globalNgRanNodeId)
+}
+
+func TestDecodeComponentConfigWithoutCodecs(t *testing.T) {
+	config := &entities.E2NodeComponentConfig{
+		E2NodeComponentInterfaceType: entities.E2NodeComponentInterfaceType_s1,
+		E2NodeComponentResponsePart:  buildApPdu(false, SuccessfulOutcome, 17, map[uint64][]byte{61: encodeName("mme1")}, 61),
+	}
+	decoded := GetNewE2NodeComponentDecoder().Decode(config)
+	assert.Nil(t, decoded.ResponseErr)
+	_, ok := decoded.Response.GetValue("MMEname")
+	assert.False(t, ok)
+	assert.Equal(t, encodeName("mme1"), decoded.Response.ProtocolIes[0].Value)
+}
+
+func TestDecodeComponentConfigRegisterProtocolIe(t *testing.T) {
+	decoder := GetNewE2NodeComponentDecoder()
+	decoder.RegisterProtocolIe(entities.E2NodeComponentInterfaceType_x2, 21, ProtocolIeCodec{Name: "GlobalENB-ID", Decode: func(value []byte) (interface{}, error) {
+		return len(value), nil
+	}})
+	pdu, err := decoder.DecodePart(entities.E2NodeComponentInterfaceType_x2, buildApPdu(false, InitiatingMessage, 6, map[uint64][]byte{21: {1, 2, 3}}, 21))
+	assert.Nil(t, err)
+	value, _ := pdu.GetValue("GlobalENB-ID")
+	assert.Equal(t, 3, value)
+}
+
+func TestDecodeComponentConfigCorruptPart(t *testing.T) {
+	part := buildApPdu(false, SuccessfulOutcome, 21, map[uint64][]byte{1: encodeName("amf1")}, 1)
+	decoded := GetDefaultE2NodeComponentDecoder().Decode(&entities.E2NodeComponentConfig{E2NodeComponentResponsePart: part[:len(part)-2]})
+	assert.Nil(t, decoded.Response)
+	assert.True(t, errors.Is(decoded.ResponseErr, common.ErrCorruptData))
+}
+
+func TestDecodeComponentConfigCorruptProtocolIe(t *testing.T) {
+	part := buildApPdu(false, SuccessfulOutcome, 21, map[uint64][]byte{1: {0x01}}, 1)
+	pdu, err := GetDefaultE2NodeComponentDecoder().DecodePart(entities.E2NodeComponentInterfaceType_ng, part)
+	assert.Nil(t, pdu)
+	assert.True(t, errors.Is(err, common.ErrCorruptData))
+}
@@ -0,0 +1,135 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+// KpmV2Oid identifies the E2SM-KPM v2 service model
+const KpmV2Oid = "1.3.6.1.4.1.53148.1.2.2.2"
+
+const (
+	maxnoofRicStyles       = 63
+	maxnoofMeasurementInfo = 65535
+)
+
+/*
+KpmRanFunctionDefinition is the decoded E2SM-KPM-RANfunction-Description.
+*/
+type KpmRanFunctionDefinition struct {
+	RanFunctionName
+	EventTriggerStyles []KpmEventTriggerStyle
+	ReportStyles       []KpmReportStyle
+}
+
+type KpmEventTriggerStyle struct {
+	Type       int64
+	Name       string
+	FormatType int64
+}
+
+type KpmReportStyle struct {
+	Type                        int64
+	Name                        string
+	ActionFormatType            int64
+	Measurements                []KpmMeasurement
+	IndicationHeaderFormatType  int64
+	IndicationMessageFormatType int64
+}
+
+// KpmMeasurement is a measurement supported by a report style, Id is 0 when the E2 node does not assign one
+type KpmMeasurement struct {
+	Name string
+	Id   int64
+}
+
+// MeasurementNames returns the names of the measurements supported by the report style
+func (s *KpmReportStyle) MeasurementNames() []string {
+	names := make([]string, 0, len(s.Measurements))
+	for _, measurement := range s.Measurements {
+		names = append(names, measurement.Name)
+	}
+	return names
+}
+
+//DecodeKpmRanFunctionDefinition decodes an APER encoded E2SM-KPM v2 RAN function definition
+func DecodeKpmRanFunctionDefinition(data []byte) (RanFunctionDefinition, error) {
+	r := newAperReader(data)
+	extended, present := r.readSequencePreamble(true, 2)
+	definition := &KpmRanFunctionDefinition{RanFunctionName: readRanFunctionName(r)}
+	if present[0] {
+		r.readSequenceOf(1, maxnoofRicStyles, func() {
+			definition.EventTriggerStyles = append(definition.EventTriggerStyles, readKpmEventTriggerStyle(r))
+		})
+	}
+	if present[1] {
+		r.readSequenceOf(1, maxnoofRicStyles, func() {
+			definition.ReportStyles = append(definition.ReportStyles, readKpmReportStyle(r))
+		})
+	}
+	if extended {
+		r.skipExtensions()
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return definition, nil
+}
+
+func readKpmEventTriggerStyle(r *aperReader) KpmEventTriggerStyle {
+	extended, _ := r.readSequencePreamble(true, 0)
+	style := KpmEventTriggerStyle{
+		Type:       r.readInteger(),
+		Name:       r.readPrintableString(1, 150),
+		FormatType: r.readInteger(),
+	}
+	if extended {
+		r.skipExtensions()
+	}
+	return style
+}
+
+func readKpmReportStyle(r *aperReader) KpmReportStyle {
+	extended, _ := r.readSequencePreamble(true, 0)
+	style := KpmReportStyle{
+		Type:             r.readInteger(),
+		Name:             r.readPrintableString(1, 150),
+		ActionFormatType: r.readInteger(),
+	}
+	r.readSequenceOf(1, maxnoofMeasurementInfo, func() {
+		style.Measurements = append(style.Measurements, readKpmMeasurement(r))
+	})
+	style.IndicationHeaderFormatType = r.readInteger()
+	style.IndicationMessageFormatType = r.readInteger()
+	if extended {
+		r.skipExtensions()
+	}
+	return style
+}
+
+func readKpmMeasurement(r *aperReader) KpmMeasurement {
+	extended, present := r.readSequencePreamble(true, 1)
+	measurement := KpmMeasurement{Name: r.readPrintableString(1, 150)}
+	if present[0] {
+		measurement.Id = r.readExtensibleInteger(1, 65536)
+	}
+	if extended {
+		r.skipExtensions()
+	}
+	return measurement
+}
@@ -0,0 +1,147 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func writeRanFunctionName(w *aperWriter, shortName string, oid string, description string, instance *int64) {
+	w.writePreamble(false, instance != nil)
+	w.writePrintableString(shortName, 1, 150)
+	w.writePrintableString(oid, 1, 1000)
+	w.writePrintableString(description, 1, 150)
+	if instance != nil {
+		w.writeInteger(*instance)
+	}
+}
+
+func buildKpmDefinition() []byte {
+	w := &aperWriter{}
+	w.writePreamble(false, true, true)
+	instance := int64(1)
+	writeRanFunctionName(w, "ORAN-E2SM-KPM", KpmV2Oid, "KPM Monitor", &instance)
+	w.writeSequenceOfLength(1, 1, maxnoofRicStyles)
+	w.writePreamble(false)
+	w.writeInteger(1)
+	w.writePrintableString("Periodic Report", 1, 150)
+	w.writeInteger(1)
+	w.writeSequenceOfLength(2, 1, maxnoofRicStyles)
+	w.writePreamble(false)
+	w.writeInteger(1)
+	w.writePrintableString("E2 Node Measurement", 1, 150)
+	w.writeInteger(1)
+	w.writeSequenceOfLength(2, 1, maxnoofMeasurementInfo)
+	w.writePreamble(false, true)
+	w.writePrintableString("DRB.UEThpDl", 1, 150)
+	w.writeExtensibleInteger(1, 1, 65536)
+	w.writePreamble(false, false)
+	w.writePrintableString("RRU.PrbUsedDl", 1, 150)
+	w.writeInteger(1)
+	w.writeInteger(1)
+	w.writePreamble(true)
+	w.writeInteger(4)
+	w.writePrintableString("Common Condition-based, UE-level Measurement", 1, 150)
+	w.writeInteger(3)
+	w.writeSequenceOfLength(1, 1, maxnoofMeasurementInfo)
+	w.writePreamble(false, false)
+	w.writePrintableString("DRB.UEThpUl", 1, 150)
+	w.writeInteger(1)
+	w.writeInteger(2)
+	w.writeBool(false)
+	w.writeBits(0, 6)
+	w.writeBool(true)
+	w.writeUnconstrainedLength(1)
+	w.writeOctets([]byte{0x00})
+	return w.data
+}
+
+/*
+kpmRanFunctionDefinitionVector is an E2SM-KPM v02.00 RAN function definition of a gNB, as hex encoded in the RAN function
+entities, laid out octet by octet from the E2SM-KPM ASN.1 module rather than with aperWriter: an event trigger style and
+two report styles, the second one with a measurement id.
+*/
+const kpmRanFunctionDefinitionVector = "" +
+	"68304f52414e2d4532534d2d4b504d000018312e332e362e312e342e312e3533" +
+	"3134382e312e322e322e3205004b504d204d6f6e69746f720101000101070050" +
+	"6572696f646963205265706f7274010104010109004532204e6f6465204d6561" +
+	"737572656d656e740101000301404452422e5545546870446c01404452422e55" +
+	"45546870556c01805252552e50726255736564446c01805252552e5072625573" +
+	"6564556c010101010001041580436f6d6d6f6e20436f6e646974696f6e2d6261" +
+	"7365642c2055452d6c6576656c204d6561737572656d656e7401040000414044" +
+	"52422e5545546870446c00000001010102"
+
+func TestDecodeKpmRanFunctionDefinitionVector(t *testing.T) {
+	decoded, err := GetDefaultRegistry().Decode(&entities.RanFunction{RanFunctionOid: KpmV2Oid, RanFunctionDefinition: kpmRanFunctionDefinitionVector})
+	assert.Nil(t, err)
+	definition := decoded.(*KpmRanFunctionDefinition)
+	instance := int64(1)
+	assert.Equal(t, RanFunctionName{ShortName: "ORAN-E2SM-KPM", E2smOid: KpmV2Oid, Description: "KPM Monitor", Instance: &instance}, definition.RanFunctionName)
+	assert.Equal(t, []KpmEventTriggerStyle{{Type: 1, Name: "Periodic Report", FormatType: 1}}, definition.EventTriggerStyles)
+	assert.Equal(t, []KpmReportStyle{
+		{Type: 1, Name: "E2 Node Measurement", ActionFormatType: 1, Measurements: []KpmMeasurement{
+			{Name: "DRB.UEThpDl"}, {Name: "DRB.UEThpUl"}, {Name: "RRU.PrbUsedDl"}, {Name: "RRU.PrbUsedUl"},
+		}, IndicationHeaderFormatType: 1, IndicationMessageFormatType: 1},
+		{Type: 4, Name: "Common Condition-based, UE-level Measurement", ActionFormatType: 4, Measurements: []KpmMeasurement{
+			{Name: "DRB.UEThpDl", Id: 1},
+		}, IndicationHeaderFormatType: 1, IndicationMessageFormatType: 2},
+	}, definition.ReportStyles)
+}
+
+func TestDecodeKpmRanFunctionDefinition(t *testing.T) {
+	decoded, err := DecodeKpmRanFunctionDefinition(buildKpmDefinition())
+	assert.Nil(t, err)
+	definition, ok := decoded.(*KpmRanFunctionDefinition)
+	assert.True(t, ok)
+	assert.Equal(t, "ORAN-E2SM-KPM", definition.GetRanFunctionName().ShortName)
+	assert.Equal(t, KpmV2Oid, definition.E2smOid)
+	assert.Equal(t, "KPM Monitor", definition.Description)
+	assert.EqualValues(t, 1, *definition.Instance)
+	assert.Equal(t, []KpmEventTriggerStyle{{Type: 1, Name: "Periodic Report", FormatType: 1}}, definition.EventTriggerStyles)
+	assert.Len(t, definition.ReportStyles, 2)
+	assert.Equal(t, []KpmMeasurement{{Name: "DRB.UEThpDl", Id: 1}, {Name: "RRU.PrbUsedDl"}}, definition.ReportStyles[0].Measurements)
+	assert.EqualValues(t, 4, definition.ReportStyles[1].Type)
+	assert.EqualValues(t, 3, definition.ReportStyles[1].ActionFormatType)
+	assert.Equal(t, []string{"DRB.UEThpUl"}, definition.ReportStyles[1].MeasurementNames())
+	assert.EqualValues(t, 1, definition.ReportStyles[1].IndicationHeaderFormatType)
+	assert.EqualValues(t, 2, definition.ReportStyles[1].IndicationMessageFormatType)
+}
+
+func TestDecodeKpmRanFunctionDefinitionNameOnly(t *testing.T) {
+	w := &aperWriter{}
+	w.writePreamble(false, false, false)
+	writeRanFunctionName(w, "ORAN-E2SM-KPM", KpmV2Oid, "KPM Monitor", nil)
+	decoded, err := DecodeKpmRanFunctionDefinition(w.data)
+	assert.Nil(t, err)
+	definition := decoded.(*KpmRanFunctionDefinition)
+	assert.Nil(t, definition.Instance)
+	assert.Nil(t, definition.EventTriggerStyles)
+	assert.Nil(t, definition.ReportStyles)
+}
+
+func TestDecodeKpmRanFunctionDefinitionTruncated(t *testing.T) {
+	data := buildKpmDefinition()
+	decoded, err := DecodeKpmRanFunctionDefinition(data[:len(data)/2])
+	assert.Nil(t, decoded)
+	assert.NotNil(t, err)
+}
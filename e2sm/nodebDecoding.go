@@ -0,0 +1,92 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+)
+
+/*
+The decoding of the entities saved in the R-NIB reads through these interfaces, both implemented by reader.RNibReader,
+so that the e2sm package does not depend on the reader.
+*/
+
+// RanFunctionsReader retrieves the RAN functions advertised by a nodeb
+type RanFunctionsReader interface {
+	GetRanFunctions(inventoryName string) ([]*entities.RanFunction, error)
+}
+
+// NodebReader retrieves a nodeb entity
+type NodebReader interface {
+	GetNodeb(inventoryName string) (*entities.NodebInfo, error)
+}
+
+/*
+DecodedRanFunction pairs a RAN function advertised by a nodeb with its decoded definition.
+When the definition cannot be decoded, e.g. its service model has no registered decoder, Definition is nil and Err tells why.
+*/
+type DecodedRanFunction struct {
+	RanFunction *entities.RanFunction
+	Definition  RanFunctionDefinition
+	Err         error
+}
+
+/*
+GetDecodedRanFunctions retrieves the RAN functions of the nodeb and decodes their definitions with the registry,
+or with the default registry when registry is nil.
+*/
+func GetDecodedRanFunctions(r RanFunctionsReader, registry *Registry, inventoryName string) ([]*DecodedRanFunction, error) {
+	ranFunctions, err := r.GetRanFunctions(inventoryName)
+	if err != nil {
+		return nil, err
+	}
+	if registry == nil {
+		registry = GetDefaultRegistry()
+	}
+	decodedRanFunctions := make([]*DecodedRanFunction, 0, len(ranFunctions))
+	for _, ranFunction := range ranFunctions {
+		definition, err := registry.Decode(ranFunction)
+		decodedRanFunctions = append(decodedRanFunctions, &DecodedRanFunction{RanFunction: ranFunction, Definition: definition, Err: err})
+	}
+	return decodedRanFunctions, nil
+}
+
+/*
+GetDecodedE2NodeComponentConfigs retrieves the E2 node component configurations of the nodeb grouped by interface type,
+with their request and response parts decoded by decoder, or by the default decoder when decoder is nil.
+*/
+func GetDecodedE2NodeComponentConfigs(r NodebReader, decoder *E2NodeComponentDecoder, inventoryName string) (map[entities.E2NodeComponentInterfaceType][]*DecodedE2NodeComponentConfig, error) {
+	nodeb, err := r.GetNodeb(inventoryName)
+	if err != nil {
+		return nil, err
+	}
+	if decoder == nil {
+		decoder = GetDefaultE2NodeComponentDecoder()
+	}
+	groups := entities.GroupE2NodeComponentConfigs(nodeb.GetNodeConfigs())
+	decodedGroups := make(map[entities.E2NodeComponentInterfaceType][]*DecodedE2NodeComponentConfig, len(groups))
+	for interfaceType, configs := range groups {
+		for _, config := range configs {
+			decodedGroups[interfaceType] = append(decodedGroups[interfaceType], decoder.Decode(config))
+		}
+	}
+	return decodedGroups, nil
+}
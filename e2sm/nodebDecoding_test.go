@@ -0,0 +1,109 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// nodebsReader reads the nodebs it holds, as reader.RNibReader reads those saved in the R-NIB
+type nodebsReader map[string]*entities.NodebInfo
+
+func (r nodebsReader) GetNodeb(inventoryName string) (*entities.NodebInfo, error) {
+	nodeb, ok := r[inventoryName]
+	if !ok {
+		return nil, common.NewResourceNotFoundErrorf("#nodebsReader.GetNodeb - nodeb %s not found", inventoryName)
+	}
+	return nodeb, nil
+}
+
+func (r nodebsReader) GetRanFunctions(inventoryName string) ([]*entities.RanFunction, error) {
+	nodeb, err := r.GetNodeb(inventoryName)
+	if err != nil {
+		return nil, err
+	}
+	return nodeb.GetGnb().GetRanFunctions(), nil
+}
+
+func TestGetDecodedRanFunctions(t *testing.T) {
+	r := nodebsReader{"gnb_1": {RanName: "gnb_1", Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{RanFunctions: []*entities.RanFunction{
+		{RanFunctionId: 2, RanFunctionOid: KpmV2Oid, RanFunctionDefinition: kpmRanFunctionDefinitionVector},
+		{RanFunctionId: 3, RanFunctionOid: RcV1Oid, RanFunctionDefinition: rcRanFunctionDefinitionVector},
+		{RanFunctionId: 5, RanFunctionOid: "1.2.3", RanFunctionDefinition: "00"},
+	}}}}}
+	decodedRanFunctions, err := GetDecodedRanFunctions(r, nil, "gnb_1")
+	assert.Nil(t, err)
+	assert.Len(t, decodedRanFunctions, 3)
+	assert.Nil(t, decodedRanFunctions[0].Err)
+	assert.EqualValues(t, 2, decodedRanFunctions[0].RanFunction.GetRanFunctionId())
+	assert.Equal(t, "ORAN-E2SM-KPM", decodedRanFunctions[0].Definition.GetRanFunctionName().ShortName)
+	assert.Nil(t, decodedRanFunctions[1].Err)
+	assert.Equal(t, "RAN Control", decodedRanFunctions[1].Definition.GetRanFunctionName().Description)
+	assert.Nil(t, decodedRanFunctions[2].Definition)
+	assert.IsType(t, &common.ValidationError{}, decodedRanFunctions[2].Err)
+
+	decodedRanFunctions, err = GetDecodedRanFunctions(r, GetNewRegistry(), "gnb_1")
+	assert.Nil(t, err)
+	assert.IsType(t, &common.ValidationError{}, decodedRanFunctions[0].Err)
+}
+
+func TestGetDecodedRanFunctionsNodebNotFound(t *testing.T) {
+	decodedRanFunctions, err := GetDecodedRanFunctions(nodebsReader{}, nil, "gnb_1")
+	assert.Nil(t, decodedRanFunctions)
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+}
+
+func TestGetDecodedE2NodeComponentConfigs(t *testing.T) {
+	r := nodebsReader{"gnb_1": {RanName: "gnb_1", Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{NodeConfigs: []*entities.E2NodeComponentConfig{
+		{
+			E2NodeComponentID:           &entities.E2NodeComponentConfig_E2NodeComponentInterfaceTypeNG{E2NodeComponentInterfaceTypeNG: &entities.E2NodeComponentInterfaceNG{AmfName: "amf1"}},
+			E2NodeComponentResponsePart: []byte{0x20, 0x15, 0x00, 0x0d, 0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x06, 0x01, 0x80, 'a', 'm', 'f', '1'},
+		},
+		{
+			E2NodeComponentID: &entities.E2NodeComponentConfig_E2NodeComponentInterfaceTypeF1{E2NodeComponentInterfaceTypeF1: &entities.E2NodeComponentInterfaceF1{GNBDuId: 1}},
+		},
+		{
+			E2NodeComponentInterfaceType: entities.E2NodeComponentInterfaceType_f1,
+			E2NodeComponentRequestPart:   []byte{0x20},
+		},
+	}}}}}
+	groups, err := GetDecodedE2NodeComponentConfigs(r, nil, "gnb_1")
+	assert.Nil(t, err)
+	assert.Len(t, groups, 2)
+	ng := groups[entities.E2NodeComponentInterfaceType_ng][0]
+	assert.Nil(t, ng.Request)
+	assert.Nil(t, ng.ResponseErr)
+	amfName, ok := ng.Response.GetValue("AMFName")
+	assert.True(t, ok)
+	assert.Equal(t, "amf1", amfName)
+	f1 := groups[entities.E2NodeComponentInterfaceType_f1]
+	assert.Nil(t, f1[0].Request)
+	assert.Nil(t, f1[0].RequestErr)
+	assert.Nil(t, f1[1].Request)
+	assert.IsType(t, &common.InternalError{}, f1[1].RequestErr)
+
+	groups, err = GetDecodedE2NodeComponentConfigs(r, nil, "gnb_2")
+	assert.Nil(t, groups)
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+}
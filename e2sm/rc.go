@@ -0,0 +1,376 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+// RcV1Oid identifies the E2SM-RC v1 service model
+const RcV1Oid = "1.3.6.1.4.1.53148.1.1.2.3"
+
+const (
+	maxnoofRcItems       = 65535
+	maxnoofRanParameters = 4294967295
+)
+
+/*
+RcRanFunctionDefinition is the decoded E2SM-RC-RANFunctionDefinition. The sections the E2 node does not support are nil.
+The RAN parameters are decoded to their id and name, their definitions being skipped.
+*/
+type RcRanFunctionDefinition struct {
+	RanFunctionName
+	EventTrigger  *RcEventTrigger
+	ReportStyles  []RcReportStyle
+	InsertStyles  []RcInsertStyle
+	ControlStyles []RcControlStyle
+	PolicyStyles  []RcPolicyStyle
+}
+
+type RanParameter struct {
+	Id   int64
+	Name string
+}
+
+type RcEventTrigger struct {
+	Styles                       []RcEventTriggerStyle
+	L2Parameters                 []RanParameter
+	CallProcessTypes             []RcCallProcessType
+	UeIdentificationParameters   []RanParameter
+	CellIdentificationParameters []RanParameter
+}
+
+type RcEventTriggerStyle struct {
+	Type       int64
+	Name       string
+	FormatType int64
+}
+
+type RcCallProcessType struct {
+	Id          int64
+	Name        string
+	Breakpoints []RcCallProcessBreakpoint
+}
+
+type RcCallProcessBreakpoint struct {
+	Id         int64
+	Name       string
+	Parameters []RanParameter
+}
+
+type RcReportStyle struct {
+	Type                           int64
+	Name                           string
+	SupportedEventTriggerStyleType int64
+	ActionFormatType               int64
+	IndicationHeaderFormatType     int64
+	IndicationMessageFormatType    int64
+	Parameters                     []RanParameter
+}
+
+type RcInsertStyle struct {
+	Type                           int64
+	Name                           string
+	SupportedEventTriggerStyleType int64
+	ActionDefinitionFormatType     int64
+	Indications                    []RcInsertIndication
+	IndicationHeaderFormatType     int64
+	IndicationMessageFormatType    int64
+	CallProcessIdFormatType        int64
+}
+
+type RcInsertIndication struct {
+	Id         int64
+	Name       string
+	Parameters []RanParameter
+}
+
+type RcControlStyle struct {
+	Type                    int64
+	Name                    string
+	Actions                 []RcControlAction
+	HeaderFormatType        int64
+	MessageFormatType       int64
+	CallProcessIdFormatType *int64
+	OutcomeFormatType       int64
+	OutcomeParameters       []RanParameter
+}
+
+type RcControlAction struct {
+	Id         int64
+	Name       string
+	Parameters []RanParameter
+}
+
+type RcPolicyStyle struct {
+	Type                           int64
+	Name                           string
+	SupportedEventTriggerStyleType int64
+	Actions                        []RcPolicyAction
+}
+
+type RcPolicyAction struct {
+	Id                         int64
+	Name                       string
+	ActionDefinitionFormatType int64
+	ActionParameters           []RanParameter
+	ConditionParameters        []RanParameter
+}
+
+//DecodeRcRanFunctionDefinition decodes an APER encoded E2SM-RC v1 RAN function definition
+func DecodeRcRanFunctionDefinition(data []byte) (RanFunctionDefinition, error) {
+	r := newAperReader(data)
+	extended, present := r.readSequencePreamble(true, 5)
+	definition := &RcRanFunctionDefinition{RanFunctionName: readRanFunctionName(r)}
+	if present[0] {
+		definition.EventTrigger = readRcEventTrigger(r)
+	}
+	if present[1] {
+		readRcStyles(r, func() {
+			definition.ReportStyles = append(definition.ReportStyles, readRcReportStyle(r))
+		})
+	}
+	if present[2] {
+		readRcStyles(r, func() {
+			definition.InsertStyles = append(definition.InsertStyles, readRcInsertStyle(r))
+		})
+	}
+	if present[3] {
+		readRcStyles(r, func() {
+			definition.ControlStyles = append(definition.ControlStyles, readRcControlStyle(r))
+		})
+	}
+	if present[4] {
+		readRcStyles(r, func() {
+			definition.PolicyStyles = append(definition.PolicyStyles, readRcPolicyStyle(r))
+		})
+	}
+	if extended {
+		r.skipExtensions()
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return definition, nil
+}
+
+// readRcStyles decodes the report, insert, control or policy section, an extensible SEQUENCE holding just the style list
+func readRcStyles(r *aperReader, readStyle func()) {
+	extended, _ := r.readSequencePreamble(true, 0)
+	r.readSequenceOf(1, maxnoofRicStyles, readStyle)
+	if extended {
+		r.skipExtensions()
+	}
+}
+
+func readRanParameters(r *aperReader) []RanParameter {
+	var parameters []RanParameter
+	r.readSequenceOf(1, maxnoofRcItems, func() {
+		extended, _ := r.readSequencePreamble(true, 0)
+		parameters = append(parameters, RanParameter{
+			Id:   r.readExtensibleInteger(1, maxnoofRanParameters),
+			Name: r.readPrintableString(1, 150),
+		})
+		if extended {
+			r.skipExtensions()
+		}
+	})
+	return parameters
+}
+
+func readRcEventTrigger(r *aperReader) *RcEventTrigger {
+	extended, present := r.readSequencePreamble(true, 4)
+	eventTrigger := &RcEventTrigger{}
+	r.readSequenceOf(1, maxnoofRicStyles, func() {
+		styleExtended, _ := r.readSequencePreamble(true, 0)
+		eventTrigger.Styles = append(eventTrigger.Styles, RcEventTriggerStyle{
+			Type:       r.readInteger(),
+			Name:       r.readPrintableString(1, 150),
+			FormatType: r.readInteger(),
+		})
+		if styleExtended {
+			r.skipExtensions()
+		}
+	})
+	if present[0] {
+		eventTrigger.L2Parameters = readRanParameters(r)
+	}
+	if present[1] {
+		r.readSequenceOf(1, maxnoofRcItems, func() {
+			eventTrigger.CallProcessTypes = append(eventTrigger.CallProcessTypes, readRcCallProcessType(r))
+		})
+	}
+	if present[2] {
+		eventTrigger.UeIdentificationParameters = readRanParameters(r)
+	}
+	if present[3] {
+		eventTrigger.CellIdentificationParameters = readRanParameters(r)
+	}
+	if extended {
+		r.skipExtensions()
+	}
+	return eventTrigger
+}
+
+func readRcCallProcessType(r *aperReader) RcCallProcessType {
+	extended, _ := r.readSequencePreamble(true, 0)
+	callProcessType := RcCallProcessType{
+		Id:   r.readExtensibleInteger(1, maxnoofRcItems),
+		Name: r.readPrintableString(1, 150),
+	}
+	r.readSequenceOf(1, maxnoofRcItems, func() {
+		breakpointExtended, present := r.readSequencePreamble(true, 1)
+		breakpoint := RcCallProcessBreakpoint{
+			Id:   r.readExtensibleInteger(1, maxnoofRcItems),
+			Name: r.readPrintableString(1, 150),
+		}
+		if present[0] {
+			breakpoint.Parameters = readRanParameters(r)
+		}
+		if breakpointExtended {
+			r.skipExtensions()
+		}
+		callProcessType.Breakpoints = append(callProcessType.Breakpoints, breakpoint)
+	})
+	if extended {
+		r.skipExtensions()
+	}
+	return callProcessType
+}
+
+func readRcReportStyle(r *aperReader) RcReportStyle {
+	extended, present := r.readSequencePreamble(true, 1)
+	style := RcReportStyle{
+		Type:                           r.readInteger(),
+		Name:                           r.readPrintableString(1, 150),
+		SupportedEventTriggerStyleType: r.readInteger(),
+		ActionFormatType:               r.readInteger(),
+		IndicationHeaderFormatType:     r.readInteger(),
+		IndicationMessageFormatType:    r.readInteger(),
+	}
+	if present[0] {
+		style.Parameters = readRanParameters(r)
+	}
+	if extended {
+		r.skipExtensions()
+	}
+	return style
+}
+
+func readRcInsertStyle(r *aperReader) RcInsertStyle {
+	extended, present := r.readSequencePreamble(true, 1)
+	style := RcInsertStyle{
+		Type:                           r.readInteger(),
+		Name:                           r.readPrintableString(1, 150),
+		SupportedEventTriggerStyleType: r.readInteger(),
+		ActionDefinitionFormatType:     r.readInteger(),
+	}
+	if present[0] {
+		r.readSequenceOf(1, maxnoofRcItems, func() {
+			indicationExtended, indicationPresent := r.readSequencePreamble(true, 1)
+			indication := RcInsertIndication{
+				Id:   r.readExtensibleInteger(1, maxnoofRcItems),
+				Name: r.readPrintableString(1, 150),
+			}
+			if indicationPresent[0] {
+				indication.Parameters = readRanParameters(r)
+			}
+			if indicationExtended {
+				r.skipExtensions()
+			}
+			style.Indications = append(style.Indications, indication)
+		})
+	}
+	style.IndicationHeaderFormatType = r.readInteger()
+	style.IndicationMessageFormatType = r.readInteger()
+	style.CallProcessIdFormatType = r.readInteger()
+	if extended {
+		r.skipExtensions()
+	}
+	return style
+}
+
+func readRcControlStyle(r *aperReader) RcControlStyle {
+	extended, present := r.readSequencePreamble(true, 3)
+	style := RcControlStyle{
+		Type: r.readInteger(),
+		Name: r.readPrintableString(1, 150),
+	}
+	if present[0] {
+		r.readSequenceOf(1, maxnoofRcItems, func() {
+			actionExtended, actionPresent := r.readSequencePreamble(true, 1)
+			action := RcControlAction{
+				Id:   r.readExtensibleInteger(1, maxnoofRcItems),
+				Name: r.readPrintableString(1, 150),
+			}
+			if actionPresent[0] {
+				action.Parameters = readRanParameters(r)
+			}
+			if actionExtended {
+				r.skipExtensions()
+			}
+			style.Actions = append(style.Actions, action)
+		})
+	}
+	style.HeaderFormatType = r.readInteger()
+	style.MessageFormatType = r.readInteger()
+	if present[1] {
+		callProcessIdFormatType := r.readInteger()
+		style.CallProcessIdFormatType = &callProcessIdFormatType
+	}
+	style.OutcomeFormatType = r.readInteger()
+	if present[2] {
+		style.OutcomeParameters = readRanParameters(r)
+	}
+	if extended {
+		r.skipExtensions()
+	}
+	return style
+}
+
+func readRcPolicyStyle(r *aperReader) RcPolicyStyle {
+	extended, present := r.readSequencePreamble(true, 1)
+	style := RcPolicyStyle{
+		Type:                           r.readInteger(),
+		Name:                           r.readPrintableString(1, 150),
+		SupportedEventTriggerStyleType: r.readInteger(),
+	}
+	if present[0] {
+		r.readSequenceOf(1, maxnoofRcItems, func() {
+			actionExtended, actionPresent := r.readSequencePreamble(true, 2)
+			action := RcPolicyAction{
+				Id:                         r.readExtensibleInteger(1, maxnoofRcItems),
+				Name:                       r.readPrintableString(1, 150),
+				ActionDefinitionFormatType: r.readInteger(),
+			}
+			if actionPresent[0] {
+				action.ActionParameters = readRanParameters(r)
+			}
+			if actionPresent[1] {
+				action.ConditionParameters = readRanParameters(r)
+			}
+			if actionExtended {
+				r.skipExtensions()
+			}
+			style.Actions = append(style.Actions, action)
+		})
+	}
+	if extended {
+		r.skipExtensions()
+	}
+	return style
+}
@@ -0,0 +1,202 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func writeRanParameters(w *aperWriter, parameters ...RanParameter) {
+	w.writeSequenceOfLength(len(parameters), 1, maxnoofRcItems)
+	for _, parameter := range parameters {
+		w.writePreamble(false)
+		w.writeExtensibleInteger(parameter.Id, 1, maxnoofRanParameters)
+		w.writePrintableString(parameter.Name, 1, 150)
+	}
+}
+
+func buildRcDefinition() []byte {
+	w := &aperWriter{}
+	w.writePreamble(false, true, true, false, true, true)
+	writeRanFunctionName(w, "ORAN-E2SM-RC", RcV1Oid, "RAN Control", nil)
+
+	w.writePreamble(false, false, true, false, true)
+	w.writeSequenceOfLength(1, 1, maxnoofRicStyles)
+	w.writePreamble(false)
+	w.writeInteger(2)
+	w.writePrintableString("Call Process Breakpoint", 1, 150)
+	w.writeInteger(2)
+	w.writeSequenceOfLength(1, 1, maxnoofRcItems)
+	w.writePreamble(false)
+	w.writeExtensibleInteger(1, 1, maxnoofRcItems)
+	w.writePrintableString("Mobility Management", 1, 150)
+	w.writeSequenceOfLength(1, 1, maxnoofRcItems)
+	w.writePreamble(false, true)
+	w.writeExtensibleInteger(1, 1, maxnoofRcItems)
+	w.writePrintableString("Handover Preparation", 1, 150)
+	writeRanParameters(w, RanParameter{Id: 1, Name: "Target Primary Cell ID"})
+	writeRanParameters(w, RanParameter{Id: 70000, Name: "NR CGI"})
+
+	w.writePreamble(false)
+	w.writeSequenceOfLength(1, 1, maxnoofRicStyles)
+	w.writePreamble(false, true)
+	w.writeInteger(2)
+	w.writePrintableString("Call Process Outcome", 1, 150)
+	w.writeInteger(2)
+	w.writeInteger(1)
+	w.writeInteger(1)
+	w.writeInteger(2)
+	writeRanParameters(w, RanParameter{Id: 1, Name: "UE ID"}, RanParameter{Id: 2, Name: "Cell Global ID"})
+
+	w.writePreamble(false)
+	w.writeSequenceOfLength(1, 1, maxnoofRicStyles)
+	w.writePreamble(false, true, true, false)
+	w.writeInteger(3)
+	w.writePrintableString("Connected Mode Mobility", 1, 150)
+	w.writeSequenceOfLength(1, 1, maxnoofRcItems)
+	w.writePreamble(false, true)
+	w.writeExtensibleInteger(1, 1, maxnoofRcItems)
+	w.writePrintableString("Handover Control", 1, 150)
+	writeRanParameters(w, RanParameter{Id: 1, Name: "Target Primary Cell ID"})
+	w.writeInteger(1)
+	w.writeInteger(1)
+	w.writeInteger(1)
+	w.writeInteger(1)
+
+	w.writePreamble(false)
+	w.writeSequenceOfLength(1, 1, maxnoofRicStyles)
+	w.writePreamble(false, true)
+	w.writeInteger(3)
+	w.writePrintableString("Connected Mode Mobility", 1, 150)
+	w.writeInteger(2)
+	w.writeSequenceOfLength(1, 1, maxnoofRcItems)
+	w.writePreamble(false, false, true)
+	w.writeExtensibleInteger(1, 1, maxnoofRcItems)
+	w.writePrintableString("Handover Control", 1, 150)
+	w.writeInteger(2)
+	writeRanParameters(w, RanParameter{Id: 3, Name: "Serving Cell RSRP"})
+	return w.data
+}
+
+/*
+rcRanFunctionDefinitionVector is an E2SM-RC v01.03 RAN function definition of a gNB, as hex encoded in the RAN function
+entities, laid out octet by octet from the E2SM-RC ASN.1 module rather than with aperWriter: event trigger, report and
+control sections, with RAN parameter ids spanning one and two octets.
+*/
+const rcRanFunctionDefinitionVector = "" +
+	"6905804f52414e2d4532534d2d5243000018312e332e362e312e342e312e3533" +
+	"3134382e312e312e322e33050052414e20436f6e74726f6c0101102001010600" +
+	"4d657373616765204576656e7401010001040a00554520496e666f726d617469" +
+	"6f6e204368616e67650104000000000200554520494400800104068055452049" +
+	"6e666f726d6174696f6e010401010101010200011053fe040052524320537461" +
+	"7465105417078053657276696e672043656c6c2050434900a001021000526164" +
+	"696f205265736f7572636520416c6c6f636174696f6e20436f6e74726f6c0000" +
+	"4000050a00536c6963652d6c6576656c205052422071756f7461000100000a00" +
+	"52524d20506f6c69637920526174696f204c697374000a09804d696e20505242" +
+	"20506f6c69637920526174696f010101010101000000000e00536c6963652d6c" +
+	"6576656c205052422071756f7461206170706c696564"
+
+func TestDecodeRcRanFunctionDefinitionVector(t *testing.T) {
+	decoded, err := GetDefaultRegistry().Decode(&entities.RanFunction{RanFunctionOid: RcV1Oid, RanFunctionDefinition: rcRanFunctionDefinitionVector})
+	assert.Nil(t, err)
+	definition := decoded.(*RcRanFunctionDefinition)
+	instance := int64(1)
+	assert.Equal(t, RanFunctionName{ShortName: "ORAN-E2SM-RC", E2smOid: RcV1Oid, Description: "RAN Control", Instance: &instance}, definition.RanFunctionName)
+	assert.Equal(t, &RcEventTrigger{
+		Styles:                     []RcEventTriggerStyle{{Type: 1, Name: "Message Event", FormatType: 1}, {Type: 4, Name: "UE Information Change", FormatType: 4}},
+		UeIdentificationParameters: []RanParameter{{Id: 1, Name: "UE ID"}},
+	}, definition.EventTrigger)
+	assert.Equal(t, []RcReportStyle{{Type: 4, Name: "UE Information", SupportedEventTriggerStyleType: 4, ActionFormatType: 1,
+		IndicationHeaderFormatType: 1, IndicationMessageFormatType: 2, Parameters: []RanParameter{{Id: 21503, Name: "RRC State"}, {Id: 21528, Name: "Serving Cell PCI"}}}}, definition.ReportStyles)
+	assert.Nil(t, definition.InsertStyles)
+	assert.Equal(t, []RcControlStyle{{Type: 2, Name: "Radio Resource Allocation Control", Actions: []RcControlAction{
+		{Id: 6, Name: "Slice-level PRB quota", Parameters: []RanParameter{{Id: 1, Name: "RRM Policy Ratio List"}, {Id: 11, Name: "Min PRB Policy Ratio"}}},
+	}, HeaderFormatType: 1, MessageFormatType: 1, OutcomeFormatType: 1, OutcomeParameters: []RanParameter{{Id: 1, Name: "Slice-level PRB quota applied"}}}}, definition.ControlStyles)
+	assert.Nil(t, definition.PolicyStyles)
+}
+
+func TestDecodeRcRanFunctionDefinition(t *testing.T) {
+	decoded, err := DecodeRcRanFunctionDefinition(buildRcDefinition())
+	assert.Nil(t, err)
+	definition, ok := decoded.(*RcRanFunctionDefinition)
+	assert.True(t, ok)
+	assert.Equal(t, "ORAN-E2SM-RC", definition.ShortName)
+	assert.Equal(t, RcV1Oid, definition.GetRanFunctionName().E2smOid)
+
+	eventTrigger := definition.EventTrigger
+	assert.Equal(t, []RcEventTriggerStyle{{Type: 2, Name: "Call Process Breakpoint", FormatType: 2}}, eventTrigger.Styles)
+	assert.Nil(t, eventTrigger.L2Parameters)
+	assert.Nil(t, eventTrigger.UeIdentificationParameters)
+	assert.Equal(t, []RcCallProcessType{{Id: 1, Name: "Mobility Management", Breakpoints: []RcCallProcessBreakpoint{
+		{Id: 1, Name: "Handover Preparation", Parameters: []RanParameter{{Id: 1, Name: "Target Primary Cell ID"}}},
+	}}}, eventTrigger.CallProcessTypes)
+	assert.Equal(t, []RanParameter{{Id: 70000, Name: "NR CGI"}}, eventTrigger.CellIdentificationParameters)
+
+	assert.Equal(t, []RcReportStyle{{Type: 2, Name: "Call Process Outcome", SupportedEventTriggerStyleType: 2, ActionFormatType: 1,
+		IndicationHeaderFormatType: 1, IndicationMessageFormatType: 2, Parameters: []RanParameter{{Id: 1, Name: "UE ID"}, {Id: 2, Name: "Cell Global ID"}}}}, definition.ReportStyles)
+	assert.Nil(t, definition.InsertStyles)
+
+	assert.Len(t, definition.ControlStyles, 1)
+	controlStyle := definition.ControlStyles[0]
+	assert.EqualValues(t, 3, controlStyle.Type)
+	assert.Equal(t, []RcControlAction{{Id: 1, Name: "Handover Control", Parameters: []RanParameter{{Id: 1, Name: "Target Primary Cell ID"}}}}, controlStyle.Actions)
+	assert.EqualValues(t, 1, *controlStyle.CallProcessIdFormatType)
+	assert.Nil(t, controlStyle.OutcomeParameters)
+
+	assert.Equal(t, []RcPolicyStyle{{Type: 3, Name: "Connected Mode Mobility", SupportedEventTriggerStyleType: 2, Actions: []RcPolicyAction{
+		{Id: 1, Name: "Handover Control", ActionDefinitionFormatType: 2, ConditionParameters: []RanParameter{{Id: 3, Name: "Serving Cell RSRP"}}},
+	}}}, definition.PolicyStyles)
+}
+
+func TestDecodeRcRanFunctionDefinitionInsertStyle(t *testing.T) {
+	w := &aperWriter{}
+	w.writePreamble(false, false, false, true, false, false)
+	writeRanFunctionName(w, "ORAN-E2SM-RC", RcV1Oid, "RAN Control", nil)
+	w.writePreamble(false)
+	w.writeSequenceOfLength(1, 1, maxnoofRicStyles)
+	w.writePreamble(false, true)
+	w.writeInteger(3)
+	w.writePrintableString("Connected Mode Mobility", 1, 150)
+	w.writeInteger(2)
+	w.writeInteger(1)
+	w.writeSequenceOfLength(1, 1, maxnoofRcItems)
+	w.writePreamble(false, false)
+	w.writeExtensibleInteger(1, 1, maxnoofRcItems)
+	w.writePrintableString("Handover Control request", 1, 150)
+	w.writeInteger(1)
+	w.writeInteger(1)
+	w.writeInteger(1)
+	decoded, err := DecodeRcRanFunctionDefinition(w.data)
+	assert.Nil(t, err)
+	definition := decoded.(*RcRanFunctionDefinition)
+	assert.Nil(t, definition.EventTrigger)
+	assert.Equal(t, []RcInsertStyle{{Type: 3, Name: "Connected Mode Mobility", SupportedEventTriggerStyleType: 2, ActionDefinitionFormatType: 1,
+		Indications: []RcInsertIndication{{Id: 1, Name: "Handover Control request"}}, IndicationHeaderFormatType: 1, IndicationMessageFormatType: 1, CallProcessIdFormatType: 1}}, definition.InsertStyles)
+}
+
+func TestDecodeRcRanFunctionDefinitionTruncated(t *testing.T) {
+	data := buildRcDefinition()
+	decoded, err := DecodeRcRanFunctionDefinition(data[:len(data)-3])
+	assert.Nil(t, decoded)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,145 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+import (
+	"encoding/hex"
+	"fmt"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"sort"
+	"sync"
+)
+
+/*
+RanFunctionName is the RANfunction-Name every E2 service model starts its RAN function definition with.
+*/
+type RanFunctionName struct {
+	ShortName   string
+	E2smOid     string
+	Description string
+	Instance    *int64
+}
+
+// GetRanFunctionName returns the RAN function name of the decoded definition
+func (n *RanFunctionName) GetRanFunctionName() *RanFunctionName {
+	return n
+}
+
+/*
+RanFunctionDefinition is a decoded RAN function definition. The concrete type depends on the service model,
+e.g. *KpmRanFunctionDefinition or *RcRanFunctionDefinition.
+*/
+type RanFunctionDefinition interface {
+	GetRanFunctionName() *RanFunctionName
+}
+
+// Decoder decodes the APER encoded RAN function definition of a single E2 service model
+type Decoder func(definition []byte) (RanFunctionDefinition, error)
+
+/*
+Registry holds the RAN function definition decoders keyed by the E2 service model OID.
+*/
+type Registry struct {
+	mux      sync.RWMutex
+	decoders map[string]Decoder
+}
+
+//GetNewRegistry returns reference to an empty Registry
+func GetNewRegistry() *Registry {
+	return &Registry{decoders: map[string]Decoder{}}
+}
+
+//GetDefaultRegistry returns reference to a Registry holding the decoders shipped with this package
+func GetDefaultRegistry() *Registry {
+	registry := GetNewRegistry()
+	registry.Register(KpmV2Oid, DecodeKpmRanFunctionDefinition)
+	registry.Register(RcV1Oid, DecodeRcRanFunctionDefinition)
+	return registry
+}
+
+// Register adds the decoder of the service model identified by oid, replacing any decoder registered before for it
+func (r *Registry) Register(oid string, decoder Decoder) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.decoders[oid] = decoder
+}
+
+// Supports returns true when a decoder is registered for the service model identified by oid
+func (r *Registry) Supports(oid string) bool {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	_, ok := r.decoders[oid]
+	return ok
+}
+
+// Oids returns the sorted OIDs of the service models having a registered decoder
+func (r *Registry) Oids() []string {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	oids := make([]string, 0, len(r.decoders))
+	for oid := range r.decoders {
+		oids = append(oids, oid)
+	}
+	sort.Strings(oids)
+	return oids
+}
+
+/*
+Decode decodes the definition of the RAN function with the decoder registered for its OID.
+The definition is expected as the hex string of its APER encoding, as saved by the E2 manager.
+A RAN function of an unsupported service model yields a ValidationError and an undecodable definition a CorruptData InternalError.
+*/
+func (r *Registry) Decode(ranFunction *entities.RanFunction) (RanFunctionDefinition, error) {
+	oid := ranFunction.GetRanFunctionOid()
+	r.mux.RLock()
+	decoder, ok := r.decoders[oid]
+	r.mux.RUnlock()
+	if !ok {
+		return nil, common.NewValidationErrorf("#e2sm.Decode - no decoder registered for RAN function OID %s", oid)
+	}
+	data, err := hex.DecodeString(ranFunction.GetRanFunctionDefinition())
+	if err != nil {
+		return nil, common.NewCorruptDataError("*entities.RanFunction", oid, fmt.Errorf("#e2sm.Decode - RAN function definition is not a hex string: %w", err))
+	}
+	definition, err := decoder(data)
+	if err != nil {
+		return nil, common.NewCorruptDataError("*entities.RanFunction", oid, fmt.Errorf("#e2sm.Decode - failed to decode RAN function %d definition: %w", ranFunction.GetRanFunctionId(), err))
+	}
+	return definition, nil
+}
+
+func readRanFunctionName(r *aperReader) RanFunctionName {
+	extended, present := r.readSequencePreamble(true, 1)
+	name := RanFunctionName{
+		ShortName:   r.readPrintableString(1, 150),
+		E2smOid:     r.readPrintableString(1, 1000),
+		Description: r.readPrintableString(1, 150),
+	}
+	if present[0] {
+		instance := r.readInteger()
+		name.Instance = &instance
+	}
+	if extended {
+		r.skipExtensions()
+	}
+	return name
+}
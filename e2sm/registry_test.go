@@ -0,0 +1,76 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package e2sm
+
+import (
+	"encoding/hex"
+	"errors"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDefaultRegistryDecode(t *testing.T) {
+	registry := GetDefaultRegistry()
+	assert.Equal(t, []string{RcV1Oid, KpmV2Oid}, registry.Oids())
+	definition, err := registry.Decode(&entities.RanFunction{
+		RanFunctionId:         2,
+		RanFunctionOid:        KpmV2Oid,
+		RanFunctionDefinition: hex.EncodeToString(buildKpmDefinition()),
+	})
+	assert.Nil(t, err)
+	assert.IsType(t, &KpmRanFunctionDefinition{}, definition)
+	assert.Equal(t, "ORAN-E2SM-KPM", definition.GetRanFunctionName().ShortName)
+}
+
+func TestRegistryDecodeUnsupportedOid(t *testing.T) {
+	registry := GetNewRegistry()
+	assert.False(t, registry.Supports(KpmV2Oid))
+	definition, err := registry.Decode(&entities.RanFunction{RanFunctionOid: KpmV2Oid})
+	assert.Nil(t, definition)
+	assert.IsType(t, &common.ValidationError{}, err)
+}
+
+func TestRegistryDecodeNotHex(t *testing.T) {
+	definition, err := GetDefaultRegistry().Decode(&entities.RanFunction{RanFunctionOid: RcV1Oid, RanFunctionDefinition: "not hex"})
+	assert.Nil(t, definition)
+	assert.True(t, errors.Is(err, common.ErrCorruptData))
+}
+
+func TestRegistryDecodeCorruptDefinition(t *testing.T) {
+	definition, err := GetDefaultRegistry().Decode(&entities.RanFunction{RanFunctionId: 3, RanFunctionOid: RcV1Oid, RanFunctionDefinition: "20"})
+	assert.Nil(t, definition)
+	assert.True(t, errors.Is(err, common.ErrCorruptData))
+	assert.Contains(t, err.Error(), "failed to decode RAN function 3 definition")
+}
+
+func TestRegistryRegister(t *testing.T) {
+	registry := GetNewRegistry()
+	custom := &RanFunctionName{ShortName: "custom"}
+	registry.Register("1.2.3", func(definition []byte) (RanFunctionDefinition, error) {
+		return custom, nil
+	})
+	assert.True(t, registry.Supports("1.2.3"))
+	definition, err := registry.Decode(&entities.RanFunction{RanFunctionOid: "1.2.3", RanFunctionDefinition: "00"})
+	assert.Nil(t, err)
+	assert.Equal(t, custom, definition)
+}
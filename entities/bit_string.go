@@ -0,0 +1,56 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package entities
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+parseBitString parses a BIT STRING of length bits, given either as its binary digits
+or as the hex string of its octets with the bits left aligned and the trailing padding bits zero.
+*/
+func parseBitString(s string, length int) (uint64, error) {
+	if len(s) == length && strings.Trim(s, "01") == "" {
+		return strconv.ParseUint(s, 2, 64)
+	}
+	octets := (length + 7) / 8
+	if len(s) != octets*2 {
+		return 0, fmt.Errorf("expected %d binary digits or %d hex digits, got %q", length, octets*2, s)
+	}
+	value, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hex string %q", s)
+	}
+	padding := uint(octets*8 - length)
+	if value&(1<<padding-1) != 0 {
+		return 0, fmt.Errorf("padding bits of %q are not zero", s)
+	}
+	return value >> padding, nil
+}
+
+// formatBitString formats a BIT STRING of length bits as the hex string of its octets, with the bits left aligned
+func formatBitString(value uint64, length int) string {
+	octets := (length + 7) / 8
+	return fmt.Sprintf("%0*x", octets*2, value<<uint(octets*8-length))
+}
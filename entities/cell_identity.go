@@ -0,0 +1,182 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package entities
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	NrCellIdentityLength    = 36
+	EutraCellIdentityLength = 28
+)
+
+/*
+NrCellIdentity is a 36 bits NR cell identity, the gNB ID in its leftmost 22 to 32 bits and the cell local ID in the others.
+rNib stores it like the GnbId.
+*/
+type NrCellIdentity uint64
+
+// NewNrCellIdentity returns the NR cell identity of the cell local ID within the gNB
+func NewNrCellIdentity(gnbId GnbId, cellLocalId uint64) (NrCellIdentity, error) {
+	if err := gnbId.Validate(); err != nil {
+		return 0, err
+	}
+	cellLocalIdLength := uint(NrCellIdentityLength - gnbId.Length)
+	if cellLocalId >= 1<<cellLocalIdLength {
+		return 0, fmt.Errorf("cell local ID %d does not fit in %d bits", cellLocalId, cellLocalIdLength)
+	}
+	return NrCellIdentity(uint64(gnbId.Value)<<cellLocalIdLength | cellLocalId), nil
+}
+
+// ParseNrCellIdentity parses an NR cell identity
+func ParseNrCellIdentity(s string) (NrCellIdentity, error) {
+	value, err := parseBitString(s, NrCellIdentityLength)
+	if err != nil {
+		return 0, fmt.Errorf("#entities.ParseNrCellIdentity - invalid NR cell identity: %s", err)
+	}
+	return NrCellIdentity(value), nil
+}
+
+// GnbId returns the gNB ID held in the leftmost gnbIdLength bits
+func (n NrCellIdentity) GnbId(gnbIdLength int) (GnbId, error) {
+	if gnbIdLength < MinGnbIdLength || gnbIdLength > MaxGnbIdLength {
+		return GnbId{}, fmt.Errorf("gNB ID length %d is not within %d..%d bits", gnbIdLength, MinGnbIdLength, MaxGnbIdLength)
+	}
+	return NewGnbId(uint32(uint64(n)>>uint(NrCellIdentityLength-gnbIdLength)), gnbIdLength)
+}
+
+// CellLocalId returns the cell local ID held in the bits following the gnbIdLength bits of the gNB ID, 0 for an invalid gNB ID length
+func (n NrCellIdentity) CellLocalId(gnbIdLength int) uint64 {
+	if gnbIdLength < MinGnbIdLength || gnbIdLength > MaxGnbIdLength {
+		return 0
+	}
+	return uint64(n) & (1<<uint(NrCellIdentityLength-gnbIdLength) - 1)
+}
+
+// String returns the hex string of the NR cell identity octets, the bits left aligned
+func (n NrCellIdentity) String() string {
+	return formatBitString(uint64(n), NrCellIdentityLength)
+}
+
+/*
+EutraCellIdentity is a 28 bits E-UTRAN cell identity, the eNB ID in its leftmost bits and the cell ID in the others.
+A home eNB ID takes all the 28 bits. rNib stores it like the GnbId.
+*/
+type EutraCellIdentity uint32
+
+// NewEutraCellIdentity returns the E-UTRAN cell identity of the cell within the eNB
+func NewEutraCellIdentity(enbId EnbId, cellId uint32) (EutraCellIdentity, error) {
+	if err := enbId.Validate(); err != nil {
+		return 0, err
+	}
+	cellIdLength := uint(EutraCellIdentityLength - enbId.Length())
+	if uint64(cellId) >= 1<<cellIdLength {
+		return 0, fmt.Errorf("cell ID %d does not fit in %d bits", cellId, cellIdLength)
+	}
+	return EutraCellIdentity(enbId.Value<<cellIdLength | cellId), nil
+}
+
+// ParseEutraCellIdentity parses an E-UTRAN cell identity
+func ParseEutraCellIdentity(s string) (EutraCellIdentity, error) {
+	value, err := parseBitString(s, EutraCellIdentityLength)
+	if err != nil {
+		return 0, fmt.Errorf("#entities.ParseEutraCellIdentity - invalid E-UTRAN cell identity: %s", err)
+	}
+	return EutraCellIdentity(value), nil
+}
+
+// EnbId returns the eNB ID of the eNB type held in the leftmost bits
+func (e EutraCellIdentity) EnbId(enbType EnbType) (EnbId, error) {
+	length := EnbIdLength(enbType)
+	if length == 0 {
+		return EnbId{}, fmt.Errorf("unknown eNB type %s", enbType)
+	}
+	return EnbId{Value: uint32(e) >> uint(EutraCellIdentityLength-length), Type: enbType}, nil
+}
+
+// CellId returns the cell ID held in the bits following the eNB ID of the eNB type
+func (e EutraCellIdentity) CellId(enbType EnbType) uint32 {
+	return uint32(e) & (1<<uint(EutraCellIdentityLength-EnbIdLength(enbType)) - 1)
+}
+
+// String returns the hex string of the E-UTRAN cell identity octets, the bits left aligned
+func (e EutraCellIdentity) String() string {
+	return formatBitString(uint64(e), EutraCellIdentityLength)
+}
+
+// NRCGI is an NR cell global identity, formatted as "<PLMN identity>:<NR cell identity>"
+type NRCGI struct {
+	PlmnId         PlmnId
+	NrCellIdentity NrCellIdentity
+}
+
+// ParseNRCGI parses an NR cell global identity, its PLMN and cell identities either separated by ':' or concatenated
+func ParseNRCGI(s string) (NRCGI, error) {
+	plmnId, cellIdentity, err := parseCgi(s)
+	if err != nil {
+		return NRCGI{}, fmt.Errorf("#entities.ParseNRCGI - invalid NR CGI %q: %s", s, err)
+	}
+	nrCellIdentity, err := ParseNrCellIdentity(cellIdentity)
+	if err != nil {
+		return NRCGI{}, err
+	}
+	return NRCGI{PlmnId: plmnId, NrCellIdentity: nrCellIdentity}, nil
+}
+
+func (c NRCGI) String() string {
+	return c.PlmnId.String() + ":" + c.NrCellIdentity.String()
+}
+
+// ECGI is an E-UTRAN cell global identity, formatted as "<PLMN identity>:<E-UTRAN cell identity>"
+type ECGI struct {
+	PlmnId            PlmnId
+	EutraCellIdentity EutraCellIdentity
+}
+
+// ParseECGI parses an E-UTRAN cell global identity, its PLMN and cell identities either separated by ':' or concatenated
+func ParseECGI(s string) (ECGI, error) {
+	plmnId, cellIdentity, err := parseCgi(s)
+	if err != nil {
+		return ECGI{}, fmt.Errorf("#entities.ParseECGI - invalid ECGI %q: %s", s, err)
+	}
+	eutraCellIdentity, err := ParseEutraCellIdentity(cellIdentity)
+	if err != nil {
+		return ECGI{}, err
+	}
+	return ECGI{PlmnId: plmnId, EutraCellIdentity: eutraCellIdentity}, nil
+}
+
+func (c ECGI) String() string {
+	return c.PlmnId.String() + ":" + c.EutraCellIdentity.String()
+}
+
+func parseCgi(s string) (PlmnId, string, error) {
+	plmn, cellIdentity := s, ""
+	if i := strings.Index(s, ":"); i >= 0 {
+		plmn, cellIdentity = s[:i], s[i+1:]
+	} else if len(s) > 6 {
+		plmn, cellIdentity = s[:6], s[6:]
+	}
+	plmnId, err := ParsePlmnId(plmn)
+	return plmnId, cellIdentity, err
+}
@@ -0,0 +1,134 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package entities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNrCellIdentity(t *testing.T) {
+	gnbId, _ := NewGnbId(0x4a952a0a, 32)
+	nci, err := NewNrCellIdentity(gnbId, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, "4a952a0a50", nci.String())
+	parsedGnbId, err := nci.GnbId(32)
+	assert.Nil(t, err)
+	assert.Equal(t, gnbId, parsedGnbId)
+	assert.EqualValues(t, 5, nci.CellLocalId(32))
+	parsed, err := ParseNrCellIdentity("4a952a0a50")
+	assert.Nil(t, err)
+	assert.Equal(t, nci, parsed)
+	parsed, err = ParseNrCellIdentity("010010101001010100101010000010100101")
+	assert.Nil(t, err)
+	assert.Equal(t, nci, parsed)
+	_, err = nci.GnbId(40)
+	assert.NotNil(t, err)
+	assert.EqualValues(t, 0, nci.CellLocalId(40))
+}
+
+func TestNrCellIdentityInvalid(t *testing.T) {
+	_, err := ParseNrCellIdentity("4a952a0a51")
+	assert.NotNil(t, err)
+	gnbId, _ := NewGnbId(1, 32)
+	_, err = NewNrCellIdentity(gnbId, 16)
+	assert.NotNil(t, err)
+}
+
+func TestEutraCellIdentity(t *testing.T) {
+	enbId, _ := NewEnbId(0x7a8, EnbType_MACRO_ENB)
+	eci, err := NewEutraCellIdentity(enbId, 0x1f)
+	assert.Nil(t, err)
+	assert.Equal(t, "007a81f0", eci.String())
+	parsedEnbId, err := eci.EnbId(EnbType_MACRO_ENB)
+	assert.Nil(t, err)
+	assert.Equal(t, enbId, parsedEnbId)
+	assert.EqualValues(t, 0x1f, eci.CellId(EnbType_MACRO_ENB))
+	assert.EqualValues(t, 0, eci.CellId(EnbType_HOME_ENB))
+	_, err = eci.EnbId(EnbType_UNKNOWN_ENB_TYPE)
+	assert.NotNil(t, err)
+	_, err = NewEutraCellIdentity(enbId, 0x100)
+	assert.NotNil(t, err)
+}
+
+func TestParseNRCGI(t *testing.T) {
+	for _, s := range []string{"02f829:4a952a0a50", "02f8294a952a0a50"} {
+		nrcgi, err := ParseNRCGI(s)
+		assert.Nil(t, err)
+		assert.Equal(t, PlmnId{Mcc: "208", Mnc: "92"}, nrcgi.PlmnId)
+		assert.Equal(t, "02f829:4a952a0a50", nrcgi.String())
+	}
+	_, err := ParseNRCGI("02f829")
+	assert.NotNil(t, err)
+	_, err = ParseNRCGI("0zf829:4a952a0a50")
+	assert.NotNil(t, err)
+}
+
+func TestParseECGI(t *testing.T) {
+	ecgi, err := ParseECGI("02f829007a81f0")
+	assert.Nil(t, err)
+	assert.Equal(t, "02f829:007a81f0", ecgi.String())
+	_, err = ParseECGI("02f829:007a81f")
+	assert.NotNil(t, err)
+}
+
+func TestNodebInfoIds(t *testing.T) {
+	gnb := &NodebInfo{GlobalNbId: &GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"}, Configuration: &NodebInfo_Gnb{Gnb: &Gnb{}}}
+	plmnId, err := gnb.ParsePlmnId()
+	assert.Nil(t, err)
+	assert.Equal(t, "208", plmnId.Mcc)
+	gnbId, err := gnb.ParseGnbId(32)
+	assert.Nil(t, err)
+	assert.Equal(t, GnbId{Value: 0x4a952a0a, Length: 32}, gnbId)
+	_, err = gnb.ParseGnbId(0)
+	assert.NotNil(t, err)
+	gnb.GetGlobalNbId().NbId = "1001010100101010010101"
+	gnbId, err = gnb.ParseGnbId(0)
+	assert.Nil(t, err)
+	assert.Equal(t, GnbId{Value: 0x254a95, Length: 22}, gnbId)
+	enb := &NodebInfo{GlobalNbId: &GlobalNbId{PlmnId: "02f829", NbId: "007a80"}, Configuration: &NodebInfo_Enb{Enb: &Enb{EnbType: EnbType_MACRO_ENB}}}
+	enbId, err := enb.ParseEnbId()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 0x7a8, enbId.Value)
+	_, err = (&NodebInfo{}).ParsePlmnId()
+	assert.NotNil(t, err)
+}
+
+func TestCellIds(t *testing.T) {
+	nrCell := &ServedNRCellInformation{CellId: "02f829:4a952a0a50", ServedPlmns: []string{"02f829", "130014"}}
+	nrcgi, err := nrCell.ParseNRCGI()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 5, nrcgi.NrCellIdentity.CellLocalId(32))
+	plmnIds, err := nrCell.ParseServedPlmns()
+	assert.Nil(t, err)
+	assert.Equal(t, []PlmnId{{Mcc: "208", Mnc: "92"}, {Mcc: "310", Mnc: "410"}}, plmnIds)
+	_, err = (&NrNeighbourInformation{NrCgi: "02f829:4a952a0a50"}).ParseNRCGI()
+	assert.Nil(t, err)
+
+	cell := &ServedCellInfo{CellId: "02f829:007a81f0", BroadcastPlmns: []string{"02f829", "bad"}}
+	ecgi, err := cell.ParseECGI()
+	assert.Nil(t, err)
+	assert.EqualValues(t, 0x1f, ecgi.EutraCellIdentity.CellId(EnbType_MACRO_ENB))
+	_, err = cell.ParseBroadcastPlmns()
+	assert.NotNil(t, err)
+	_, err = (&NeighbourInformation{Ecgi: "02f829:007a81f0"}).ParseECGI()
+	assert.Nil(t, err)
+}
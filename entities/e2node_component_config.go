@@ -0,0 +1,60 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package entities
+
+// InterfaceType returns the interface of the component, taken from its component ID when set and else from its interface type field
+func (x *E2NodeComponentConfig) InterfaceType() E2NodeComponentInterfaceType {
+	switch x.GetE2NodeComponentID().(type) {
+	case *E2NodeComponentConfig_E2NodeComponentInterfaceTypeNG:
+		return E2NodeComponentInterfaceType_ng
+	case *E2NodeComponentConfig_E2NodeComponentInterfaceTypeXn:
+		return E2NodeComponentInterfaceType_xn
+	case *E2NodeComponentConfig_E2NodeComponentInterfaceTypeE1:
+		return E2NodeComponentInterfaceType_e1
+	case *E2NodeComponentConfig_E2NodeComponentInterfaceTypeF1:
+		return E2NodeComponentInterfaceType_f1
+	case *E2NodeComponentConfig_E2NodeComponentInterfaceTypeW1:
+		return E2NodeComponentInterfaceType_w1
+	case *E2NodeComponentConfig_E2NodeComponentInterfaceTypeS1:
+		return E2NodeComponentInterfaceType_s1
+	case *E2NodeComponentConfig_E2NodeComponentInterfaceTypeX2:
+		return E2NodeComponentInterfaceType_x2
+	}
+	return x.GetE2NodeComponentInterfaceType()
+}
+
+// GetNodeConfigs returns the E2 node component configurations of the eNodeb or gNodeb
+func (x *NodebInfo) GetNodeConfigs() []*E2NodeComponentConfig {
+	if x.GetEnb() != nil {
+		return x.GetEnb().GetNodeConfigs()
+	}
+	return x.GetGnb().GetNodeConfigs()
+}
+
+// GroupE2NodeComponentConfigs groups the configurations by interface type, keeping their order within each group
+func GroupE2NodeComponentConfigs(configs []*E2NodeComponentConfig) map[E2NodeComponentInterfaceType][]*E2NodeComponentConfig {
+	groups := make(map[E2NodeComponentInterfaceType][]*E2NodeComponentConfig)
+	for _, config := range configs {
+		interfaceType := config.InterfaceType()
+		groups[interfaceType] = append(groups[interfaceType], config)
+	}
+	return groups
+}
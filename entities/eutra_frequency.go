@@ -0,0 +1,239 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package entities
+
+import "fmt"
+
+/*
+eutraBand is an E-UTRA operating band of 3GPP TS 36.101 table 5.7.3-1, frequencies in units of 100 kHz.
+TDD bands only have a downlink range, SDL bands have no uplink range.
+*/
+type eutraBand struct {
+	band          uint32
+	dlLow         uint32
+	dlFirstEarfcn uint32
+	dlLastEarfcn  uint32
+	ulLow         uint32
+	ulFirstEarfcn uint32
+	ulLastEarfcn  uint32
+}
+
+var eutraBands = []eutraBand{
+	{1, 21100, 0, 599, 19200, 18000, 18599},
+	{2, 19300, 600, 1199, 18500, 18600, 19199},
+	{3, 18050, 1200, 1949, 17100, 19200, 19949},
+	{4, 21100, 1950, 2399, 17100, 19950, 20399},
+	{5, 8690, 2400, 2649, 8240, 20400, 20649},
+	{7, 26200, 2750, 3449, 25000, 20750, 21449},
+	{8, 9250, 3450, 3799, 8800, 21450, 21799},
+	{9, 18449, 3800, 4149, 17499, 21800, 22149},
+	{10, 21100, 4150, 4749, 17100, 22150, 22749},
+	{11, 14759, 4750, 4949, 14279, 22750, 22949},
+	{12, 7290, 5010, 5179, 6990, 23010, 23179},
+	{13, 7460, 5180, 5279, 7770, 23180, 23279},
+	{14, 7580, 5280, 5379, 7880, 23280, 23379},
+	{17, 7340, 5730, 5849, 7040, 23730, 23849},
+	{18, 8600, 5850, 5999, 8150, 23850, 23999},
+	{19, 8750, 6000, 6149, 8300, 24000, 24149},
+	{20, 7910, 6150, 6449, 8320, 24150, 24449},
+	{21, 14959, 6450, 6599, 14479, 24450, 24599},
+	{25, 19300, 8040, 8689, 18500, 26040, 26689},
+	{26, 8590, 8690, 9039, 8140, 26690, 27039},
+	{28, 7580, 9210, 9659, 7030, 27210, 27659},
+	{29, 7170, 9660, 9769, 0, 0, 0},
+	{30, 23500, 9770, 9869, 23050, 27660, 27759},
+	{32, 14520, 9920, 10359, 0, 0, 0},
+	{33, 19000, 36000, 36199, 0, 0, 0},
+	{34, 20100, 36200, 36349, 0, 0, 0},
+	{35, 18500, 36350, 36949, 0, 0, 0},
+	{36, 19300, 36950, 37549, 0, 0, 0},
+	{37, 19100, 37550, 37749, 0, 0, 0},
+	{38, 25700, 37750, 38249, 0, 0, 0},
+	{39, 18800, 38250, 38649, 0, 0, 0},
+	{40, 23000, 38650, 39649, 0, 0, 0},
+	{41, 24960, 39650, 41589, 0, 0, 0},
+	{42, 34000, 41590, 43589, 0, 0, 0},
+	{43, 36000, 43590, 45589, 0, 0, 0},
+	{46, 51500, 46790, 54539, 0, 0, 0},
+	{48, 35500, 55240, 56739, 0, 0, 0},
+	{65, 21100, 65536, 66435, 19200, 131072, 131971},
+	{66, 21100, 66436, 67335, 17100, 131972, 132671},
+	{70, 19950, 68336, 68585, 16950, 132972, 133121},
+	{71, 6170, 68586, 68935, 6630, 133122, 133471},
+}
+
+func getEutraBand(band uint32) (eutraBand, bool) {
+	for _, eutraBand := range eutraBands {
+		if eutraBand.band == band {
+			return eutraBand, true
+		}
+	}
+	return eutraBand{}, false
+}
+
+func (b eutraBand) containsDownlink(frequencyMHz float64) bool {
+	return frequencyMHz >= float64(b.dlLow)/10 && frequencyMHz <= float64(b.dlLow+b.dlLastEarfcn-b.dlFirstEarfcn)/10
+}
+
+// containsUplink returns true when the frequency lies within the uplink range of an FDD band, always for the TDD and SDL bands
+func (b eutraBand) containsUplink(frequencyMHz float64) bool {
+	if b.ulLastEarfcn == 0 {
+		return true
+	}
+	return frequencyMHz >= float64(b.ulLow)/10 && frequencyMHz <= float64(b.ulLow+b.ulLastEarfcn-b.ulFirstEarfcn)/10
+}
+
+// EarfcnToFrequency returns the carrier frequency in MHz and the operating band of the EARFCN (3GPP TS 36.101 5.7.3)
+func EarfcnToFrequency(earfcn uint32) (float64, uint32, error) {
+	for _, band := range eutraBands {
+		if earfcn >= band.dlFirstEarfcn && earfcn <= band.dlLastEarfcn {
+			return float64(band.dlLow+earfcn-band.dlFirstEarfcn) / 10, band.band, nil
+		}
+		if band.ulLastEarfcn > 0 && earfcn >= band.ulFirstEarfcn && earfcn <= band.ulLastEarfcn {
+			return float64(band.ulLow+earfcn-band.ulFirstEarfcn) / 10, band.band, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("#entities.EarfcnToFrequency - EARFCN %d is not within a known E-UTRA band", earfcn)
+}
+
+// eutraChannelBandwidths maps the transmission bandwidth to the channel bandwidth in MHz (3GPP TS 36.101 table 5.6-1)
+var eutraChannelBandwidths = map[TransmissionBandwidth]float64{
+	TransmissionBandwidth_BW1:   0.2,
+	TransmissionBandwidth_BW6:   1.4,
+	TransmissionBandwidth_BW15:  3,
+	TransmissionBandwidth_BW25:  5,
+	TransmissionBandwidth_BW50:  10,
+	TransmissionBandwidth_BW75:  15,
+	TransmissionBandwidth_BW100: 20,
+}
+
+// EutraChannelBandwidth returns the channel bandwidth in MHz of the transmission bandwidth
+func EutraChannelBandwidth(bandwidth TransmissionBandwidth) (float64, error) {
+	channelBandwidth, ok := eutraChannelBandwidths[bandwidth]
+	if !ok {
+		return 0, fmt.Errorf("#entities.EutraChannelBandwidth - unknown transmission bandwidth %s", bandwidth)
+	}
+	return channelBandwidth, nil
+}
+
+func (x *ServedCellInfo) earfcns() (uint32, uint32, error) {
+	choiceEutraMode := x.GetChoiceEutraMode()
+	if choiceEutraMode.GetTdd() != nil {
+		return choiceEutraMode.GetTdd().GetEarFcn(), choiceEutraMode.GetTdd().GetEarFcn(), nil
+	}
+	if choiceEutraMode.GetFdd() != nil {
+		return choiceEutraMode.GetFdd().GetUlearFcn(), choiceEutraMode.GetFdd().GetDlearFcn(), nil
+	}
+	return 0, 0, fmt.Errorf("#entities.ServedCellInfo - cell %s has no EUTRA mode info", x.GetCellId())
+}
+
+func (x *ServedCellInfo) transmissionBandwidths() (TransmissionBandwidth, TransmissionBandwidth, error) {
+	choiceEutraMode := x.GetChoiceEutraMode()
+	if choiceEutraMode.GetTdd() != nil {
+		return choiceEutraMode.GetTdd().GetTransmissionBandwidth(), choiceEutraMode.GetTdd().GetTransmissionBandwidth(), nil
+	}
+	if choiceEutraMode.GetFdd() != nil {
+		return choiceEutraMode.GetFdd().GetUlTransmissionBandwidth(), choiceEutraMode.GetFdd().GetDlTransmissionBandwidth(), nil
+	}
+	return 0, 0, fmt.Errorf("#entities.ServedCellInfo - cell %s has no EUTRA mode info", x.GetCellId())
+}
+
+// UplinkFrequencyMHz returns the uplink carrier frequency in MHz of the cell, the TDD carrier for a TDD cell
+func (x *ServedCellInfo) UplinkFrequencyMHz() (float64, error) {
+	ulEarfcn, _, err := x.earfcns()
+	if err != nil {
+		return 0, err
+	}
+	frequency, _, err := EarfcnToFrequency(ulEarfcn)
+	return frequency, err
+}
+
+// DownlinkFrequencyMHz returns the downlink carrier frequency in MHz of the cell, the TDD carrier for a TDD cell
+func (x *ServedCellInfo) DownlinkFrequencyMHz() (float64, error) {
+	_, dlEarfcn, err := x.earfcns()
+	if err != nil {
+		return 0, err
+	}
+	frequency, _, err := EarfcnToFrequency(dlEarfcn)
+	return frequency, err
+}
+
+// Band returns the E-UTRA operating band of the downlink EARFCN of the cell
+func (x *ServedCellInfo) Band() (uint32, error) {
+	_, dlEarfcn, err := x.earfcns()
+	if err != nil {
+		return 0, err
+	}
+	_, band, err := EarfcnToFrequency(dlEarfcn)
+	return band, err
+}
+
+// UplinkBandwidthMHz returns the uplink channel bandwidth in MHz of the cell, the TDD channel bandwidth for a TDD cell
+func (x *ServedCellInfo) UplinkBandwidthMHz() (float64, error) {
+	ulBandwidth, _, err := x.transmissionBandwidths()
+	if err != nil {
+		return 0, err
+	}
+	return EutraChannelBandwidth(ulBandwidth)
+}
+
+// DownlinkBandwidthMHz returns the downlink channel bandwidth in MHz of the cell, the TDD channel bandwidth for a TDD cell
+func (x *ServedCellInfo) DownlinkBandwidthMHz() (float64, error) {
+	_, dlBandwidth, err := x.transmissionBandwidths()
+	if err != nil {
+		return 0, err
+	}
+	return EutraChannelBandwidth(dlBandwidth)
+}
+
+/*
+ValidateBands checks that the EARFCNs of the cell lie within a single E-UTRA band, and that its carrier frequencies lie
+within the downlink and uplink ranges of every band of its multiband infos.
+*/
+func (x *ServedCellInfo) ValidateBands() error {
+	ulEarfcn, dlEarfcn, err := x.earfcns()
+	if err != nil {
+		return err
+	}
+	ulFrequency, ulBand, err := EarfcnToFrequency(ulEarfcn)
+	if err != nil {
+		return err
+	}
+	dlFrequency, dlBand, err := EarfcnToFrequency(dlEarfcn)
+	if err != nil {
+		return err
+	}
+	if ulBand != dlBand {
+		return fmt.Errorf("#entities.ServedCellInfo.ValidateBands - uplink EARFCN %d (band %d) and downlink EARFCN %d (band %d) are not in the same band", ulEarfcn, ulBand, dlEarfcn, dlBand)
+	}
+	for _, multibandInfo := range x.GetMultibandInfos() {
+		band, ok := getEutraBand(multibandInfo)
+		if !ok {
+			return fmt.Errorf("#entities.ServedCellInfo.ValidateBands - unknown multiband info band %d", multibandInfo)
+		}
+		if !band.containsDownlink(dlFrequency) {
+			return fmt.Errorf("#entities.ServedCellInfo.ValidateBands - downlink EARFCN %d (%g MHz) is not within multiband info band %d", dlEarfcn, dlFrequency, multibandInfo)
+		}
+		if !band.containsUplink(ulFrequency) {
+			return fmt.Errorf("#entities.ServedCellInfo.ValidateBands - uplink EARFCN %d (%g MHz) is not within multiband info band %d", ulEarfcn, ulFrequency, multibandInfo)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+
+package entities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEarfcnToFrequency(t *testing.T) {
+	tests := []struct {
+		earfcn    uint32
+		frequency float64
+		band      uint32
+	}{
+		{earfcn: 300, frequency: 2140, band: 1},
+		{earfcn: 18300, frequency: 1950, band: 1},
+		{earfcn: 1575, frequency: 1842.5, band: 3},
+		{earfcn: 6300, frequency: 806, band: 20},
+		{earfcn: 38000, frequency: 2595, band: 38},
+		{earfcn: 9700, frequency: 721, band: 29},
+		{earfcn: 9800, frequency: 2353, band: 30},
+		{earfcn: 27700, frequency: 2309, band: 30},
+		{earfcn: 65600, frequency: 2116.4, band: 65},
+		{earfcn: 131100, frequency: 1922.8, band: 65},
+		{earfcn: 66536, frequency: 2120, band: 66},
+		{earfcn: 68400, frequency: 2001.4, band: 70},
+		{earfcn: 133000, frequency: 1697.8, band: 70},
+	}
+	for _, tc := range tests {
+		frequency, band, err := EarfcnToFrequency(tc.earfcn)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.frequency, frequency)
+		assert.Equal(t, tc.band, band)
+	}
+	_, _, err := EarfcnToFrequency(5000)
+	assert.NotNil(t, err)
+}
+
+func TestEutraChannelBandwidth(t *testing.T) {
+	bandwidth, err := EutraChannelBandwidth(TransmissionBandwidth_BW6)
+	assert.Nil(t, err)
+	assert.Equal(t, 1.4, bandwidth)
+	_, err = EutraChannelBandwidth(TransmissionBandwidth_UNKNOWN_TRANSMISSION_BANDWIDTH)
+	assert.NotNil(t, err)
+}
+
+func TestServedCellInfoFdd(t *testing.T) {
+	cell := &ServedCellInfo{ChoiceEutraMode: &ChoiceEUTRAMode{Fdd: &FddInfo{
+		UlearFcn:                18300,
+		DlearFcn:                300,
+		UlTransmissionBandwidth: TransmissionBandwidth_BW50,
+		DlTransmissionBandwidth: TransmissionBandwidth_BW100,
+	}}}
+	ul, err := cell.UplinkFrequencyMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(1950), ul)
+	dl, err := cell.DownlinkFrequencyMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(2140), dl)
+	band, err := cell.Band()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), band)
+	ulBandwidth, err := cell.UplinkBandwidthMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(10), ulBandwidth)
+	dlBandwidth, err := cell.DownlinkBandwidthMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(20), dlBandwidth)
+	assert.Nil(t, cell.ValidateBands())
+	cell.ChoiceEutraMode.Fdd.UlearFcn = 19500
+	assert.NotNil(t, cell.ValidateBands())
+}
+
+func TestServedCellInfoMultibandInfos(t *testing.T) {
+	cell := &ServedCellInfo{ChoiceEutraMode: &ChoiceEUTRAMode{Fdd: &FddInfo{UlearFcn: 18300, DlearFcn: 300}}, MultibandInfos: []uint32{65}}
+	assert.Nil(t, cell.ValidateBands())
+	// 1950 MHz is not within the band 66 uplink range
+	cell.MultibandInfos = []uint32{65, 66}
+	assert.NotNil(t, cell.ValidateBands())
+	cell.MultibandInfos = []uint32{3}
+	assert.NotNil(t, cell.ValidateBands())
+	cell.MultibandInfos = []uint32{99}
+	assert.NotNil(t, cell.ValidateBands())
+	tddCell := &ServedCellInfo{ChoiceEutraMode: &ChoiceEUTRAMode{Tdd: &TddInfo{EarFcn: 40000}}, MultibandInfos: []uint32{41}}
+	assert.Nil(t, tddCell.ValidateBands())
+}
+
+func TestServedCellInfoTdd(t *testing.T) {
+	cell := &ServedCellInfo{ChoiceEutraMode: &ChoiceEUTRAMode{Tdd: &TddInfo{EarFcn: 38000, TransmissionBandwidth: TransmissionBandwidth_BW100}}}
+	ul, err := cell.UplinkFrequencyMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(2595), ul)
+	bandwidth, err := cell.DownlinkBandwidthMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(20), bandwidth)
+	assert.Nil(t, cell.ValidateBands())
+}
+
+func TestServedCellInfoNoMode(t *testing.T) {
+	cell := &ServedCellInfo{CellId: "02f829:0007ab50"}
+	_, err := cell.DownlinkFrequencyMHz()
+	assert.NotNil(t, err)
+	_, err = cell.UplinkBandwidthMHz()
+	assert.NotNil(t, err)
+}
@@ -2,4 +2,12 @@ module gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities
 
 go 1.17
 
-require google.golang.org/protobuf v1.23.0
+require (
+	github.com/stretchr/testify v1.3.0
+	google.golang.org/protobuf v1.23.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+)
@@ -0,0 +1,150 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package entities
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	MinGnbIdLength = 22
+	MaxGnbIdLength = 32
+)
+
+/*
+GnbId is a gNB ID, a BIT STRING of 22 to 32 bits. rNib stores it as the hex string of its octets with the bits left aligned,
+or as its binary digits.
+*/
+type GnbId struct {
+	Value  uint32
+	Length int
+}
+
+// NewGnbId returns the gNB ID of length bits holding value
+func NewGnbId(value uint32, length int) (GnbId, error) {
+	gnbId := GnbId{Value: value, Length: length}
+	return gnbId, gnbId.Validate()
+}
+
+/*
+ParseGnbId parses a gNB ID of length bits. When length is 0 it is taken from s, which must then hold the binary digits:
+the hex string of the octets does not tell the length, a 22 bits gNB ID taking as many hex digits as a 24 bits one.
+*/
+func ParseGnbId(s string, length int) (GnbId, error) {
+	if length == 0 {
+		if strings.Trim(s, "01") != "" {
+			return GnbId{}, fmt.Errorf("#entities.ParseGnbId - invalid gNB ID %q: the length of a gNB ID given in hex digits is required", s)
+		}
+		length = len(s)
+	}
+	if length < MinGnbIdLength || length > MaxGnbIdLength {
+		return GnbId{}, fmt.Errorf("#entities.ParseGnbId - invalid gNB ID %q: length %d is not within %d..%d bits", s, length, MinGnbIdLength, MaxGnbIdLength)
+	}
+	value, err := parseBitString(s, length)
+	if err != nil {
+		return GnbId{}, fmt.Errorf("#entities.ParseGnbId - invalid gNB ID: %s", err)
+	}
+	return GnbId{Value: uint32(value), Length: length}, nil
+}
+
+// Validate checks that the length is within 22..32 bits and the value fits in it
+func (g GnbId) Validate() error {
+	if g.Length < MinGnbIdLength || g.Length > MaxGnbIdLength {
+		return fmt.Errorf("gNB ID length %d is not within %d..%d bits", g.Length, MinGnbIdLength, MaxGnbIdLength)
+	}
+	if uint64(g.Value) >= 1<<uint(g.Length) {
+		return fmt.Errorf("gNB ID %d does not fit in %d bits", g.Value, g.Length)
+	}
+	return nil
+}
+
+// String returns the hex string of the gNB ID octets, the bits left aligned
+func (g GnbId) String() string {
+	return formatBitString(uint64(g.Value), g.Length)
+}
+
+/*
+EnbId is an eNB ID, whose length depends on the eNB type: 20 bits for macro, 28 for home, 18 for short macro
+and 21 for long macro eNBs and ng-eNBs. rNib stores it like the GnbId.
+*/
+type EnbId struct {
+	Value uint32
+	Type  EnbType
+}
+
+// EnbIdLength returns the length in bits of the eNB ID of the eNB type, 0 for an unknown type
+func EnbIdLength(enbType EnbType) int {
+	switch enbType {
+	case EnbType_MACRO_ENB, EnbType_MACRO_NG_ENB:
+		return 20
+	case EnbType_HOME_ENB:
+		return 28
+	case EnbType_SHORT_MACRO_ENB, EnbType_SHORT_MACRO_NG_ENB:
+		return 18
+	case EnbType_LONG_MACRO_ENB, EnbType_LONG_MACRO_NG_ENB:
+		return 21
+	}
+	return 0
+}
+
+// NewEnbId returns the eNB ID of the eNB type holding value
+func NewEnbId(value uint32, enbType EnbType) (EnbId, error) {
+	enbId := EnbId{Value: value, Type: enbType}
+	return enbId, enbId.Validate()
+}
+
+// ParseEnbId parses an eNB ID of the eNB type
+func ParseEnbId(s string, enbType EnbType) (EnbId, error) {
+	length := EnbIdLength(enbType)
+	if length == 0 {
+		return EnbId{}, fmt.Errorf("#entities.ParseEnbId - invalid eNB ID %q: unknown eNB type %s", s, enbType)
+	}
+	value, err := parseBitString(s, length)
+	if err != nil {
+		return EnbId{}, fmt.Errorf("#entities.ParseEnbId - invalid %s ID: %s", enbType, err)
+	}
+	return EnbId{Value: uint32(value), Type: enbType}, nil
+}
+
+// Length returns the length in bits of the eNB ID
+func (e EnbId) Length() int {
+	return EnbIdLength(e.Type)
+}
+
+// Validate checks that the eNB type is known and the value fits in its length
+func (e EnbId) Validate() error {
+	if e.Length() == 0 {
+		return fmt.Errorf("unknown eNB type %s", e.Type)
+	}
+	if uint64(e.Value) >= 1<<uint(e.Length()) {
+		return fmt.Errorf("%s ID %d does not fit in %d bits", e.Type, e.Value, e.Length())
+	}
+	return nil
+}
+
+// String returns the hex string of the eNB ID octets, the bits left aligned, or "" for an unknown eNB type
+func (e EnbId) String() string {
+	if e.Length() == 0 {
+		return ""
+	}
+	return formatBitString(uint64(e.Value), e.Length())
+}
@@ -0,0 +1,102 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package entities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseGnbId(t *testing.T) {
+	tests := []struct {
+		name     string
+		nbId     string
+		length   int
+		expected GnbId
+	}{
+		{name: "32 bits hex", nbId: "4a952a0a", length: 32, expected: GnbId{Value: 0x4a952a0a, Length: 32}},
+		{name: "24 bits hex", nbId: "4a952a", length: 24, expected: GnbId{Value: 0x4a952a, Length: 24}},
+		{name: "22 bits hex", nbId: "4a952c", length: 22, expected: GnbId{Value: 0x4a952c >> 2, Length: 22}},
+		{name: "22 bits binary", nbId: "1001010100101010010101", expected: GnbId{Value: 0x254a95, Length: 22}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gnbId, err := ParseGnbId(tc.nbId, tc.length)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, gnbId)
+		})
+	}
+}
+
+func TestParseGnbIdInvalid(t *testing.T) {
+	for _, nbId := range []string{"001", "4a952a0a0a", "4a952x", "4a952a0a", "4a952c", "10010101001010100101012"} {
+		_, err := ParseGnbId(nbId, 0)
+		assert.NotNil(t, err, nbId)
+	}
+	_, err := ParseGnbId("4a952b", 22)
+	assert.NotNil(t, err)
+}
+
+func TestGnbIdString(t *testing.T) {
+	gnbId, err := NewGnbId(0x12b25, 22)
+	assert.Nil(t, err)
+	assert.Equal(t, "04ac94", gnbId.String())
+	parsed, err := ParseGnbId(gnbId.String(), 22)
+	assert.Nil(t, err)
+	assert.Equal(t, gnbId, parsed)
+	_, err = NewGnbId(1<<22, 22)
+	assert.NotNil(t, err)
+	_, err = NewGnbId(1, 21)
+	assert.NotNil(t, err)
+}
+
+func TestParseEnbId(t *testing.T) {
+	tests := []struct {
+		enbType  EnbType
+		nbId     string
+		expected uint32
+	}{
+		{enbType: EnbType_MACRO_ENB, nbId: "007a80", expected: 0x7a8},
+		{enbType: EnbType_HOME_ENB, nbId: "007a8010", expected: 0x7a801},
+		{enbType: EnbType_SHORT_MACRO_ENB, nbId: "007a80", expected: 0x1ea},
+		{enbType: EnbType_LONG_MACRO_NG_ENB, nbId: "007a80", expected: 0xf50},
+	}
+	for _, tc := range tests {
+		t.Run(tc.enbType.String(), func(t *testing.T) {
+			enbId, err := ParseEnbId(tc.nbId, tc.enbType)
+			assert.Nil(t, err)
+			assert.Equal(t, EnbId{Value: tc.expected, Type: tc.enbType}, enbId)
+			assert.Equal(t, tc.nbId, enbId.String())
+		})
+	}
+}
+
+func TestParseEnbIdInvalid(t *testing.T) {
+	_, err := ParseEnbId("007a80", EnbType_UNKNOWN_ENB_TYPE)
+	assert.NotNil(t, err)
+	_, err = ParseEnbId("007a81", EnbType_MACRO_ENB)
+	assert.NotNil(t, err)
+	_, err = ParseEnbId("007a", EnbType_MACRO_ENB)
+	assert.NotNil(t, err)
+	_, err = NewEnbId(1<<20, EnbType_MACRO_ENB)
+	assert.NotNil(t, err)
+	assert.Equal(t, "", EnbId{}.String())
+}
@@ -0,0 +1,93 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package entities
+
+// ParsePlmnId parses the PLMN identity of the global nodeb ID
+func (x *GlobalNbId) ParsePlmnId() (PlmnId, error) {
+	return ParsePlmnId(x.GetPlmnId())
+}
+
+// ParseGnbId parses the nodeb ID of the global nodeb ID as a gNB ID of length bits, 0 when it is stored as binary digits
+func (x *GlobalNbId) ParseGnbId(length int) (GnbId, error) {
+	return ParseGnbId(x.GetNbId(), length)
+}
+
+// ParseEnbId parses the nodeb ID of the global nodeb ID as an eNB ID of the eNB type
+func (x *GlobalNbId) ParseEnbId(enbType EnbType) (EnbId, error) {
+	return ParseEnbId(x.GetNbId(), enbType)
+}
+
+// ParsePlmnId parses the PLMN identity of the nodeb
+func (x *NodebInfo) ParsePlmnId() (PlmnId, error) {
+	return x.GetGlobalNbId().ParsePlmnId()
+}
+
+// ParseGnbId parses the gNB ID of the nodeb, of length bits or 0 when it is stored as binary digits
+func (x *NodebInfo) ParseGnbId(length int) (GnbId, error) {
+	return x.GetGlobalNbId().ParseGnbId(length)
+}
+
+// ParseEnbId parses the eNB ID of the nodeb, whose length is given by the eNB type of its configuration
+func (x *NodebInfo) ParseEnbId() (EnbId, error) {
+	return x.GetGlobalNbId().ParseEnbId(x.GetEnb().GetEnbType())
+}
+
+// ParseNRCGI parses the cell ID of the served NR cell as an NR CGI
+func (x *ServedNRCellInformation) ParseNRCGI() (NRCGI, error) {
+	return ParseNRCGI(x.GetCellId())
+}
+
+// ParseServedPlmns parses the PLMN identities served by the NR cell
+func (x *ServedNRCellInformation) ParseServedPlmns() ([]PlmnId, error) {
+	return parsePlmnIds(x.GetServedPlmns())
+}
+
+// ParseNRCGI parses the NR CGI of the NR neighbour
+func (x *NrNeighbourInformation) ParseNRCGI() (NRCGI, error) {
+	return ParseNRCGI(x.GetNrCgi())
+}
+
+// ParseECGI parses the cell ID of the served E-UTRAN cell as an ECGI
+func (x *ServedCellInfo) ParseECGI() (ECGI, error) {
+	return ParseECGI(x.GetCellId())
+}
+
+// ParseBroadcastPlmns parses the PLMN identities broadcast by the E-UTRAN cell
+func (x *ServedCellInfo) ParseBroadcastPlmns() ([]PlmnId, error) {
+	return parsePlmnIds(x.GetBroadcastPlmns())
+}
+
+// ParseECGI parses the ECGI of the E-UTRAN neighbour
+func (x *NeighbourInformation) ParseECGI() (ECGI, error) {
+	return ParseECGI(x.GetEcgi())
+}
+
+func parsePlmnIds(plmns []string) ([]PlmnId, error) {
+	plmnIds := make([]PlmnId, 0, len(plmns))
+	for _, plmn := range plmns {
+		plmnId, err := ParsePlmnId(plmn)
+		if err != nil {
+			return nil, err
+		}
+		plmnIds = append(plmnIds, plmnId)
+	}
+	return plmnIds, nil
+}
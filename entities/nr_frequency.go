@@ -0,0 +1,284 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package entities
+
+import "fmt"
+
+const MaxNrArfcn = 3279165
+
+// nrArfcnRanges are the global frequency raster ranges of 3GPP TS 38.104 table 5.4.2.1-1, in kHz
+var nrArfcnRanges = []struct {
+	firstArfcn uint64
+	lastArfcn  uint64
+	stepKHz    uint64
+	offsetKHz  uint64
+}{
+	{firstArfcn: 0, lastArfcn: 599999, stepKHz: 5, offsetKHz: 0},
+	{firstArfcn: 600000, lastArfcn: 2016666, stepKHz: 15, offsetKHz: 3000000},
+	{firstArfcn: 2016667, lastArfcn: MaxNrArfcn, stepKHz: 60, offsetKHz: 24250080},
+}
+
+/*
+NrOperatingBand is an NR operating band of 3GPP TS 38.104 tables 5.2-1 and 5.2-2.
+The uplink range of SDL bands and the downlink range of SUL bands are zero.
+*/
+type NrOperatingBand struct {
+	Band            uint32
+	UplinkLowMHz    float64
+	UplinkHighMHz   float64
+	DownlinkLowMHz  float64
+	DownlinkHighMHz float64
+}
+
+var nrOperatingBands = map[uint32]NrOperatingBand{}
+
+func init() {
+	for _, band := range []NrOperatingBand{
+		{1, 1920, 1980, 2110, 2170},
+		{2, 1850, 1910, 1930, 1990},
+		{3, 1710, 1785, 1805, 1880},
+		{5, 824, 849, 869, 894},
+		{7, 2500, 2570, 2620, 2690},
+		{8, 880, 915, 925, 960},
+		{12, 699, 716, 729, 746},
+		{13, 777, 787, 746, 756},
+		{14, 788, 798, 758, 768},
+		{18, 815, 830, 860, 875},
+		{20, 832, 862, 791, 821},
+		{24, 1626.5, 1660.5, 1525, 1559},
+		{25, 1850, 1915, 1930, 1995},
+		{26, 814, 849, 859, 894},
+		{28, 703, 748, 758, 803},
+		{29, 0, 0, 717, 728},
+		{30, 2305, 2315, 2350, 2360},
+		{34, 2010, 2025, 2010, 2025},
+		{38, 2570, 2620, 2570, 2620},
+		{39, 1880, 1920, 1880, 1920},
+		{40, 2300, 2400, 2300, 2400},
+		{41, 2496, 2690, 2496, 2690},
+		{46, 5150, 5925, 5150, 5925},
+		{48, 3550, 3700, 3550, 3700},
+		{50, 1432, 1517, 1432, 1517},
+		{51, 1427, 1432, 1427, 1432},
+		{53, 2483.5, 2495, 2483.5, 2495},
+		{65, 1920, 2010, 2110, 2200},
+		{66, 1710, 1780, 2110, 2200},
+		{67, 0, 0, 738, 758},
+		{70, 1695, 1710, 1995, 2020},
+		{71, 663, 698, 617, 652},
+		{74, 1427, 1470, 1475, 1518},
+		{75, 0, 0, 1432, 1517},
+		{76, 0, 0, 1427, 1432},
+		{77, 3300, 4200, 3300, 4200},
+		{78, 3300, 3800, 3300, 3800},
+		{79, 4400, 5000, 4400, 5000},
+		{80, 1710, 1785, 0, 0},
+		{81, 880, 915, 0, 0},
+		{82, 832, 862, 0, 0},
+		{83, 703, 748, 0, 0},
+		{84, 1920, 1980, 0, 0},
+		{85, 698, 716, 728, 746},
+		{86, 1710, 1780, 0, 0},
+		{89, 824, 849, 0, 0},
+		{90, 2496, 2690, 2496, 2690},
+		{91, 832, 862, 1427, 1432},
+		{92, 832, 862, 1432, 1517},
+		{93, 880, 915, 1427, 1432},
+		{94, 880, 915, 1432, 1517},
+		{95, 2010, 2025, 0, 0},
+		{96, 5925, 7125, 5925, 7125},
+		{257, 26500, 29500, 26500, 29500},
+		{258, 24250, 27500, 24250, 27500},
+		{259, 39500, 43500, 39500, 43500},
+		{260, 37000, 40000, 37000, 40000},
+		{261, 27500, 28350, 27500, 28350},
+		{262, 47200, 48200, 47200, 48200},
+	} {
+		nrOperatingBands[band.Band] = band
+	}
+}
+
+// GetNrOperatingBand returns the NR operating band n<band>
+func GetNrOperatingBand(band uint32) (NrOperatingBand, bool) {
+	operatingBand, ok := nrOperatingBands[band]
+	return operatingBand, ok
+}
+
+// ContainsUplink returns true when the frequency lies within the uplink range of the band, never for an SDL band
+func (b NrOperatingBand) ContainsUplink(frequencyMHz float64) bool {
+	return b.UplinkHighMHz > 0 && frequencyMHz >= b.UplinkLowMHz && frequencyMHz <= b.UplinkHighMHz
+}
+
+// ContainsDownlink returns true when the frequency lies within the downlink range of the band, never for an SUL band
+func (b NrOperatingBand) ContainsDownlink(frequencyMHz float64) bool {
+	return b.DownlinkHighMHz > 0 && frequencyMHz >= b.DownlinkLowMHz && frequencyMHz <= b.DownlinkHighMHz
+}
+
+// NrArfcnToFrequency returns the RF reference frequency in MHz of the NR-ARFCN (3GPP TS 38.104 5.4.2.1)
+func NrArfcnToFrequency(nrArfcn uint64) (float64, error) {
+	for _, arfcnRange := range nrArfcnRanges {
+		if nrArfcn >= arfcnRange.firstArfcn && nrArfcn <= arfcnRange.lastArfcn {
+			frequencyKHz := arfcnRange.offsetKHz + arfcnRange.stepKHz*(nrArfcn-arfcnRange.firstArfcn)
+			return float64(frequencyKHz) / 1000, nil
+		}
+	}
+	return 0, fmt.Errorf("#entities.NrArfcnToFrequency - NR-ARFCN %d is above %d", nrArfcn, MaxNrArfcn)
+}
+
+// nrChannelBandwidths maps the subcarrier spacing and the transmission bandwidth in resource blocks to the channel bandwidth in MHz
+// (3GPP TS 38.101-1 table 5.3.2-1 and TS 38.101-2 table 5.3.2-1)
+var nrChannelBandwidths = map[Nrscs]map[Ncnrb]float64{
+	Nrscs_SCS15: {
+		Ncnrb_NRB25: 5, Ncnrb_NRB52: 10, Ncnrb_NRB79: 15, Ncnrb_NRB106: 20, Ncnrb_NRB133: 25,
+		Ncnrb_NRB160: 30, Ncnrb_NRB216: 40, Ncnrb_NRB270: 50,
+	},
+	Nrscs_SCS30: {
+		Ncnrb_NRB11: 5, Ncnrb_NRB24: 10, Ncnrb_NRB38: 15, Ncnrb_NRB51: 20, Ncnrb_NRB65: 25, Ncnrb_NRB78: 30,
+		Ncnrb_NRB106: 40, Ncnrb_NRB133: 50, Ncnrb_NRB162: 60, Ncnrb_NRB189: 70, Ncnrb_NRB217: 80, Ncnrb_NRB245: 90, Ncnrb_NRB273: 100,
+	},
+	Nrscs_SCS60: {
+		Ncnrb_NRB11: 10, Ncnrb_NRB18: 15, Ncnrb_NRB24: 20, Ncnrb_NRB31: 25, Ncnrb_NRB38: 30, Ncnrb_NRB51: 40, Ncnrb_NRB65: 50,
+		Ncnrb_NRB79: 60, Ncnrb_NRB93: 70, Ncnrb_NRB107: 80, Ncnrb_NRB121: 90, Ncnrb_NRB135: 100,
+		Ncnrb_NRB66: 50, Ncnrb_NRB132: 100, Ncnrb_NRB264: 200,
+	},
+	Nrscs_SCS120: {
+		Ncnrb_NRB32: 50, Ncnrb_NRB66: 100, Ncnrb_NRB132: 200, Ncnrb_NRB264: 400,
+	},
+}
+
+// NrChannelBandwidth returns the channel bandwidth in MHz of the transmission bandwidth of nrb resource blocks at the subcarrier spacing
+func NrChannelBandwidth(scs Nrscs, nrb Ncnrb) (float64, error) {
+	bandwidth, ok := nrChannelBandwidths[scs][nrb]
+	if !ok {
+		return 0, fmt.Errorf("#entities.NrChannelBandwidth - no channel bandwidth has %s resource blocks at %s", nrb, scs)
+	}
+	return bandwidth, nil
+}
+
+// ChannelBandwidthMHz returns the channel bandwidth in MHz of the transmission bandwidth
+func (x *NrTransmissionBandwidth) ChannelBandwidthMHz() (float64, error) {
+	return NrChannelBandwidth(x.GetNrscs(), x.GetNcnrb())
+}
+
+// FrequencyMHz returns the RF reference frequency in MHz of the NR-ARFCN
+func (x *NrFrequencyInfo) FrequencyMHz() (float64, error) {
+	return NrArfcnToFrequency(x.GetNrArFcn())
+}
+
+// ValidateUplinkBands checks that the frequency of the uplink NR-ARFCN lies within the uplink range of every declared frequency band
+func (x *NrFrequencyInfo) ValidateUplinkBands() error {
+	return x.validateBands("uplink", NrOperatingBand.ContainsUplink)
+}
+
+// ValidateDownlinkBands checks that the frequency of the downlink NR-ARFCN lies within the downlink range of every declared frequency band
+func (x *NrFrequencyInfo) ValidateDownlinkBands() error {
+	return x.validateBands("downlink", NrOperatingBand.ContainsDownlink)
+}
+
+func (x *NrFrequencyInfo) validateBands(direction string, contains func(NrOperatingBand, float64) bool) error {
+	frequency, err := x.FrequencyMHz()
+	if err != nil {
+		return err
+	}
+	for _, frequencyBand := range x.GetFrequencyBands() {
+		band, ok := GetNrOperatingBand(frequencyBand.GetNrFrequencyBand())
+		if !ok {
+			return fmt.Errorf("#entities.NrFrequencyInfo.ValidateBands - unknown NR band n%d", frequencyBand.GetNrFrequencyBand())
+		}
+		if !contains(band, frequency) {
+			return fmt.Errorf("#entities.NrFrequencyInfo.ValidateBands - %s NR-ARFCN %d (%g MHz) is not within the %s range of band n%d", direction, x.GetNrArFcn(), frequency, direction, band.Band)
+		}
+	}
+	return nil
+}
+
+func (x *ServedNRCell) uplinkFrequencyInfo() *NrFrequencyInfo {
+	choiceNrMode := x.GetServedNrCellInformation().GetChoiceNrMode()
+	if choiceNrMode.GetTdd() != nil {
+		return choiceNrMode.GetTdd().GetNrFreqInfo()
+	}
+	return choiceNrMode.GetFdd().GetUlFreqInfo()
+}
+
+func (x *ServedNRCell) downlinkFrequencyInfo() *NrFrequencyInfo {
+	choiceNrMode := x.GetServedNrCellInformation().GetChoiceNrMode()
+	if choiceNrMode.GetTdd() != nil {
+		return choiceNrMode.GetTdd().GetNrFreqInfo()
+	}
+	return choiceNrMode.GetFdd().GetDlFreqInfo()
+}
+
+func (x *ServedNRCell) uplinkTransmissionBandwidth() *NrTransmissionBandwidth {
+	choiceNrMode := x.GetServedNrCellInformation().GetChoiceNrMode()
+	if choiceNrMode.GetTdd() != nil {
+		return choiceNrMode.GetTdd().GetTransmissionBandwidth()
+	}
+	return choiceNrMode.GetFdd().GetUlTransmissionBandwidth()
+}
+
+func (x *ServedNRCell) downlinkTransmissionBandwidth() *NrTransmissionBandwidth {
+	choiceNrMode := x.GetServedNrCellInformation().GetChoiceNrMode()
+	if choiceNrMode.GetTdd() != nil {
+		return choiceNrMode.GetTdd().GetTransmissionBandwidth()
+	}
+	return choiceNrMode.GetFdd().GetDlTransmissionBandwidth()
+}
+
+// UplinkFrequencyMHz returns the uplink carrier frequency in MHz of the cell, the TDD carrier for a TDD cell
+func (x *ServedNRCell) UplinkFrequencyMHz() (float64, error) {
+	if x.uplinkFrequencyInfo() == nil {
+		return 0, fmt.Errorf("#entities.ServedNRCell.UplinkFrequencyMHz - cell %s has no NR mode info", x.GetServedNrCellInformation().GetCellId())
+	}
+	return x.uplinkFrequencyInfo().FrequencyMHz()
+}
+
+// DownlinkFrequencyMHz returns the downlink carrier frequency in MHz of the cell, the TDD carrier for a TDD cell
+func (x *ServedNRCell) DownlinkFrequencyMHz() (float64, error) {
+	if x.downlinkFrequencyInfo() == nil {
+		return 0, fmt.Errorf("#entities.ServedNRCell.DownlinkFrequencyMHz - cell %s has no NR mode info", x.GetServedNrCellInformation().GetCellId())
+	}
+	return x.downlinkFrequencyInfo().FrequencyMHz()
+}
+
+// UplinkBandwidthMHz returns the uplink channel bandwidth in MHz of the cell, the TDD channel bandwidth for a TDD cell
+func (x *ServedNRCell) UplinkBandwidthMHz() (float64, error) {
+	return x.uplinkTransmissionBandwidth().ChannelBandwidthMHz()
+}
+
+// DownlinkBandwidthMHz returns the downlink channel bandwidth in MHz of the cell, the TDD channel bandwidth for a TDD cell
+func (x *ServedNRCell) DownlinkBandwidthMHz() (float64, error) {
+	return x.downlinkTransmissionBandwidth().ChannelBandwidthMHz()
+}
+
+/*
+ValidateBands checks that the uplink and downlink NR-ARFCNs of the cell lie within the uplink and downlink ranges
+of their declared frequency bands, the TDD NR-ARFCN within both.
+*/
+func (x *ServedNRCell) ValidateBands() error {
+	if uplinkFrequencyInfo := x.uplinkFrequencyInfo(); uplinkFrequencyInfo != nil {
+		if err := uplinkFrequencyInfo.ValidateUplinkBands(); err != nil {
+			return err
+		}
+	}
+	if downlinkFrequencyInfo := x.downlinkFrequencyInfo(); downlinkFrequencyInfo != nil {
+		return downlinkFrequencyInfo.ValidateDownlinkBands()
+	}
+	return nil
+}
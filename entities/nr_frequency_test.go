@@ -0,0 +1,133 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+
+package entities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNrArfcnToFrequency(t *testing.T) {
+	tests := []struct {
+		nrArfcn  uint64
+		expected float64
+	}{
+		{nrArfcn: 150000, expected: 750},
+		{nrArfcn: 620000, expected: 3300},
+		{nrArfcn: 632628, expected: 3489.42},
+		{nrArfcn: 2079167, expected: 28000.08},
+	}
+	for _, tc := range tests {
+		frequency, err := NrArfcnToFrequency(tc.nrArfcn)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expected, frequency)
+	}
+	_, err := NrArfcnToFrequency(MaxNrArfcn + 1)
+	assert.NotNil(t, err)
+}
+
+func TestNrChannelBandwidth(t *testing.T) {
+	bandwidth, err := NrChannelBandwidth(Nrscs_SCS30, Ncnrb_NRB273)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(100), bandwidth)
+	bandwidth, err = NrChannelBandwidth(Nrscs_SCS120, Ncnrb_NRB66)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(100), bandwidth)
+	_, err = NrChannelBandwidth(Nrscs_SCS15, Ncnrb_NRB273)
+	assert.NotNil(t, err)
+}
+
+func TestNrFrequencyInfoValidateBands(t *testing.T) {
+	frequencyInfo := &NrFrequencyInfo{NrArFcn: 632628, FrequencyBands: []*FrequencyBandItem{{NrFrequencyBand: 77}, {NrFrequencyBand: 78}}}
+	assert.Nil(t, frequencyInfo.ValidateUplinkBands())
+	assert.Nil(t, frequencyInfo.ValidateDownlinkBands())
+	frequencyInfo.FrequencyBands = append(frequencyInfo.FrequencyBands, &FrequencyBandItem{NrFrequencyBand: 79})
+	assert.NotNil(t, frequencyInfo.ValidateDownlinkBands())
+	frequencyInfo.FrequencyBands = []*FrequencyBandItem{{NrFrequencyBand: 999}}
+	assert.NotNil(t, frequencyInfo.ValidateDownlinkBands())
+}
+
+func TestNrFrequencyInfoValidateBandsDirection(t *testing.T) {
+	// 2140 MHz is within the n1 downlink range only
+	frequencyInfo := &NrFrequencyInfo{NrArFcn: 428000, FrequencyBands: []*FrequencyBandItem{{NrFrequencyBand: 1}}}
+	assert.Nil(t, frequencyInfo.ValidateDownlinkBands())
+	assert.NotNil(t, frequencyInfo.ValidateUplinkBands())
+	// 1920 MHz is within the n1 and n84 uplink ranges, n84 being an SUL band
+	frequencyInfo = &NrFrequencyInfo{NrArFcn: 384000, FrequencyBands: []*FrequencyBandItem{{NrFrequencyBand: 1}, {NrFrequencyBand: 84}}}
+	assert.Nil(t, frequencyInfo.ValidateUplinkBands())
+	assert.NotNil(t, frequencyInfo.ValidateDownlinkBands())
+}
+
+func TestServedNRCellFdd(t *testing.T) {
+	cell := &ServedNRCell{ServedNrCellInformation: &ServedNRCellInformation{
+		CellId: "02f829:0007ab50100",
+		ChoiceNrMode: &ServedNRCellInformation_ChoiceNRMode{Fdd: &ServedNRCellInformation_ChoiceNRMode_FddInfo{
+			UlFreqInfo:              &NrFrequencyInfo{NrArFcn: 384000, FrequencyBands: []*FrequencyBandItem{{NrFrequencyBand: 1}}},
+			DlFreqInfo:              &NrFrequencyInfo{NrArFcn: 428000, FrequencyBands: []*FrequencyBandItem{{NrFrequencyBand: 1}}},
+			UlTransmissionBandwidth: &NrTransmissionBandwidth{Nrscs: Nrscs_SCS15, Ncnrb: Ncnrb_NRB106},
+			DlTransmissionBandwidth: &NrTransmissionBandwidth{Nrscs: Nrscs_SCS15, Ncnrb: Ncnrb_NRB52},
+		}},
+	}}
+	ul, err := cell.UplinkFrequencyMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(1920), ul)
+	dl, err := cell.DownlinkFrequencyMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(2140), dl)
+	ulBandwidth, err := cell.UplinkBandwidthMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(20), ulBandwidth)
+	dlBandwidth, err := cell.DownlinkBandwidthMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(10), dlBandwidth)
+	assert.Nil(t, cell.ValidateBands())
+	fddInfo := cell.GetServedNrCellInformation().GetChoiceNrMode().GetFdd()
+	fddInfo.UlFreqInfo, fddInfo.DlFreqInfo = fddInfo.DlFreqInfo, fddInfo.UlFreqInfo
+	assert.NotNil(t, cell.ValidateBands())
+}
+
+func TestServedNRCellTdd(t *testing.T) {
+	cell := &ServedNRCell{ServedNrCellInformation: &ServedNRCellInformation{
+		ChoiceNrMode: &ServedNRCellInformation_ChoiceNRMode{Tdd: &ServedNRCellInformation_ChoiceNRMode_TddInfo{
+			NrFreqInfo:            &NrFrequencyInfo{NrArFcn: 632628, FrequencyBands: []*FrequencyBandItem{{NrFrequencyBand: 1}}},
+			TransmissionBandwidth: &NrTransmissionBandwidth{Nrscs: Nrscs_SCS30, Ncnrb: Ncnrb_NRB273},
+		}},
+	}}
+	ul, err := cell.UplinkFrequencyMHz()
+	assert.Nil(t, err)
+	dl, err := cell.DownlinkFrequencyMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, ul, dl)
+	bandwidth, err := cell.DownlinkBandwidthMHz()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(100), bandwidth)
+	assert.NotNil(t, cell.ValidateBands())
+}
+
+func TestServedNRCellNoMode(t *testing.T) {
+	cell := &ServedNRCell{ServedNrCellInformation: &ServedNRCellInformation{}}
+	_, err := cell.DownlinkFrequencyMHz()
+	assert.NotNil(t, err)
+	_, err = cell.DownlinkBandwidthMHz()
+	assert.NotNil(t, err)
+	assert.Nil(t, cell.ValidateBands())
+}
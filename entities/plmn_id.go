@@ -0,0 +1,85 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package entities
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+PlmnId is a PLMN identity. rNib stores it as the hex string of its 3 BCD encoded octets (3GPP TS 38.413),
+e.g. "02f829" for MCC 208 and MNC 92.
+*/
+type PlmnId struct {
+	Mcc string
+	Mnc string
+}
+
+// NewPlmnId returns the PLMN identity of the 3 digits MCC and the 2 or 3 digits MNC
+func NewPlmnId(mcc string, mnc string) (PlmnId, error) {
+	plmnId := PlmnId{Mcc: mcc, Mnc: mnc}
+	return plmnId, plmnId.Validate()
+}
+
+// ParsePlmnId parses the hex string of a BCD encoded PLMN identity
+func ParsePlmnId(s string) (PlmnId, error) {
+	if len(s) != 6 {
+		return PlmnId{}, fmt.Errorf("#entities.ParsePlmnId - expected 6 hex digits, got %q", s)
+	}
+	s = strings.ToLower(s)
+	digits := []byte{s[1], s[0], s[3], s[5], s[4], s[2]}
+	plmnId := PlmnId{Mcc: string(digits[:3]), Mnc: string(digits[3:])}
+	if digits[5] == 'f' {
+		plmnId.Mnc = string(digits[3:5])
+	}
+	if err := plmnId.Validate(); err != nil {
+		return PlmnId{}, fmt.Errorf("#entities.ParsePlmnId - invalid PLMN identity %q: %s", s, err)
+	}
+	return plmnId, nil
+}
+
+// Validate checks that the MCC has 3 digits and the MNC 2 or 3
+func (p PlmnId) Validate() error {
+	if len(p.Mcc) != 3 || !isDecimal(p.Mcc) {
+		return fmt.Errorf("MCC %q is not 3 digits", p.Mcc)
+	}
+	if len(p.Mnc) < 2 || len(p.Mnc) > 3 || !isDecimal(p.Mnc) {
+		return fmt.Errorf("MNC %q is not 2 or 3 digits", p.Mnc)
+	}
+	return nil
+}
+
+// String returns the hex string of the BCD encoded PLMN identity, or "" when it is not valid
+func (p PlmnId) String() string {
+	if p.Validate() != nil {
+		return ""
+	}
+	mnc3 := byte('f')
+	if len(p.Mnc) == 3 {
+		mnc3 = p.Mnc[2]
+	}
+	return string([]byte{p.Mcc[1], p.Mcc[0], mnc3, p.Mcc[2], p.Mnc[1], p.Mnc[0]})
+}
+
+func isDecimal(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
+}
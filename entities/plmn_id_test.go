@@ -0,0 +1,71 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package entities
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParsePlmnId(t *testing.T) {
+	tests := []struct {
+		plmn     string
+		expected PlmnId
+	}{
+		{plmn: "02f829", expected: PlmnId{Mcc: "208", Mnc: "92"}},
+		{plmn: "13f184", expected: PlmnId{Mcc: "311", Mnc: "48"}},
+		{plmn: "130014", expected: PlmnId{Mcc: "310", Mnc: "410"}},
+		{plmn: "02F829", expected: PlmnId{Mcc: "208", Mnc: "92"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.plmn, func(t *testing.T) {
+			plmnId, err := ParsePlmnId(tc.plmn)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, plmnId)
+		})
+	}
+}
+
+func TestParsePlmnIdInvalid(t *testing.T) {
+	for _, plmn := range []string{"", "02f82", "02f8290", "0zf829", "ff0829"} {
+		_, err := ParsePlmnId(plmn)
+		assert.NotNil(t, err, plmn)
+	}
+}
+
+func TestPlmnIdString(t *testing.T) {
+	plmnId, err := NewPlmnId("208", "92")
+	assert.Nil(t, err)
+	assert.Equal(t, "02f829", plmnId.String())
+	plmnId, err = NewPlmnId("310", "410")
+	assert.Nil(t, err)
+	assert.Equal(t, "130014", plmnId.String())
+}
+
+func TestNewPlmnIdInvalid(t *testing.T) {
+	_, err := NewPlmnId("20", "92")
+	assert.NotNil(t, err)
+	_, err = NewPlmnId("208", "9")
+	assert.NotNil(t, err)
+	_, err = NewPlmnId("208", "92a")
+	assert.NotNil(t, err)
+	assert.Equal(t, "", PlmnId{}.String())
+}
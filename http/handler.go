@@ -0,0 +1,213 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package http
+
+import (
+	"encoding/json"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+)
+
+type ErrorResponse struct {
+	ErrorMessage string `json:"errorMessage"`
+}
+
+/*
+Handler serves the RNibReader operations as read-only JSON endpoints, see openapi.yaml
+*/
+type Handler struct {
+	reader reader.RNibReader
+}
+
+//NewHandler returns reference to Handler serving the given RNibReader
+func NewHandler(reader reader.RNibReader) *Handler {
+	return &Handler{reader: reader}
+}
+
+func (h *Handler) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	if r.Method != nethttp.MethodGet {
+		w.Header().Set("Allow", nethttp.MethodGet)
+		writeError(w, nethttp.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	segments, err := splitPath(r.URL.EscapedPath())
+	if err != nil {
+		writeError(w, nethttp.StatusBadRequest, err.Error())
+		return
+	}
+	switch {
+	case match(segments, "nodebs"):
+		h.getNodebs(w, r)
+	case match(segments, "nodebs", "*"):
+		nb, err := h.reader.GetNodeb(segments[1])
+		writeResult(w, nb, err)
+	case match(segments, "nodebs", "*", "cells"):
+		cells, err := h.reader.GetCellList(segments[1])
+		writeResult(w, cells, err)
+	case match(segments, "cells", "nr", "*"):
+		cell, err := h.reader.GetCellById(entities.Cell_NR_CELL, segments[2])
+		writeResult(w, cell, err)
+	case match(segments, "cells", "lte", "*"):
+		cell, err := h.reader.GetCellById(entities.Cell_LTE_CELL, segments[2])
+		writeResult(w, cell, err)
+	case match(segments, "e2t"):
+		h.getE2TInstances(w)
+	case match(segments, "e2t", "*"):
+		e2tInstance, err := h.reader.GetE2TInstance(segments[1])
+		writeResult(w, e2tInstance, err)
+	case match(segments, "load", "*"):
+		loadInfo, err := h.reader.GetRanLoadInformation(segments[1])
+		writeResult(w, loadInfo, err)
+	case match(segments, "configuration"):
+		config, err := h.reader.GetGeneralConfiguration()
+		writeResult(w, config, err)
+	case match(segments, "statistics"):
+		statistics, err := h.reader.GetNodebStatistics()
+		writeResult(w, statistics, err)
+	default:
+		writeError(w, nethttp.StatusNotFound, "resource not found")
+	}
+}
+
+func (h *Handler) getNodebs(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var ids []*entities.NbIdentity
+	var err error
+	switch strings.ToLower(r.URL.Query().Get("type")) {
+	case "":
+		ids, err = h.reader.GetListNodebIds()
+	case "gnb":
+		ids, err = h.reader.GetListGnbIds()
+	case "enb":
+		ids, err = h.reader.GetListEnbIds()
+	default:
+		err = common.NewValidationErrorf("#http.getNodebs - invalid node type: %s", r.URL.Query().Get("type"))
+	}
+	list := make([]proto.Message, 0, len(ids))
+	for _, id := range ids {
+		list = append(list, id)
+	}
+	writeResult(w, list, err)
+}
+
+func (h *Handler) getE2TInstances(w nethttp.ResponseWriter) {
+	addresses, err := h.reader.GetE2TAddresses()
+	if err != nil {
+		writeResult(w, nil, err)
+		return
+	}
+	e2tInstances := []*entities.E2TInstance{}
+	if len(addresses) > 0 {
+		e2tInstances, err = h.reader.GetE2TInstances(addresses)
+	}
+	writeResult(w, e2tInstances, err)
+}
+
+func splitPath(escapedPath string) ([]string, error) {
+	trimmed := strings.Trim(escapedPath, "/")
+	if trimmed == "" {
+		return nil, nil
+	}
+	segments := strings.Split(trimmed, "/")
+	for i, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, err
+		}
+		segments[i] = unescaped
+	}
+	return segments, nil
+}
+
+// match reports whether the path segments match the pattern, "*" matching any single non empty segment
+func match(segments []string, pattern ...string) bool {
+	if len(segments) != len(pattern) {
+		return false
+	}
+	for i, p := range pattern {
+		if p == "*" {
+			if segments[i] == "" {
+				return false
+			}
+		} else if segments[i] != p {
+			return false
+		}
+	}
+	return true
+}
+
+func writeResult(w nethttp.ResponseWriter, result interface{}, err error) {
+	if err != nil {
+		writeError(w, statusCode(err), err.Error())
+		return
+	}
+	data, err := marshal(result)
+	if err != nil {
+		writeError(w, nethttp.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(nethttp.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func writeError(w nethttp.ResponseWriter, status int, message string) {
+	data, _ := json.Marshal(ErrorResponse{ErrorMessage: message})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+func statusCode(err error) int {
+	switch common.GetErrorCode(err) {
+	case common.NotFound:
+		return nethttp.StatusNotFound
+	case common.Validation:
+		return nethttp.StatusBadRequest
+	case common.StorageUnavailable:
+		return nethttp.StatusServiceUnavailable
+	case common.Timeout:
+		return nethttp.StatusGatewayTimeout
+	}
+	return nethttp.StatusInternalServerError
+}
+
+func marshal(result interface{}) ([]byte, error) {
+	switch r := result.(type) {
+	case proto.Message:
+		return protojson.Marshal(proto.MessageV2(r))
+	case []proto.Message:
+		list := make([]json.RawMessage, 0, len(r))
+		for _, m := range r {
+			data, err := protojson.Marshal(proto.MessageV2(m))
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, data)
+		}
+		return json.Marshal(list)
+	}
+	return json.Marshal(result)
+}
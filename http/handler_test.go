@@ -0,0 +1,163 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func initHandler(t *testing.T) *Handler {
+	storage := common.NewInMemorySdlSyncStorage()
+	w := writer.GetNewRNibWriter(storage)
+	gnb := &entities.NodebInfo{
+		RanName:          "gnb_1",
+		NodeType:         entities.Node_GNB,
+		ConnectionStatus: entities.ConnectionStatus_CONNECTED,
+		GlobalNbId:       &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"},
+		Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{ServedNrCells: []*entities.ServedNRCell{
+			{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: "nrcell1", NrPci: 5}},
+		}}},
+	}
+	assert.Nil(t, w.SaveNodeb(gnb))
+	assert.Nil(t, w.AddNbIdentity(entities.Node_GNB, &entities.NbIdentity{InventoryName: "gnb_1", GlobalNbId: gnb.GetGlobalNbId()}))
+	assert.Nil(t, w.SaveE2TInstance(entities.NewE2TInstance("10.0.2.15:3213", "e2term")))
+	assert.Nil(t, w.SaveE2TAddresses([]string{"10.0.2.15:3213"}))
+	assert.Nil(t, w.SaveRanLoadInformation("gnb_1", &entities.RanLoadInformation{LoadTimestamp: 5}))
+	assert.Nil(t, w.SaveGeneralConfiguration(&entities.GeneralConfiguration{EnableRic: true}))
+	return NewHandler(reader.GetNewRNibReader(storage))
+}
+
+func serve(h nethttp.Handler, method string, target string) (*httptest.ResponseRecorder, map[string]interface{}) {
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, httptest.NewRequest(method, target, nil))
+	var body map[string]interface{}
+	_ = json.Unmarshal(recorder.Body.Bytes(), &body)
+	return recorder, body
+}
+
+func TestGetNodeb(t *testing.T) {
+	h := initHandler(t)
+	recorder, body := serve(h, nethttp.MethodGet, "/nodebs/gnb_1")
+	assert.Equal(t, nethttp.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "gnb_1", body["ranName"])
+}
+
+func TestGetNodebNotFound(t *testing.T) {
+	h := initHandler(t)
+	recorder, body := serve(h, nethttp.MethodGet, "/nodebs/missing")
+	assert.Equal(t, nethttp.StatusNotFound, recorder.Code)
+	assert.Contains(t, body["errorMessage"], "not found")
+}
+
+func TestGetNodebs(t *testing.T) {
+	h := initHandler(t)
+	for _, target := range []string{"/nodebs", "/nodebs/", "/nodebs?type=gnb"} {
+		recorder := httptest.NewRecorder()
+		h.ServeHTTP(recorder, httptest.NewRequest(nethttp.MethodGet, target, nil))
+		assert.Equal(t, nethttp.StatusOK, recorder.Code)
+		var ids []map[string]interface{}
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &ids))
+		assert.Len(t, ids, 1)
+		assert.Equal(t, "gnb_1", ids[0]["inventoryName"])
+	}
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, httptest.NewRequest(nethttp.MethodGet, "/nodebs?type=enb", nil))
+	assert.Equal(t, "[]", recorder.Body.String())
+}
+
+func TestGetNodebsInvalidType(t *testing.T) {
+	h := initHandler(t)
+	recorder, _ := serve(h, nethttp.MethodGet, "/nodebs?type=xnb")
+	assert.Equal(t, nethttp.StatusBadRequest, recorder.Code)
+}
+
+func TestGetCells(t *testing.T) {
+	h := initHandler(t)
+	recorder, body := serve(h, nethttp.MethodGet, "/nodebs/gnb_1/cells")
+	assert.Equal(t, nethttp.StatusOK, recorder.Code)
+	assert.Equal(t, "NR_CELL", body["type"])
+	recorder, body = serve(h, nethttp.MethodGet, "/cells/nr/nrcell1")
+	assert.Equal(t, nethttp.StatusOK, recorder.Code)
+	assert.Equal(t, "NR_CELL", body["type"])
+	recorder, _ = serve(h, nethttp.MethodGet, "/cells/lte/nrcell1")
+	assert.Equal(t, nethttp.StatusNotFound, recorder.Code)
+}
+
+func TestGetE2TInstances(t *testing.T) {
+	h := initHandler(t)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, httptest.NewRequest(nethttp.MethodGet, "/e2t", nil))
+	assert.Equal(t, nethttp.StatusOK, recorder.Code)
+	var instances []entities.E2TInstance
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &instances))
+	assert.Len(t, instances, 1)
+	recorder, body := serve(h, nethttp.MethodGet, "/e2t/10.0.2.15:3213")
+	assert.Equal(t, nethttp.StatusOK, recorder.Code)
+	assert.Equal(t, "e2term", body["podName"])
+}
+
+func TestGetLoadAndConfiguration(t *testing.T) {
+	h := initHandler(t)
+	recorder, body := serve(h, nethttp.MethodGet, "/load/gnb_1")
+	assert.Equal(t, nethttp.StatusOK, recorder.Code)
+	assert.Equal(t, "5", body["loadTimestamp"])
+	recorder, body = serve(h, nethttp.MethodGet, "/configuration")
+	assert.Equal(t, nethttp.StatusOK, recorder.Code)
+	assert.Equal(t, true, body["enableRic"])
+}
+
+func TestGetStatistics(t *testing.T) {
+	h := initHandler(t)
+	recorder, body := serve(h, nethttp.MethodGet, "/statistics")
+	assert.Equal(t, nethttp.StatusOK, recorder.Code)
+	assert.Equal(t, float64(1), body["total"])
+	assert.Equal(t, map[string]interface{}{"GNB": float64(1)}, body["byNodeType"])
+	assert.Equal(t, float64(1), body["nrCells"])
+}
+
+func TestUnknownPathAndMethod(t *testing.T) {
+	h := initHandler(t)
+	recorder, _ := serve(h, nethttp.MethodGet, "/ran/gnb_1")
+	assert.Equal(t, nethttp.StatusNotFound, recorder.Code)
+	recorder, _ = serve(h, nethttp.MethodDelete, "/nodebs/gnb_1")
+	assert.Equal(t, nethttp.StatusMethodNotAllowed, recorder.Code)
+	assert.Equal(t, nethttp.MethodGet, recorder.Header().Get("Allow"))
+}
+
+func TestStorageUnavailable(t *testing.T) {
+	sdlStorageMock := new(reader.MockSdlSyncStorage)
+	var ret map[string]interface{}
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), mock.Anything).Return(ret, errors.New("expected error"))
+	h := NewHandler(reader.GetNewRNibReader(sdlStorageMock))
+	recorder, body := serve(h, nethttp.MethodGet, "/nodebs/gnb_1")
+	assert.Equal(t, nethttp.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, "expected error", body["errorMessage"])
+}
@@ -0,0 +1,256 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/golang/protobuf/proto"
+	"sync"
+	"time"
+)
+
+/*
+CacheConfig configures the cached reader. A zero TTL keeps entries until they are evicted or invalidated,
+a zero MaxSize does not bound the number of cached entries.
+*/
+type CacheConfig struct {
+	TTL     time.Duration
+	MaxSize int
+}
+
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Size      int
+}
+
+/*
+CachedRNibReader is a RNibReader keeping the nodeb and cell entities it has read in memory
+*/
+type CachedRNibReader interface {
+	RNibReader
+	// Stats returns the cache counters
+	Stats() CacheStats
+	// Invalidate drops the cached entities of the responding nodeb
+	Invalidate(inventoryName string)
+	// InvalidateAll drops all cached entities
+	InvalidateAll()
+	// InvalidateOnEvents drops the cached entities of every nodeb reported on the RAN channels until the context is done
+	InvalidateOnEvents(ctx context.Context, storage common.ISdlSyncStorage) error
+}
+
+type cacheEntry struct {
+	key           string
+	inventoryName string
+	value         proto.Message
+	expiresAt     time.Time
+}
+
+/*
+The generation counts the invalidations: the entities fetched on a miss are stored only if neither their nodeb
+nor the whole cache has been invalidated since the miss, so that a fetch racing an invalidation cannot cache stale data.
+The invalidated generations only matter to the fetches in flight and are dropped once there are none.
+*/
+type cachedRNibReaderInstance struct {
+	RNibReader
+	config         CacheConfig
+	mutex          sync.Mutex
+	lru            *list.List
+	entries        map[string]*list.Element
+	byName         map[string]map[string]struct{}
+	generation     uint64
+	invalidated    map[string]uint64
+	invalidatedAll uint64
+	fetching       int
+	stats          CacheStats
+	now            func() time.Time
+}
+
+//GetNewCachedRNibReader returns reference to CachedRNibReader reading through the given RNibReader
+func GetNewCachedRNibReader(reader RNibReader, config CacheConfig) CachedRNibReader {
+	return &cachedRNibReaderInstance{
+		RNibReader:  reader,
+		config:      config,
+		lru:         list.New(),
+		entries:     map[string]*list.Element{},
+		byName:      map[string]map[string]struct{}{},
+		invalidated: map[string]uint64{},
+		now:         time.Now,
+	}
+}
+
+func (c *cachedRNibReaderInstance) GetNodeb(inventoryName string) (*entities.NodebInfo, error) {
+	key := fmt.Sprintf("RAN:%s", inventoryName)
+	value, generation, ok := c.load(key)
+	if ok {
+		return value.(*entities.NodebInfo), nil
+	}
+	defer c.fetched()
+	nb, err := c.RNibReader.GetNodeb(inventoryName)
+	if err != nil {
+		return nil, err
+	}
+	c.store(key, inventoryName, nb, generation)
+	return nb, nil
+}
+
+func (c *cachedRNibReaderInstance) GetCellList(inventoryName string) (*entities.Cells, error) {
+	key := fmt.Sprintf("CELLS:%s", inventoryName)
+	value, generation, ok := c.load(key)
+	if ok {
+		return value.(*entities.Cells), nil
+	}
+	defer c.fetched()
+	cells, err := c.RNibReader.GetCellList(inventoryName)
+	if err != nil {
+		return nil, err
+	}
+	c.store(key, inventoryName, cells, generation)
+	return cells, nil
+}
+
+func (c *cachedRNibReaderInstance) GetCell(inventoryName string, pci uint32) (*entities.Cell, error) {
+	key := fmt.Sprintf("PCI:%s:%02x", inventoryName, pci)
+	value, generation, ok := c.load(key)
+	if ok {
+		return value.(*entities.Cell), nil
+	}
+	defer c.fetched()
+	cell, err := c.RNibReader.GetCell(inventoryName, pci)
+	if err != nil {
+		return nil, err
+	}
+	c.store(key, inventoryName, cell, generation)
+	return cell, nil
+}
+
+func (c *cachedRNibReaderInstance) Stats() CacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	stats := c.stats
+	stats.Size = c.lru.Len()
+	return stats
+}
+
+func (c *cachedRNibReaderInstance) Invalidate(inventoryName string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	if c.fetching > 0 {
+		c.invalidated[inventoryName] = c.generation
+	}
+	for key := range c.byName[inventoryName] {
+		c.remove(c.entries[key])
+	}
+}
+
+func (c *cachedRNibReaderInstance) InvalidateAll() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	c.invalidatedAll = c.generation
+	c.invalidated = map[string]uint64{}
+	c.lru.Init()
+	c.entries = map[string]*list.Element{}
+	c.byName = map[string]map[string]struct{}{}
+}
+
+func (c *cachedRNibReaderInstance) InvalidateOnEvents(ctx context.Context, storage common.ISdlSyncStorage) error {
+	events, err := Watch(ctx, storage, WatchOptions{})
+	if err != nil {
+		return err
+	}
+	go func() {
+		for event := range events {
+			c.Invalidate(event.InventoryName)
+		}
+	}()
+	return nil
+}
+
+// load returns a copy of the cached entity, so that callers cannot modify the cache content, or the generation of a miss
+// whose fetch must be ended with fetched
+func (c *cachedRNibReaderInstance) load(key string) (proto.Message, uint64, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		c.fetching++
+		return nil, c.generation, false
+	}
+	entry := element.Value.(*cacheEntry)
+	if !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		c.stats.Misses++
+		c.fetching++
+		return nil, c.generation, false
+	}
+	c.lru.MoveToFront(element)
+	c.stats.Hits++
+	return proto.Clone(entry.value), c.generation, true
+}
+
+func (c *cachedRNibReaderInstance) fetched() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.fetching--
+	if c.fetching == 0 && len(c.invalidated) > 0 {
+		c.invalidated = map[string]uint64{}
+	}
+}
+
+func (c *cachedRNibReaderInstance) store(key string, inventoryName string, value proto.Message, generation uint64) {
+	entry := &cacheEntry{key: key, inventoryName: inventoryName, value: proto.Clone(value)}
+	if c.config.TTL > 0 {
+		entry.expiresAt = c.now().Add(c.config.TTL)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.invalidatedAll > generation || c.invalidated[inventoryName] > generation {
+		return
+	}
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	if c.byName[inventoryName] == nil {
+		c.byName[inventoryName] = map[string]struct{}{}
+	}
+	c.byName[inventoryName][key] = struct{}{}
+	for c.config.MaxSize > 0 && c.lru.Len() > c.config.MaxSize {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+func (c *cachedRNibReaderInstance) remove(element *list.Element) {
+	entry := c.lru.Remove(element).(*cacheEntry)
+	delete(c.entries, entry.key)
+	delete(c.byName[entry.inventoryName], entry.key)
+	if len(c.byName[entry.inventoryName]) == 0 {
+		delete(c.byName, entry.inventoryName)
+	}
+}
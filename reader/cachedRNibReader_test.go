@@ -0,0 +1,203 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"context"
+	"fmt"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func initCachedRNibReader(config CacheConfig) (*cachedRNibReaderInstance, *MockSdlSyncStorage) {
+	sdlStorageMock := new(MockSdlSyncStorage)
+	c := GetNewCachedRNibReader(GetNewRNibReader(sdlStorageMock), config)
+	return c.(*cachedRNibReaderInstance), sdlStorageMock
+}
+
+func mockNodebGet(t *testing.T, sdlStorageMock *MockSdlSyncStorage, name string) {
+	nb := entities.NodebInfo{RanName: name, Ip: "localhost"}
+	cell := &entities.ServedNRCell{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: "cell", NrPci: 1}}
+	nb.Configuration = &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{ServedNrCells: []*entities.ServedNRCell{cell}}}
+	data, err := proto.Marshal(&nb)
+	if err != nil {
+		t.Errorf("#cachedRNibReader_test.mockNodebGet - Failed to marshal NodebInfo entity. Error: %v", err)
+	}
+	key := "RAN:" + name
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{key}).Return(map[string]interface{}{key: string(data)}, nil)
+}
+
+func TestCachedGetNodebHit(t *testing.T) {
+	c, sdlStorageMock := initCachedRNibReader(CacheConfig{})
+	mockNodebGet(t, sdlStorageMock, "name")
+	nb, err := c.GetNodeb("name")
+	assert.Nil(t, err)
+	nb.Ip = "modified"
+	nb, err = c.GetNodeb("name")
+	assert.Nil(t, err)
+	assert.Equal(t, "localhost", nb.Ip)
+	sdlStorageMock.AssertNumberOfCalls(t, "Get", 1)
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1, Size: 1}, c.Stats())
+}
+
+func TestCachedGetNodebNotFoundNotCached(t *testing.T) {
+	c, sdlStorageMock := initCachedRNibReader(CacheConfig{})
+	var ret map[string]interface{}
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"RAN:name"}).Return(ret, nil)
+	_, err := c.GetNodeb("name")
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+	_, err = c.GetNodeb("name")
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+	sdlStorageMock.AssertNumberOfCalls(t, "Get", 2)
+	assert.Equal(t, 0, c.Stats().Size)
+}
+
+func TestCachedGetNodebExpired(t *testing.T) {
+	c, sdlStorageMock := initCachedRNibReader(CacheConfig{TTL: time.Minute})
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	mockNodebGet(t, sdlStorageMock, "name")
+	_, _ = c.GetNodeb("name")
+	_, _ = c.GetNodeb("name")
+	now = now.Add(time.Minute)
+	_, _ = c.GetNodeb("name")
+	sdlStorageMock.AssertNumberOfCalls(t, "Get", 2)
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 2, Size: 1}, c.Stats())
+}
+
+func TestCachedGetNodebEvictsLeastRecentlyUsed(t *testing.T) {
+	c, sdlStorageMock := initCachedRNibReader(CacheConfig{MaxSize: 2})
+	mockNodebGet(t, sdlStorageMock, "name1")
+	mockNodebGet(t, sdlStorageMock, "name2")
+	mockNodebGet(t, sdlStorageMock, "name3")
+	_, _ = c.GetNodeb("name1")
+	_, _ = c.GetNodeb("name2")
+	_, _ = c.GetNodeb("name1")
+	_, _ = c.GetNodeb("name3")
+	_, _ = c.GetNodeb("name1")
+	_, _ = c.GetNodeb("name2")
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 4, Evictions: 2, Size: 2}, c.Stats())
+}
+
+func TestCachedGetCellAndCellList(t *testing.T) {
+	c, sdlStorageMock := initCachedRNibReader(CacheConfig{})
+	mockNodebGet(t, sdlStorageMock, "name")
+	cellData, err := proto.Marshal(&entities.Cell{Type: entities.Cell_NR_CELL})
+	if err != nil {
+		t.Errorf("#cachedRNibReader_test.TestCachedGetCellAndCellList - Failed to marshal Cell entity. Error: %v", err)
+	}
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"PCI:name:01"}).Return(map[string]interface{}{"PCI:name:01": string(cellData)}, nil)
+	for i := 0; i < 2; i++ {
+		cells, err := c.GetCellList("name")
+		assert.Nil(t, err)
+		assert.Equal(t, entities.Cell_NR_CELL, cells.GetType())
+		cell, err := c.GetCell("name", 1)
+		assert.Nil(t, err)
+		assert.Equal(t, entities.Cell_NR_CELL, cell.GetType())
+	}
+	sdlStorageMock.AssertNumberOfCalls(t, "Get", 2)
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 2, Size: 2}, c.Stats())
+}
+
+func TestCachedInvalidate(t *testing.T) {
+	c, sdlStorageMock := initCachedRNibReader(CacheConfig{})
+	mockNodebGet(t, sdlStorageMock, "name1")
+	mockNodebGet(t, sdlStorageMock, "name2")
+	_, _ = c.GetNodeb("name1")
+	_, _ = c.GetCellList("name1")
+	_, _ = c.GetNodeb("name2")
+	c.Invalidate("name1")
+	assert.Equal(t, 1, c.Stats().Size)
+	c.InvalidateAll()
+	assert.Equal(t, 0, c.Stats().Size)
+}
+
+func TestCachedInvalidateDuringFetchNotStored(t *testing.T) {
+	c, sdlStorageMock := initCachedRNibReader(CacheConfig{})
+	nb := entities.NodebInfo{RanName: "name", Ip: "localhost"}
+	data, err := proto.Marshal(&nb)
+	assert.Nil(t, err)
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"RAN:name"}).Run(func(args mock.Arguments) {
+		c.Invalidate("name")
+	}).Return(map[string]interface{}{"RAN:name": string(data)}, nil).Once()
+	_, err = c.GetNodeb("name")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, c.Stats().Size)
+
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"RAN:name"}).Run(func(args mock.Arguments) {
+		c.InvalidateAll()
+	}).Return(map[string]interface{}{"RAN:name": string(data)}, nil).Once()
+	_, err = c.GetNodeb("name")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, c.Stats().Size)
+
+	mockNodebGet(t, sdlStorageMock, "name")
+	_, _ = c.GetNodeb("name")
+	assert.Equal(t, 1, c.Stats().Size)
+}
+
+func TestCachedInvalidatedGenerationsDroppedAfterFetches(t *testing.T) {
+	c, sdlStorageMock := initCachedRNibReader(CacheConfig{})
+	mockNodebGet(t, sdlStorageMock, "name1")
+	var ret map[string]interface{}
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"RAN:name2"}).Run(func(args mock.Arguments) {
+		c.Invalidate("name1")
+		assert.Len(t, c.invalidated, 1)
+	}).Return(ret, nil)
+	_, err := c.GetNodeb("name2")
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+	assert.Empty(t, c.invalidated)
+
+	for i := 0; i < 3; i++ {
+		c.Invalidate(fmt.Sprintf("name%d", i))
+	}
+	assert.Empty(t, c.invalidated)
+	_, err = c.GetNodeb("name1")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, c.fetching)
+	assert.Equal(t, 1, c.Stats().Size)
+}
+
+func TestCachedInvalidateOnEvents(t *testing.T) {
+	c, sdlStorageMock := initCachedRNibReader(CacheConfig{})
+	mockNodebGet(t, sdlStorageMock, "name")
+	callbacks := make(chan func(string, ...string), 1)
+	sdlStorageMock.On("SubscribeChannel", common.GetRNibNamespace(), mock.Anything, defaultWatchChannels).Run(func(args mock.Arguments) {
+		callbacks <- args.Get(1).(func(string, ...string))
+	}).Return(nil)
+	sdlStorageMock.On("UnsubscribeChannel", common.GetRNibNamespace(), defaultWatchChannels).Return(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err := c.InvalidateOnEvents(ctx, sdlStorageMock)
+	assert.Nil(t, err)
+	_, _ = c.GetNodeb("name")
+	cb := <-callbacks
+	cb(common.StateChangeMessageChannel, "name_DISCONNECTED")
+	deadline := time.Now().Add(time.Second)
+	for c.Stats().Size != 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, 0, c.Stats().Size)
+}
@@ -0,0 +1,144 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package reader
+
+import (
+	"context"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/golang/protobuf/proto"
+	"sort"
+	"strings"
+)
+
+/*
+getCellByCgi returns the cell saved under its global identity: an NR CGI for NR cells, an ECGI for LTE cells.
+The CGI is accepted with its PLMN and cell identities either separated by ':' or concatenated.
+*/
+func (w *rNibReaderInstance) getCellByCgi(ctx context.Context, cellType entities.Cell_Type, cgi string) (*entities.Cell, error) {
+	var key string
+	var rNibErr error
+	switch cellType {
+	case entities.Cell_LTE_CELL:
+		ecgi, err := entities.ParseECGI(cgi)
+		if err != nil {
+			return nil, common.NewValidationErrorf("#rNibReader.GetCellByCgi - %s", err)
+		}
+		key, rNibErr = common.ValidateAndBuildEcgiKey(ecgi.String())
+	case entities.Cell_NR_CELL:
+		nrCgi, err := entities.ParseNRCGI(cgi)
+		if err != nil {
+			return nil, common.NewValidationErrorf("#rNibReader.GetCellByCgi - %s", err)
+		}
+		key, rNibErr = common.ValidateAndBuildNrCgiKey(nrCgi.String())
+	default:
+		return nil, common.NewValidationErrorf("#rNibReader.GetCellByCgi - invalid cell type: %v", cellType)
+	}
+	if rNibErr != nil {
+		return nil, rNibErr
+	}
+	cell := &entities.Cell{}
+	err := w.getByKeyAndUnmarshal(ctx, key, cell)
+	if err != nil {
+		return nil, err
+	}
+	return cell, nil
+}
+
+// getCellsByPlmn returns the cells serving the PLMN, sorted by cell key
+func (w *rNibReaderInstance) getCellsByPlmn(ctx context.Context, plmnId string) ([]*entities.Cell, error) {
+	group, rNibErr := common.ValidateAndBuildPlmnCellsKey(plmnId)
+	if rNibErr != nil {
+		return nil, rNibErr
+	}
+	return w.getIndexedCells(ctx, group, func(cell *entities.Cell) bool {
+		return containsFold(cellPlmns(cell), plmnId)
+	})
+}
+
+// getCellsByTac returns the cells of the tracking area, sorted by cell key
+func (w *rNibReaderInstance) getCellsByTac(ctx context.Context, tac string) ([]*entities.Cell, error) {
+	group, rNibErr := common.ValidateAndBuildTacCellsKey(tac)
+	if rNibErr != nil {
+		return nil, rNibErr
+	}
+	return w.getIndexedCells(ctx, group, func(cell *entities.Cell) bool {
+		return containsFold(cellTacs(cell), tac)
+	})
+}
+
+/*
+getIndexedCells reads the cells whose keys are members of the index group.
+Cells removed or updated since they were indexed no longer match and are skipped.
+*/
+func (w *rNibReaderInstance) getIndexedCells(ctx context.Context, group string, matches func(*entities.Cell) bool) ([]*entities.Cell, error) {
+	keys, err := w.getMembers(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	cells := []*entities.Cell{}
+	if len(keys) == 0 {
+		return cells, nil
+	}
+	sort.Strings(keys)
+	data, err := w.get(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if data[key] == nil {
+			continue
+		}
+		cell := &entities.Cell{}
+		err = proto.Unmarshal([]byte(data[key].(string)), cell)
+		if err != nil {
+			return nil, common.NewCorruptDataError("*entities.Cell", key, err)
+		}
+		if matches(cell) {
+			cells = append(cells, cell)
+		}
+	}
+	return cells, nil
+}
+
+func cellPlmns(cell *entities.Cell) []string {
+	if cell.GetServedNrCell() != nil {
+		return cell.GetServedNrCell().GetServedNrCellInformation().GetServedPlmns()
+	}
+	return cell.GetServedCellInfo().GetBroadcastPlmns()
+}
+
+func cellTacs(cell *entities.Cell) []string {
+	if cell.GetServedNrCell() != nil {
+		cellInfo := cell.GetServedNrCell().GetServedNrCellInformation()
+		return []string{cellInfo.GetConfiguredStac(), cellInfo.GetStac5G()}
+	}
+	return []string{cell.GetServedCellInfo().GetTac()}
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package reader
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func initCellIndexes(t *testing.T) (RNibReader, common.ISdlSyncStorage) {
+	storage := common.NewInMemorySdlSyncStorage()
+	ns := common.GetRNibNamespace()
+	nrCell := &entities.Cell{Type: entities.Cell_NR_CELL, Cell: &entities.Cell_ServedNrCell{ServedNrCell: &entities.ServedNRCell{
+		ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: "02f829:4a952a0a50", ServedPlmns: []string{"02f829"}, Stac5G: "000001"},
+	}}}
+	lteCell := &entities.Cell{Type: entities.Cell_LTE_CELL, Cell: &entities.Cell_ServedCellInfo{ServedCellInfo: &entities.ServedCellInfo{
+		CellId: "02f829:007a81f0", BroadcastPlmns: []string{"13f184"}, Tac: "0001",
+	}}}
+	nrData, err := proto.Marshal(nrCell)
+	assert.Nil(t, err)
+	lteData, err := proto.Marshal(lteCell)
+	assert.Nil(t, err)
+	assert.Nil(t, storage.Set(ns, "NRCELL:02f829:4a952a0a50", nrData, "NRCGI:02f829:4a952a0a50", nrData, "CELL:02f829:007a81f0", lteData))
+	assert.Nil(t, storage.AddMember(ns, "PLMN_CELLS:02f829", "NRCELL:02f829:4a952a0a50", "CELL:02f829:007a81f0", "CELL:removed"))
+	assert.Nil(t, storage.AddMember(ns, "TAC_CELLS:0001", "CELL:02f829:007a81f0"))
+	return GetNewRNibReader(storage), storage
+}
+
+func TestGetCellByCgi(t *testing.T) {
+	r, _ := initCellIndexes(t)
+	cell, err := r.GetCellByCgi(entities.Cell_NR_CELL, "02F8294A952A0A50")
+	assert.Nil(t, err)
+	assert.Equal(t, "02f829:4a952a0a50", cell.GetServedNrCell().GetServedNrCellInformation().GetCellId())
+	_, err = r.GetCellByCgi(entities.Cell_LTE_CELL, "02f829:007a81f0")
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+}
+
+func TestGetCellByCgiValidationFailure(t *testing.T) {
+	r, _ := initCellIndexes(t)
+	_, err := r.GetCellByCgi(entities.Cell_NR_CELL, "02f829:007a81f0")
+	assert.IsType(t, &common.ValidationError{}, err)
+	_, err = r.GetCellByCgi(entities.Cell_LTE_CELL, "")
+	assert.IsType(t, &common.ValidationError{}, err)
+	_, err = r.GetCellByCgi(entities.Cell_UNKNOWN_CELL, "02f829:4a952a0a50")
+	assert.IsType(t, &common.ValidationError{}, err)
+}
+
+func TestGetCellsByPlmn(t *testing.T) {
+	r, storage := initCellIndexes(t)
+	cells, err := r.GetCellsByPlmn("02F829")
+	assert.Nil(t, err)
+	assert.Len(t, cells, 1)
+	assert.Equal(t, entities.Cell_NR_CELL, cells[0].GetType())
+	cells, err = r.GetCellsByPlmn("208f93")
+	assert.Nil(t, err)
+	assert.Empty(t, cells)
+	_, err = r.GetCellsByPlmn("")
+	assert.IsType(t, &common.ValidationError{}, err)
+
+	assert.Nil(t, storage.Set(common.GetRNibNamespace(), "CELL:removed", []byte{0xff}))
+	_, err = r.GetCellsByPlmn("02f829")
+	assert.IsType(t, &common.InternalError{}, err)
+	assert.Equal(t, common.CorruptData, common.GetErrorCode(err))
+}
+
+func TestGetCellsByTac(t *testing.T) {
+	r, _ := initCellIndexes(t)
+	cells, err := r.GetCellsByTac("0001")
+	assert.Nil(t, err)
+	assert.Len(t, cells, 1)
+	assert.Equal(t, "02f829:007a81f0", cells[0].GetServedCellInfo().GetCellId())
+	cells, err = r.GetCellsByTac("000001")
+	assert.Nil(t, err)
+	assert.Empty(t, cells)
+}
@@ -0,0 +1,247 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package reader
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"math"
+	"sort"
+)
+
+// EarthRadiusMeters is the mean earth radius used for the great circle distances
+const EarthRadiusMeters = 6371008.8
+
+const maxDistanceMeters = math.Pi * EarthRadiusMeters
+
+/*
+LocatedCell is a served cell with the location and antenna orientation of its AdditionalCellInformation.
+Azimuth is in degrees clockwise from north.
+*/
+type LocatedCell struct {
+	InventoryName string
+	Cell          *entities.Cell
+	Latitude      float64
+	Longitude     float64
+	Azimuth       float64
+	SectorId      uint32
+}
+
+// CellDistance is a located cell matching a query, with its great circle distance and initial bearing towards the queried location
+type CellDistance struct {
+	*LocatedCell
+	DistanceMeters float64
+	Bearing        float64
+}
+
+/*
+CellSpatialIndex answers location queries on the served cells holding an AdditionalCellInformation.
+The cells are sorted by latitude, so queries only scan the latitude band they can match.
+The index is not updated after it is built.
+*/
+type CellSpatialIndex struct {
+	cells []*LocatedCell
+}
+
+// BuildCellSpatialIndex loads every nodeb through the reader and indexes their located cells
+func BuildCellSpatialIndex(r RNibReader) (*CellSpatialIndex, error) {
+	nodebs, err := r.FindNodebs(NodebFilter{})
+	if err != nil {
+		return nil, err
+	}
+	return NewCellSpatialIndex(nodebs), nil
+}
+
+// NewCellSpatialIndex indexes the located cells of the nodebs
+func NewCellSpatialIndex(nodebs []*entities.NodebInfo) *CellSpatialIndex {
+	index := &CellSpatialIndex{}
+	for _, nodeb := range nodebs {
+		for _, servedCell := range nodeb.GetEnb().GetServedCells() {
+			index.add(nodeb.GetRanName(), servedCell.GetAdditionalCellInformation(),
+				&entities.Cell{Type: entities.Cell_LTE_CELL, Cell: &entities.Cell_ServedCellInfo{ServedCellInfo: servedCell}})
+		}
+		for _, servedNrCell := range nodeb.GetGnb().GetServedNrCells() {
+			index.add(nodeb.GetRanName(), servedNrCell.GetServedNrCellInformation().GetAdditionalCellInformation(),
+				&entities.Cell{Type: entities.Cell_NR_CELL, Cell: &entities.Cell_ServedNrCell{ServedNrCell: servedNrCell}})
+		}
+	}
+	sort.SliceStable(index.cells, func(i, j int) bool { return index.cells[i].Latitude < index.cells[j].Latitude })
+	return index
+}
+
+func (index *CellSpatialIndex) add(inventoryName string, info *entities.AdditionalCellInformation, cell *entities.Cell) {
+	if info == nil {
+		return
+	}
+	index.cells = append(index.cells, &LocatedCell{
+		InventoryName: inventoryName,
+		Cell:          cell,
+		Latitude:      float64(info.GetCellLatitude()),
+		Longitude:     float64(info.GetCellLongitude()),
+		Azimuth:       float64(info.GetAntennaAzimuthDirection()),
+		SectorId:      info.GetSectorId(),
+	})
+}
+
+// Len returns the number of located cells
+func (index *CellSpatialIndex) Len() int {
+	return len(index.cells)
+}
+
+// FindCellsWithinRadius returns the cells within meters of the location, nearest first
+func (index *CellSpatialIndex) FindCellsWithinRadius(latitude float64, longitude float64, meters float64) ([]CellDistance, error) {
+	if err := validateLocation(latitude, longitude); err != nil {
+		return nil, err
+	}
+	if meters < 0 {
+		return nil, common.NewValidationErrorf("#CellSpatialIndex.FindCellsWithinRadius - negative radius: %g", meters)
+	}
+	return index.withinRadius(latitude, longitude, meters), nil
+}
+
+// FindNearestCells returns the k cells nearest to the location, nearest first
+func (index *CellSpatialIndex) FindNearestCells(latitude float64, longitude float64, k int) ([]CellDistance, error) {
+	if err := validateLocation(latitude, longitude); err != nil {
+		return nil, err
+	}
+	if k <= 0 {
+		return nil, common.NewValidationErrorf("#CellSpatialIndex.FindNearestCells - k must be positive: %d", k)
+	}
+	if k > len(index.cells) {
+		k = len(index.cells)
+	}
+	// the k nearest cells are within any radius holding at least k cells
+	for meters := 1000.0; ; meters *= 2 {
+		if meters > maxDistanceMeters {
+			meters = maxDistanceMeters
+		}
+		matches := index.withinRadius(latitude, longitude, meters)
+		if len(matches) >= k || meters == maxDistanceMeters {
+			return matches[:k], nil
+		}
+	}
+}
+
+/*
+FindCellsInBoundingBox returns the cells inside the box, sorted by latitude.
+A box whose minLongitude is greater than its maxLongitude crosses the antimeridian.
+*/
+func (index *CellSpatialIndex) FindCellsInBoundingBox(minLatitude float64, minLongitude float64, maxLatitude float64, maxLongitude float64) ([]*LocatedCell, error) {
+	if err := validateLocation(minLatitude, minLongitude); err != nil {
+		return nil, err
+	}
+	if err := validateLocation(maxLatitude, maxLongitude); err != nil {
+		return nil, err
+	}
+	if minLatitude > maxLatitude {
+		return nil, common.NewValidationErrorf("#CellSpatialIndex.FindCellsInBoundingBox - min latitude %g is above max latitude %g", minLatitude, maxLatitude)
+	}
+	cells := []*LocatedCell{}
+	for _, cell := range index.latitudeBand(minLatitude, maxLatitude) {
+		if minLongitude <= maxLongitude && (cell.Longitude < minLongitude || cell.Longitude > maxLongitude) {
+			continue
+		}
+		if minLongitude > maxLongitude && cell.Longitude < minLongitude && cell.Longitude > maxLongitude {
+			continue
+		}
+		cells = append(cells, cell)
+	}
+	return cells, nil
+}
+
+/*
+FindSectorsPointingAt returns the cells within meters of the location whose antenna azimuth is at most
+half the beamwidth away from the bearing of the location, nearest first. Bearing is the bearing from the cell to the location.
+*/
+func (index *CellSpatialIndex) FindSectorsPointingAt(latitude float64, longitude float64, meters float64, beamwidth float64) ([]CellDistance, error) {
+	cells, err := index.FindCellsWithinRadius(latitude, longitude, meters)
+	if err != nil {
+		return nil, err
+	}
+	if beamwidth < 0 || beamwidth > 360 {
+		return nil, common.NewValidationErrorf("#CellSpatialIndex.FindSectorsPointingAt - beamwidth %g is not within [0, 360]", beamwidth)
+	}
+	sectors := []CellDistance{}
+	for _, cell := range cells {
+		if cell.DistanceMeters == 0 || angleDifference(cell.Azimuth, cell.Bearing) <= beamwidth/2 {
+			sectors = append(sectors, cell)
+		}
+	}
+	return sectors, nil
+}
+
+func (index *CellSpatialIndex) withinRadius(latitude float64, longitude float64, meters float64) []CellDistance {
+	latitudeDelta := meters / EarthRadiusMeters * 180 / math.Pi
+	matches := []CellDistance{}
+	for _, cell := range index.latitudeBand(latitude-latitudeDelta, latitude+latitudeDelta) {
+		distance := Distance(cell.Latitude, cell.Longitude, latitude, longitude)
+		if distance <= meters {
+			matches = append(matches, CellDistance{LocatedCell: cell, DistanceMeters: distance, Bearing: Bearing(cell.Latitude, cell.Longitude, latitude, longitude)})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].DistanceMeters < matches[j].DistanceMeters })
+	return matches
+}
+
+func (index *CellSpatialIndex) latitudeBand(minLatitude float64, maxLatitude float64) []*LocatedCell {
+	first := sort.Search(len(index.cells), func(i int) bool { return index.cells[i].Latitude >= minLatitude })
+	last := sort.Search(len(index.cells), func(i int) bool { return index.cells[i].Latitude > maxLatitude })
+	return index.cells[first:last]
+}
+
+// Distance returns the great circle distance in meters between two locations
+func Distance(latitude1 float64, longitude1 float64, latitude2 float64, longitude2 float64) float64 {
+	phi1, phi2 := toRadians(latitude1), toRadians(latitude2)
+	deltaPhi, deltaLambda := phi2-phi1, toRadians(longitude2-longitude1)
+	a := math.Sin(deltaPhi/2)*math.Sin(deltaPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(deltaLambda/2)*math.Sin(deltaLambda/2)
+	return 2 * EarthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Bearing returns the initial bearing in degrees clockwise from north from the first location to the second
+func Bearing(latitude1 float64, longitude1 float64, latitude2 float64, longitude2 float64) float64 {
+	phi1, phi2 := toRadians(latitude1), toRadians(latitude2)
+	deltaLambda := toRadians(longitude2 - longitude1)
+	y := math.Sin(deltaLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(deltaLambda)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+func angleDifference(a float64, b float64) float64 {
+	difference := math.Mod(math.Abs(a-b), 360)
+	if difference > 180 {
+		return 360 - difference
+	}
+	return difference
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func validateLocation(latitude float64, longitude float64) error {
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return common.NewValidationErrorf("#CellSpatialIndex - latitude %g is not within [-90, 90]", latitude)
+	}
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return common.NewValidationErrorf("#CellSpatialIndex - longitude %g is not within [-180, 180]", longitude)
+	}
+	return nil
+}
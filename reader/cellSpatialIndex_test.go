@@ -0,0 +1,141 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package reader
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const (
+	parisLatitude  = 48.8566
+	parisLongitude = 2.3522
+)
+
+func locatedLteCell(cellId string, latitude float32, longitude float32, azimuth float32, sectorId uint32) *entities.ServedCellInfo {
+	return &entities.ServedCellInfo{CellId: cellId, AdditionalCellInformation: &entities.AdditionalCellInformation{
+		CellLatitude: latitude, CellLongitude: longitude, AntennaAzimuthDirection: azimuth, SectorId: sectorId,
+	}}
+}
+
+func initCellSpatialIndex(t *testing.T) *CellSpatialIndex {
+	storage := common.NewInMemorySdlSyncStorage()
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:  "enb_1",
+		NodeType: entities.Node_ENB,
+		Configuration: &entities.NodebInfo_Enb{Enb: &entities.Enb{ServedCells: []*entities.ServedCellInfo{
+			locatedLteCell("east_west", parisLatitude, parisLongitude+0.01, 270, 1),
+			locatedLteCell("east_east", parisLatitude, parisLongitude+0.01, 90, 2),
+			locatedLteCell("north_south", parisLatitude+0.05, parisLongitude, 180, 1),
+			{CellId: "unlocated"},
+		}}},
+	})
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:  "gnb_1",
+		NodeType: entities.Node_GNB,
+		Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{ServedNrCells: []*entities.ServedNRCell{
+			{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: "berlin", AdditionalCellInformation: &entities.AdditionalCellInformation{
+				CellLatitude: 52.52, CellLongitude: 13.405,
+			}}},
+		}}},
+	})
+	index, err := BuildCellSpatialIndex(GetNewRNibReader(storage))
+	assert.Nil(t, err)
+	return index
+}
+
+func cellDistanceIds(cells []CellDistance) []string {
+	ids := []string{}
+	for _, cell := range cells {
+		ids = append(ids, cellId(cell.Cell))
+	}
+	return ids
+}
+
+func cellId(cell *entities.Cell) string {
+	if cell.GetServedNrCell() != nil {
+		return cell.GetServedNrCell().GetServedNrCellInformation().GetCellId()
+	}
+	return cell.GetServedCellInfo().GetCellId()
+}
+
+func TestDistanceAndBearing(t *testing.T) {
+	assert.InDelta(t, 877000, Distance(parisLatitude, parisLongitude, 52.52, 13.405), 3000)
+	assert.InDelta(t, 90, Bearing(0, 0, 0, 1), 1e-9)
+	assert.InDelta(t, 180, Bearing(1, 0, 0, 0), 1e-9)
+	assert.InDelta(t, 20, angleDifference(350, 10), 1e-9)
+}
+
+func TestFindCellsWithinRadius(t *testing.T) {
+	index := initCellSpatialIndex(t)
+	assert.Equal(t, 4, index.Len())
+	cells, err := index.FindCellsWithinRadius(parisLatitude, parisLongitude, 1000)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"east_west", "east_east"}, cellDistanceIds(cells))
+	assert.InDelta(t, 731, cells[0].DistanceMeters, 5)
+	assert.Equal(t, "enb_1", cells[0].InventoryName)
+	cells, err = index.FindCellsWithinRadius(parisLatitude, parisLongitude, 10000)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"east_west", "east_east", "north_south"}, cellDistanceIds(cells))
+	_, err = index.FindCellsWithinRadius(91, parisLongitude, 1000)
+	assert.IsType(t, &common.ValidationError{}, err)
+	_, err = index.FindCellsWithinRadius(parisLatitude, parisLongitude, -1)
+	assert.IsType(t, &common.ValidationError{}, err)
+}
+
+func TestFindNearestCells(t *testing.T) {
+	index := initCellSpatialIndex(t)
+	cells, err := index.FindNearestCells(52.5, 13.4, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, "berlin", cellDistanceIds(cells)[0])
+	assert.Equal(t, "north_south", cellDistanceIds(cells)[1])
+	cells, err = index.FindNearestCells(-33.86, 151.2, 10)
+	assert.Nil(t, err)
+	assert.Len(t, cells, 4)
+	_, err = index.FindNearestCells(parisLatitude, parisLongitude, 0)
+	assert.IsType(t, &common.ValidationError{}, err)
+}
+
+func TestFindCellsInBoundingBox(t *testing.T) {
+	index := initCellSpatialIndex(t)
+	cells, err := index.FindCellsInBoundingBox(48, 2, 49, 2.36)
+	assert.Nil(t, err)
+	assert.Len(t, cells, 1)
+	assert.Equal(t, "north_south", cellId(cells[0].Cell))
+	cells, err = index.FindCellsInBoundingBox(48, 2.36, 53, 2)
+	assert.Nil(t, err)
+	assert.Len(t, cells, 3)
+	assert.Equal(t, "berlin", cellId(cells[2].Cell))
+	_, err = index.FindCellsInBoundingBox(49, 2, 48, 3)
+	assert.IsType(t, &common.ValidationError{}, err)
+}
+
+func TestFindSectorsPointingAt(t *testing.T) {
+	index := initCellSpatialIndex(t)
+	cells, err := index.FindSectorsPointingAt(parisLatitude, parisLongitude, 10000, 60)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"east_west", "north_south"}, cellDistanceIds(cells))
+	assert.InDelta(t, 270, cells[0].Bearing, 0.1)
+	_, err = index.FindSectorsPointingAt(parisLatitude, parisLongitude, 10000, 400)
+	assert.IsType(t, &common.ValidationError{}, err)
+}
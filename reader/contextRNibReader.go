@@ -0,0 +1,253 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"context"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+)
+
+/*
+ContextRNibReader interface allows retrieving data from redis DB by various keys, giving up when the context is done.
+A call abandoned this way returns a common.ContextError wrapping the context error.
+*/
+type ContextRNibReader interface {
+	// GetNodeb retrieves responding nodeb entity from redis DB by nodeb inventory name
+	GetNodeb(ctx context.Context, inventoryName string) (*entities.NodebInfo, error)
+	// GetNodebByGlobalNbId retrieves responding nodeb entity from redis DB by nodeb global Id
+	GetNodebByGlobalNbId(ctx context.Context, nodeType entities.Node_Type, globalNbId *entities.GlobalNbId, cuupId string, duid string) (*entities.NodebInfo, error)
+	// GetNodebs retrieves the nodeb entities of the given inventory names in a single redis DB round trip
+	GetNodebs(ctx context.Context, inventoryNames []string) (map[string]*entities.NodebInfo, map[string]error, error)
+	// GetNodebsByGlobalNbIds retrieves the nodeb entities of the given global Ids in a single redis DB round trip
+	GetNodebsByGlobalNbIds(ctx context.Context, nodeType entities.Node_Type, globalNbIds []NodebGlobalId) (map[string]*entities.NodebInfo, map[string]error, error)
+	// FindNodebs retrieves the nodeb entities matching the filter, sorted by inventory name
+	FindNodebs(ctx context.Context, filter NodebFilter) ([]*entities.NodebInfo, error)
+	// FindNodebIds retrieves the identities of the nodebs matching the filter, sorted by inventory name
+	FindNodebIds(ctx context.Context, filter NodebFilter) ([]*entities.NbIdentity, error)
+	// PageNodebIds returns a pager unmarshalling the eNodeb and gNodeb identity entities in pages of at most pageSize
+	PageNodebIds(ctx context.Context, pageSize int) NodebIdPager
+	// GetCellList retrieves the list of cell entities belonging to responding nodeb entity from redis DB by nodeb inventory name
+	GetCellList(ctx context.Context, inventoryName string) (*entities.Cells, error)
+	// GetListGnbIds retrieves the list of gNodeb identity entities
+	GetListGnbIds(ctx context.Context) ([]*entities.NbIdentity, error)
+	// GetListEnbIds retrieves the list of eNodeb identity entities
+	GetListEnbIds(ctx context.Context) ([]*entities.NbIdentity, error)
+	// GetCountGnbList retrieves the number of gNodeb identity entities
+	GetCountGnbList(ctx context.Context) (int, error)
+	// GetCountEnbList retrieves the number of eNodeb identity entities
+	GetCountEnbList(ctx context.Context) (int, error)
+	// GetCountNodebs retrieves the number of eNodeb and gNodeb identity entities
+	GetCountNodebs(ctx context.Context) (int, error)
+	// GetNodebStatistics counts the nodeb entities by connection status, node type, gNodeb node type and E2T instance, and their served cells
+	GetNodebStatistics(ctx context.Context) (*NodebStatistics, error)
+	// GetCell retrieves the cell entity belonging to responding nodeb from redis DB by nodeb inventory name and cell pci
+	GetCell(ctx context.Context, inventoryName string, pci uint32) (*entities.Cell, error)
+	// GetCellById retrieves the cell entity from redis DB by cell type and cell Id
+	GetCellById(ctx context.Context, cellType entities.Cell_Type, cellId string) (*entities.Cell, error)
+	// GetCellByCgi retrieves the cell entity from redis DB by cell type and cell global Id, an NR CGI or an ECGI
+	GetCellByCgi(ctx context.Context, cellType entities.Cell_Type, cgi string) (*entities.Cell, error)
+	// GetCellsByPlmn retrieves the cell entities serving the PLMN, LTE cells first, each sorted by cell Id
+	GetCellsByPlmn(ctx context.Context, plmnId string) ([]*entities.Cell, error)
+	// GetCellsByTac retrieves the cell entities of the tracking area, LTE cells first, each sorted by cell Id
+	GetCellsByTac(ctx context.Context, tac string) ([]*entities.Cell, error)
+	// GetListNodebIds returns the full list of Nodeb identity entities
+	GetListNodebIds(ctx context.Context) ([]*entities.NbIdentity, error)
+	// GetRanLoadInformation retrieves nodeb load information entity from redis DB by nodeb inventory name
+	GetRanLoadInformation(ctx context.Context, inventoryName string) (*entities.RanLoadInformation, error)
+
+	GetE2TInstance(ctx context.Context, address string) (*entities.E2TInstance, error)
+
+	GetE2TInstances(ctx context.Context, addresses []string) ([]*entities.E2TInstance, error)
+
+	GetE2TAddresses(ctx context.Context) ([]string, error)
+
+	GetGeneralConfiguration(ctx context.Context) (*entities.GeneralConfiguration, error)
+
+	GetRanFunctionDefinition(ctx context.Context, inventoryName string, Oid string) ([]string, error)
+	// GetRanFunctions retrieves the RAN functions advertised by the gNodeb by nodeb inventory name
+	GetRanFunctions(ctx context.Context, inventoryName string) ([]*entities.RanFunction, error)
+	// GetRanFunctionById retrieves the RAN function advertised by the nodeb by nodeb inventory name and RAN function id
+	GetRanFunctionById(ctx context.Context, inventoryName string, ranFunctionId uint32) (*entities.RanFunction, error)
+	// FindNodebsSupportingRanFunction retrieves the nodebs advertising the RAN function OID with at least minRevision, sorted by inventory name
+	FindNodebsSupportingRanFunction(ctx context.Context, oid string, minRevision uint32) ([]*entities.NodebInfo, error)
+}
+
+type contextRNibReaderInstance struct {
+	reader *rNibReaderInstance
+}
+
+//GetNewContextRNibReader returns reference to ContextRNibReader
+func GetNewContextRNibReader(storage common.ISdlSyncStorage) ContextRNibReader {
+	return &contextRNibReaderInstance{
+		reader: &rNibReaderInstance{
+			sdlStorage: storage,
+			ns:         common.GetRNibNamespace(),
+		},
+	}
+}
+
+func (w *contextRNibReaderInstance) GetNodeb(ctx context.Context, inventoryName string) (*entities.NodebInfo, error) {
+	return w.reader.getNodeb(ctx, inventoryName)
+}
+
+func (w *contextRNibReaderInstance) GetNodebByGlobalNbId(ctx context.Context, nodeType entities.Node_Type, globalNbId *entities.GlobalNbId, cuupId string, duid string) (*entities.NodebInfo, error) {
+	return w.reader.getNodebByGlobalNbId(ctx, nodeType, globalNbId, cuupId, duid)
+}
+
+func (w *contextRNibReaderInstance) GetNodebs(ctx context.Context, inventoryNames []string) (map[string]*entities.NodebInfo, map[string]error, error) {
+	return w.reader.getNodebs(ctx, inventoryNames)
+}
+
+func (w *contextRNibReaderInstance) PageNodebIds(ctx context.Context, pageSize int) NodebIdPager {
+	return w.reader.pageNodebIds(ctx, pageSize)
+}
+
+func (w *contextRNibReaderInstance) FindNodebs(ctx context.Context, filter NodebFilter) ([]*entities.NodebInfo, error) {
+	_, nodebs, err := w.reader.findNodebs(ctx, filter)
+	return nodebs, err
+}
+
+func (w *contextRNibReaderInstance) FindNodebIds(ctx context.Context, filter NodebFilter) ([]*entities.NbIdentity, error) {
+	nbIdentities, _, err := w.reader.findNodebs(ctx, filter)
+	return nbIdentities, err
+}
+
+func (w *contextRNibReaderInstance) GetNodebsByGlobalNbIds(ctx context.Context, nodeType entities.Node_Type, globalNbIds []NodebGlobalId) (map[string]*entities.NodebInfo, map[string]error, error) {
+	return w.reader.getNodebsByGlobalNbIds(ctx, nodeType, globalNbIds)
+}
+
+func (w *contextRNibReaderInstance) GetCellList(ctx context.Context, inventoryName string) (*entities.Cells, error) {
+	return w.reader.getCellList(ctx, inventoryName)
+}
+
+func (w *contextRNibReaderInstance) GetListGnbIds(ctx context.Context) ([]*entities.NbIdentity, error) {
+	return w.reader.getListNodebIdsByType(ctx, entities.Node_GNB.String())
+}
+
+func (w *contextRNibReaderInstance) GetListEnbIds(ctx context.Context) ([]*entities.NbIdentity, error) {
+	return w.reader.getListNodebIdsByType(ctx, entities.Node_ENB.String())
+}
+
+func (w *contextRNibReaderInstance) GetCountGnbList(ctx context.Context) (int, error) {
+	return w.reader.getCountGnbList(ctx)
+}
+
+func (w *contextRNibReaderInstance) GetCountEnbList(ctx context.Context) (int, error) {
+	return w.reader.getCountEnbList(ctx)
+}
+
+func (w *contextRNibReaderInstance) GetCountNodebs(ctx context.Context) (int, error) {
+	return w.reader.getCountNodebs(ctx)
+}
+
+func (w *contextRNibReaderInstance) GetNodebStatistics(ctx context.Context) (*NodebStatistics, error) {
+	return w.reader.getNodebStatistics(ctx)
+}
+
+func (w *contextRNibReaderInstance) GetCell(ctx context.Context, inventoryName string, pci uint32) (*entities.Cell, error) {
+	return w.reader.getCell(ctx, inventoryName, pci)
+}
+
+func (w *contextRNibReaderInstance) GetCellById(ctx context.Context, cellType entities.Cell_Type, cellId string) (*entities.Cell, error) {
+	return w.reader.getCellById(ctx, cellType, cellId)
+}
+
+func (w *contextRNibReaderInstance) GetCellByCgi(ctx context.Context, cellType entities.Cell_Type, cgi string) (*entities.Cell, error) {
+	return w.reader.getCellByCgi(ctx, cellType, cgi)
+}
+
+func (w *contextRNibReaderInstance) GetCellsByPlmn(ctx context.Context, plmnId string) ([]*entities.Cell, error) {
+	return w.reader.getCellsByPlmn(ctx, plmnId)
+}
+
+func (w *contextRNibReaderInstance) GetCellsByTac(ctx context.Context, tac string) ([]*entities.Cell, error) {
+	return w.reader.getCellsByTac(ctx, tac)
+}
+
+func (w *contextRNibReaderInstance) GetListNodebIds(ctx context.Context) ([]*entities.NbIdentity, error) {
+	return w.reader.getListNodebIds(ctx)
+}
+
+func (w *contextRNibReaderInstance) GetRanLoadInformation(ctx context.Context, inventoryName string) (*entities.RanLoadInformation, error) {
+	return w.reader.getRanLoadInformation(ctx, inventoryName)
+}
+
+func (w *contextRNibReaderInstance) GetE2TInstance(ctx context.Context, address string) (*entities.E2TInstance, error) {
+	return w.reader.getE2TInstance(ctx, address)
+}
+
+func (w *contextRNibReaderInstance) GetE2TInstances(ctx context.Context, addresses []string) ([]*entities.E2TInstance, error) {
+	return w.reader.getE2TInstances(ctx, addresses)
+}
+
+func (w *contextRNibReaderInstance) GetE2TAddresses(ctx context.Context) ([]string, error) {
+	return w.reader.getE2TAddresses(ctx)
+}
+
+func (w *contextRNibReaderInstance) GetGeneralConfiguration(ctx context.Context) (*entities.GeneralConfiguration, error) {
+	return w.reader.getGeneralConfiguration(ctx)
+}
+
+func (w *contextRNibReaderInstance) GetRanFunctionDefinition(ctx context.Context, inventoryName string, oid string) ([]string, error) {
+	return w.reader.getRanFunctionDefinition(ctx, inventoryName, oid)
+}
+
+func (w *contextRNibReaderInstance) GetRanFunctions(ctx context.Context, inventoryName string) ([]*entities.RanFunction, error) {
+	return w.reader.getRanFunctions(ctx, inventoryName)
+}
+
+func (w *contextRNibReaderInstance) GetRanFunctionById(ctx context.Context, inventoryName string, ranFunctionId uint32) (*entities.RanFunction, error) {
+	return w.reader.getRanFunctionById(ctx, inventoryName, ranFunctionId)
+}
+
+func (w *contextRNibReaderInstance) FindNodebsSupportingRanFunction(ctx context.Context, oid string, minRevision uint32) ([]*entities.NodebInfo, error) {
+	return w.reader.findNodebsSupportingRanFunction(ctx, oid, minRevision)
+}
+
+/*
+callWithContext runs the SDL call f and waits for it no longer than the context allows.
+SDL calls cannot be interrupted, so an abandoned call completes in the background and its result is dropped.
+*/
+func callWithContext(ctx context.Context, f func() error) error {
+	if ctx.Done() == nil {
+		return f()
+	}
+	if err := ctx.Err(); err != nil {
+		return common.NewContextError(err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- f()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return common.NewContextError(ctx.Err())
+	}
+}
+
+func wrapSdlError(err error) error {
+	if _, ok := err.(*common.ContextError); ok {
+		return err
+	}
+	return common.NewStorageUnavailableError(err)
+}
@@ -0,0 +1,139 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"context"
+	"errors"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func initContextSdlSyncStorageMock() (w ContextRNibReader, sdlStorageMock *MockSdlSyncStorage) {
+	sdlStorageMock = new(MockSdlSyncStorage)
+	w = GetNewContextRNibReader(sdlStorageMock)
+	return
+}
+
+func TestGetNodebWithContext(t *testing.T) {
+	w, sdlStorageMock := initContextSdlSyncStorageMock()
+	nb := entities.NodebInfo{RanName: "name", Ip: "localhost", Port: 5656}
+	data, err := proto.Marshal(&nb)
+	if err != nil {
+		t.Errorf("#contextRNibReader_test.TestGetNodebWithContext - Failed to marshal NodebInfo entity. Error: %v", err)
+	}
+	ret := map[string]interface{}{"RAN:name": string(data)}
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"RAN:name"}).Return(ret, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	getNb, er := w.GetNodeb(ctx, "name")
+	assert.Nil(t, er)
+	assert.Equal(t, nb.Ip, getNb.Ip)
+	assert.Equal(t, nb.Port, getNb.Port)
+}
+
+func TestGetNodebWithCancelledContext(t *testing.T) {
+	w, sdlStorageMock := initContextSdlSyncStorageMock()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	getNb, er := w.GetNodeb(ctx, "name")
+	assert.Nil(t, getNb)
+	assert.IsType(t, &common.ContextError{}, er)
+	assert.True(t, errors.Is(er, context.Canceled))
+	sdlStorageMock.AssertNotCalled(t, "Get")
+}
+
+func TestGetNodebWithContextDeadlineExceeded(t *testing.T) {
+	w, sdlStorageMock := initContextSdlSyncStorageMock()
+	var ret map[string]interface{}
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"RAN:name"}).After(200*time.Millisecond).Return(ret, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	getNb, er := w.GetNodeb(ctx, "name")
+	assert.Nil(t, getNb)
+	assert.IsType(t, &common.ContextError{}, er)
+	assert.True(t, errors.Is(er, context.DeadlineExceeded))
+}
+
+func TestGetNodebWithContextSdlFailure(t *testing.T) {
+	w, sdlStorageMock := initContextSdlSyncStorageMock()
+	var ret map[string]interface{}
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"RAN:name"}).Return(ret, errors.New("expected error"))
+	getNb, er := w.GetNodeb(context.Background(), "name")
+	assert.Nil(t, getNb)
+	assert.IsType(t, &common.InternalError{}, er)
+}
+
+func TestGetListNodebIdsWithContextDeadlineExceeded(t *testing.T) {
+	w, sdlStorageMock := initContextSdlSyncStorageMock()
+	sdlStorageMock.On("GetMembers", common.GetRNibNamespace(), entities.Node_ENB.String()).After(200*time.Millisecond).Return([]string{}, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	ids, er := w.GetListNodebIds(ctx)
+	assert.Nil(t, ids)
+	assert.IsType(t, &common.ContextError{}, er)
+}
+
+func TestGetListGnbIdsWithContext(t *testing.T) {
+	w, sdlStorageMock := initContextSdlSyncStorageMock()
+	nbIdentity := &entities.NbIdentity{InventoryName: "name", GlobalNbId: &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"}}
+	data, err := proto.Marshal(nbIdentity)
+	if err != nil {
+		t.Errorf("#contextRNibReader_test.TestGetListGnbIdsWithContext - Failed to marshal NbIdentity entity. Error: %v", err)
+	}
+	sdlStorageMock.On("GetMembers", common.GetRNibNamespace(), entities.Node_GNB.String()).Return([]string{string(data)}, nil)
+	ids, er := w.GetListGnbIds(context.Background())
+	assert.Nil(t, er)
+	assert.Len(t, ids, 1)
+	assert.Equal(t, "name", ids[0].GetInventoryName())
+}
+
+func TestGetCountGnbListWithCancelledContext(t *testing.T) {
+	w, _ := initContextSdlSyncStorageMock()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	count, er := w.GetCountGnbList(ctx)
+	assert.Equal(t, 0, count)
+	assert.IsType(t, &common.ContextError{}, er)
+}
+
+func TestGetE2TInstancesWithContextDeadlineExceeded(t *testing.T) {
+	w, sdlStorageMock := initContextSdlSyncStorageMock()
+	var ret map[string]interface{}
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"E2TInstance:10.0.2.15:3213"}).After(200*time.Millisecond).Return(ret, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	instances, er := w.GetE2TInstances(ctx, []string{"10.0.2.15:3213"})
+	assert.Empty(t, instances)
+	assert.IsType(t, &common.ContextError{}, er)
+}
+
+func TestGetE2TAddressesWithContext(t *testing.T) {
+	w, sdlStorageMock := initContextSdlSyncStorageMock()
+	ret := map[string]interface{}{E2TAddressesKey: `["10.0.2.15:3213"]`}
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{E2TAddressesKey}).Return(ret, nil)
+	addresses, er := w.GetE2TAddresses(context.Background())
+	assert.Nil(t, er)
+	assert.Equal(t, []string{"10.0.2.15:3213"}, addresses)
+}
@@ -0,0 +1,34 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package reader
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+)
+
+// GetE2NodeComponentConfigs retrieves the E2 node component configurations of the nodeb grouped by interface type
+func GetE2NodeComponentConfigs(r RNibReader, inventoryName string) (map[entities.E2NodeComponentInterfaceType][]*entities.E2NodeComponentConfig, error) {
+	nodeb, err := r.GetNodeb(inventoryName)
+	if err != nil {
+		return nil, err
+	}
+	return entities.GroupE2NodeComponentConfigs(nodeb.GetNodeConfigs()), nil
+}
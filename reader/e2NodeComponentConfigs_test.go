@@ -0,0 +1,81 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package reader
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func initE2NodeComponentConfigs(t *testing.T) RNibReader {
+	storage := common.NewInMemorySdlSyncStorage()
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:  "gnb_1",
+		NodeType: entities.Node_GNB,
+		Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{NodeConfigs: []*entities.E2NodeComponentConfig{
+			{
+				E2NodeComponentID:           &entities.E2NodeComponentConfig_E2NodeComponentInterfaceTypeNG{E2NodeComponentInterfaceTypeNG: &entities.E2NodeComponentInterfaceNG{AmfName: "amf1"}},
+				E2NodeComponentResponsePart: []byte{0x20, 0x15, 0x00, 0x0d, 0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x06, 0x01, 0x80, 'a', 'm', 'f', '1'},
+			},
+			{
+				E2NodeComponentID: &entities.E2NodeComponentConfig_E2NodeComponentInterfaceTypeF1{E2NodeComponentInterfaceTypeF1: &entities.E2NodeComponentInterfaceF1{GNBDuId: 1}},
+			},
+			{
+				E2NodeComponentInterfaceType: entities.E2NodeComponentInterfaceType_f1,
+				E2NodeComponentRequestPart:   []byte{0x20},
+			},
+			{
+				E2NodeComponentID: &entities.E2NodeComponentConfig_E2NodeComponentInterfaceTypeX2{E2NodeComponentInterfaceTypeX2: &entities.E2NodeComponentInterfaceX2{
+					GlobalEnbId: &entities.GlobalENBID{PlmnIdentity: "02f829", EnbId: "007a80"},
+				}},
+			},
+		}}},
+	})
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:       "enb_1",
+		NodeType:      entities.Node_ENB,
+		Configuration: &entities.NodebInfo_Enb{Enb: &entities.Enb{}},
+	})
+	return GetNewRNibReader(storage)
+}
+
+func TestGetE2NodeComponentConfigs(t *testing.T) {
+	w := initE2NodeComponentConfigs(t)
+	groups, err := GetE2NodeComponentConfigs(w, "gnb_1")
+	assert.Nil(t, err)
+	assert.Len(t, groups, 3)
+	assert.Equal(t, "amf1", groups[entities.E2NodeComponentInterfaceType_ng][0].GetE2NodeComponentInterfaceTypeNG().GetAmfName())
+	assert.Len(t, groups[entities.E2NodeComponentInterfaceType_f1], 2)
+	assert.EqualValues(t, 1, groups[entities.E2NodeComponentInterfaceType_f1][0].GetE2NodeComponentInterfaceTypeF1().GetGNBDuId())
+	assert.Equal(t, "007a80", groups[entities.E2NodeComponentInterfaceType_x2][0].GetE2NodeComponentInterfaceTypeX2().GetGlobalEnbId().GetEnbId())
+	groups, err = GetE2NodeComponentConfigs(w, "enb_1")
+	assert.Nil(t, err)
+	assert.Empty(t, groups)
+}
+
+func TestGetE2NodeComponentConfigsNodebNotFound(t *testing.T) {
+	w := initE2NodeComponentConfigs(t)
+	groups, err := GetE2NodeComponentConfigs(w, "gnb_2")
+	assert.Nil(t, groups)
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+}
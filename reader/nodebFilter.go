@@ -0,0 +1,121 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"context"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"sort"
+)
+
+/*
+NodebFilter selects the nodebs returned by FindNodebs and FindNodebIds.
+Zero valued fields match any nodeb, the set ones must all match.
+*/
+type NodebFilter struct {
+	ConnectionStatus   entities.ConnectionStatus
+	NodeType           entities.Node_Type
+	GnbType            entities.GnbType
+	EnbType            entities.EnbType
+	GnbNodeType        string
+	PlmnId             string
+	E2TInstanceAddress string
+	SetupFromNetwork   *bool
+}
+
+// Matches returns true when the nodeb satisfies all the set fields of the filter
+func (f *NodebFilter) Matches(nodeb *entities.NodebInfo) bool {
+	if f.ConnectionStatus != entities.ConnectionStatus_UNKNOWN_CONNECTION_STATUS && nodeb.GetConnectionStatus() != f.ConnectionStatus {
+		return false
+	}
+	if f.NodeType != entities.Node_UNKNOWN && nodeb.GetNodeType() != f.NodeType {
+		return false
+	}
+	if f.GnbType != entities.GnbType_UNKNOWN_GNB_TYPE && nodeb.GetGnb().GetGnbType() != f.GnbType {
+		return false
+	}
+	if f.EnbType != entities.EnbType_UNKNOWN_ENB_TYPE && nodeb.GetEnb().GetEnbType() != f.EnbType {
+		return false
+	}
+	if f.GnbNodeType != "" && nodeb.GetGnbNodeType() != f.GnbNodeType {
+		return false
+	}
+	if f.PlmnId != "" && nodeb.GetGlobalNbId().GetPlmnId() != f.PlmnId {
+		return false
+	}
+	if f.E2TInstanceAddress != "" && nodeb.GetAssociatedE2TInstanceAddress() != f.E2TInstanceAddress {
+		return false
+	}
+	if f.SetupFromNetwork != nil && nodeb.GetSetupFromNetwork() != *f.SetupFromNetwork {
+		return false
+	}
+	return true
+}
+
+/*
+findNodebs reads the nodeb identities of the filter's node type and then all their nodeb entities in a single batch,
+and returns the matching identities and entities sorted by inventory name.
+Identities whose nodeb entity no longer exists are skipped.
+*/
+func (w *rNibReaderInstance) findNodebs(ctx context.Context, filter NodebFilter) ([]*entities.NbIdentity, []*entities.NodebInfo, error) {
+	var nbIdentities []*entities.NbIdentity
+	var err error
+	switch filter.NodeType {
+	case entities.Node_ENB, entities.Node_GNB:
+		nbIdentities, err = w.getListNodebIdsByType(ctx, filter.NodeType.String())
+	default:
+		nbIdentities, err = w.getListNodebIds(ctx)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	identitiesByName := make(map[string]*entities.NbIdentity, len(nbIdentities))
+	inventoryNames := make([]string, 0, len(nbIdentities))
+	for _, nbIdentity := range nbIdentities {
+		if filter.PlmnId != "" && nbIdentity.GetGlobalNbId().GetPlmnId() != filter.PlmnId {
+			continue
+		}
+		identitiesByName[nbIdentity.GetInventoryName()] = nbIdentity
+		inventoryNames = append(inventoryNames, nbIdentity.GetInventoryName())
+	}
+	sort.Strings(inventoryNames)
+	nodebs, rNibErrors, err := w.getNodebs(ctx, inventoryNames)
+	if err != nil {
+		return nil, nil, err
+	}
+	matchingIdentities := make([]*entities.NbIdentity, 0, len(nodebs))
+	matchingNodebs := make([]*entities.NodebInfo, 0, len(nodebs))
+	for _, inventoryName := range inventoryNames {
+		if rNibErr, ok := rNibErrors[inventoryName]; ok {
+			if common.GetErrorCode(rNibErr) == common.NotFound {
+				continue
+			}
+			return nil, nil, rNibErr
+		}
+		nodeb := nodebs[inventoryName]
+		if !filter.Matches(nodeb) {
+			continue
+		}
+		matchingIdentities = append(matchingIdentities, identitiesByName[inventoryName])
+		matchingNodebs = append(matchingNodebs, nodeb)
+	}
+	return matchingIdentities, matchingNodebs, nil
+}
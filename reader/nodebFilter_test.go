@@ -0,0 +1,148 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"context"
+	"errors"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func saveNodebForFilter(t *testing.T, storage common.ISdlSyncStorage, nodeb *entities.NodebInfo) {
+	data, err := proto.Marshal(nodeb)
+	if err != nil {
+		t.Fatalf("#nodebFilter_test.saveNodebForFilter - failed to marshal nodeb. Error: %v", err)
+	}
+	nbIdentity := &entities.NbIdentity{InventoryName: nodeb.RanName, GlobalNbId: nodeb.GlobalNbId, ConnectionStatus: nodeb.ConnectionStatus}
+	identityData, err := proto.Marshal(nbIdentity)
+	if err != nil {
+		t.Fatalf("#nodebFilter_test.saveNodebForFilter - failed to marshal nodeb identity. Error: %v", err)
+	}
+	assert.Nil(t, storage.Set(common.GetRNibNamespace(), "RAN:"+nodeb.RanName, data))
+	assert.Nil(t, storage.AddMember(common.GetRNibNamespace(), nodeb.NodeType.String(), identityData))
+}
+
+func initFindNodebs(t *testing.T) (RNibReader, common.ISdlSyncStorage) {
+	storage := common.NewInMemorySdlSyncStorage()
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:                      "gnb_1",
+		NodeType:                     entities.Node_GNB,
+		ConnectionStatus:             entities.ConnectionStatus_CONNECTED,
+		GlobalNbId:                   &entities.GlobalNbId{PlmnId: "02f829", NbId: "001"},
+		AssociatedE2TInstanceAddress: "10.0.2.15:38000",
+		GnbNodeType:                  "DU",
+		Configuration:                &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{GnbType: entities.GnbType_GNB}},
+	})
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:                      "gnb_2",
+		NodeType:                     entities.Node_GNB,
+		ConnectionStatus:             entities.ConnectionStatus_DISCONNECTED,
+		GlobalNbId:                   &entities.GlobalNbId{PlmnId: "02f829", NbId: "002"},
+		AssociatedE2TInstanceAddress: "10.0.2.16:38000",
+		GnbNodeType:                  "CU",
+		SetupFromNetwork:             true,
+		Configuration:                &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{GnbType: entities.GnbType_EN_GNB}},
+	})
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:                      "enb_1",
+		NodeType:                     entities.Node_ENB,
+		ConnectionStatus:             entities.ConnectionStatus_DISCONNECTED,
+		GlobalNbId:                   &entities.GlobalNbId{PlmnId: "13f184", NbId: "003"},
+		AssociatedE2TInstanceAddress: "10.0.2.15:38000",
+		Configuration:                &entities.NodebInfo_Enb{Enb: &entities.Enb{EnbType: entities.EnbType_MACRO_ENB}},
+	})
+	return GetNewRNibReader(storage), storage
+}
+
+func nodebNames(nodebs []*entities.NodebInfo) []string {
+	names := make([]string, 0, len(nodebs))
+	for _, nodeb := range nodebs {
+		names = append(names, nodeb.GetRanName())
+	}
+	return names
+}
+
+func TestFindNodebs(t *testing.T) {
+	w, _ := initFindNodebs(t)
+	setupFromNetwork := true
+	tests := []struct {
+		name     string
+		filter   NodebFilter
+		expected []string
+	}{
+		{"empty filter", NodebFilter{}, []string{"enb_1", "gnb_1", "gnb_2"}},
+		{"connection status", NodebFilter{ConnectionStatus: entities.ConnectionStatus_DISCONNECTED}, []string{"enb_1", "gnb_2"}},
+		{"disconnected gnbs", NodebFilter{ConnectionStatus: entities.ConnectionStatus_DISCONNECTED, NodeType: entities.Node_GNB}, []string{"gnb_2"}},
+		{"gnb type", NodebFilter{GnbType: entities.GnbType_EN_GNB}, []string{"gnb_2"}},
+		{"enb type", NodebFilter{EnbType: entities.EnbType_MACRO_ENB}, []string{"enb_1"}},
+		{"gnb node type", NodebFilter{GnbNodeType: "DU"}, []string{"gnb_1"}},
+		{"plmn", NodebFilter{PlmnId: "13f184"}, []string{"enb_1"}},
+		{"e2t address", NodebFilter{E2TInstanceAddress: "10.0.2.15:38000"}, []string{"enb_1", "gnb_1"}},
+		{"setup from network", NodebFilter{SetupFromNetwork: &setupFromNetwork}, []string{"gnb_2"}},
+		{"no match", NodebFilter{NodeType: entities.Node_ENB, GnbNodeType: "DU"}, []string{}},
+	}
+	for _, test := range tests {
+		nodebs, err := w.FindNodebs(test.filter)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expected, nodebNames(nodebs), test.name)
+		nbIdentities, err := w.FindNodebIds(test.filter)
+		assert.Nil(t, err, test.name)
+		assert.Len(t, nbIdentities, len(test.expected), test.name)
+		for i, nbIdentity := range nbIdentities {
+			assert.Equal(t, test.expected[i], nbIdentity.GetInventoryName(), test.name)
+		}
+	}
+}
+
+func TestFindNodebsSkipsIdentityWithoutNodeb(t *testing.T) {
+	w, storage := initFindNodebs(t)
+	assert.Nil(t, storage.Remove(common.GetRNibNamespace(), []string{"RAN:gnb_1"}))
+	nodebs, err := w.FindNodebs(NodebFilter{NodeType: entities.Node_GNB})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"gnb_2"}, nodebNames(nodebs))
+}
+
+func TestFindNodebsCorruptNodeb(t *testing.T) {
+	w, storage := initFindNodebs(t)
+	assert.Nil(t, storage.Set(common.GetRNibNamespace(), "RAN:gnb_1", "data"))
+	nodebs, err := w.FindNodebs(NodebFilter{})
+	assert.Nil(t, nodebs)
+	assert.True(t, errors.Is(err, common.ErrCorruptData))
+}
+
+func TestFindNodebsSdlError(t *testing.T) {
+	w, sdlInstanceMock := initSdlSyncStorageMock()
+	sdlInstanceMock.On("GetMembers", common.GetRNibNamespace(), entities.Node_GNB.String()).Return([]string(nil), errors.New("expected error"))
+	nodebs, err := w.FindNodebs(NodebFilter{NodeType: entities.Node_GNB})
+	assert.Nil(t, nodebs)
+	assert.True(t, errors.Is(err, common.ErrStorageUnavailable))
+}
+
+func TestContextFindNodebIds(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	saveNodebForFilter(t, storage, &entities.NodebInfo{RanName: "gnb_1", NodeType: entities.Node_GNB, GlobalNbId: &entities.GlobalNbId{PlmnId: "02f829", NbId: "001"}})
+	nbIdentities, err := GetNewContextRNibReader(storage).FindNodebIds(context.Background(), NodebFilter{NodeType: entities.Node_GNB})
+	assert.Nil(t, err)
+	assert.Len(t, nbIdentities, 1)
+}
@@ -0,0 +1,128 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"context"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"sort"
+)
+
+const DefaultNodebIdsPageSize = 1000
+
+/*
+NodebIdPager unmarshals the nodeb identities page by page, eNodebs first and then gNodebs:
+
+	pager := reader.PageNodebIds(ctx, 500)
+	for pager.Next() {
+		page := pager.Page()
+		...
+	}
+	if err := pager.Err(); err != nil {
+		...
+	}
+
+The order is stable as long as the identity groups are not modified during the iteration.
+SDL offers no cursor over a group, so each identity group is read whole when the pager reaches it. Only the unmarshalling
+is paged: the memory used is not bounded by the page size but by the size of the largest identity group.
+*/
+type NodebIdPager interface {
+	// Next fetches the next page and returns false when there are no more pages or an error occurred
+	Next() bool
+	// Page returns the page fetched by the last call to Next
+	Page() []*entities.NbIdentity
+	// Err returns the error that stopped the iteration, if any
+	Err() error
+	// Total returns the number of eNodeb and gNodeb identities
+	Total() (int, error)
+}
+
+/*
+nodebIdPager reads the members of one identity group at a time and unmarshals them page by page,
+the raw members of the current group are held until they are consumed.
+*/
+type nodebIdPager struct {
+	ctx      context.Context
+	reader   *rNibReaderInstance
+	pageSize int
+	groups   []string
+	members  []string
+	page     []*entities.NbIdentity
+	err      error
+}
+
+func (w *rNibReaderInstance) pageNodebIds(ctx context.Context, pageSize int) *nodebIdPager {
+	if pageSize <= 0 {
+		pageSize = DefaultNodebIdsPageSize
+	}
+	return &nodebIdPager{
+		ctx:      ctx,
+		reader:   w,
+		pageSize: pageSize,
+		groups:   []string{entities.Node_ENB.String(), entities.Node_GNB.String()},
+	}
+}
+
+func (p *nodebIdPager) Next() bool {
+	p.page = nil
+	if p.err != nil {
+		return false
+	}
+	page := make([]string, 0, p.pageSize)
+	for len(page) < p.pageSize {
+		if len(p.members) == 0 {
+			if len(p.groups) == 0 {
+				break
+			}
+			members, err := p.reader.getMembers(p.ctx, p.groups[0])
+			if err != nil {
+				p.err = err
+				return false
+			}
+			p.groups = p.groups[1:]
+			sort.Strings(members)
+			p.members = members
+			continue
+		}
+		count := p.pageSize - len(page)
+		if count > len(p.members) {
+			count = len(p.members)
+		}
+		page = append(page, p.members[:count]...)
+		p.members = p.members[count:]
+	}
+	if len(page) == 0 {
+		return false
+	}
+	p.page, p.err = p.reader.unmarshalIdentityList(page)
+	return p.err == nil
+}
+
+func (p *nodebIdPager) Page() []*entities.NbIdentity {
+	return p.page
+}
+
+func (p *nodebIdPager) Err() error {
+	return p.err
+}
+
+func (p *nodebIdPager) Total() (int, error) {
+	return p.reader.getCountNodebs(p.ctx)
+}
@@ -0,0 +1,123 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func initNodebIdPager(t *testing.T, enbCount int, gnbCount int) (RNibReader, common.ISdlSyncStorage) {
+	storage := common.NewInMemorySdlSyncStorage()
+	addIdentities := func(nodeType entities.Node_Type, count int) {
+		for i := 0; i < count; i++ {
+			nbIdentity := &entities.NbIdentity{InventoryName: fmt.Sprintf("%s_%d", nodeType.String(), i), GlobalNbId: &entities.GlobalNbId{PlmnId: "02f829", NbId: fmt.Sprint(i)}}
+			data, err := proto.Marshal(nbIdentity)
+			if err != nil {
+				t.Fatalf("#nodebIdPager_test.initNodebIdPager - failed to marshal nodeb identity. Error: %v", err)
+			}
+			assert.Nil(t, storage.AddMember(common.GetRNibNamespace(), nodeType.String(), data))
+		}
+	}
+	addIdentities(entities.Node_ENB, enbCount)
+	addIdentities(entities.Node_GNB, gnbCount)
+	return GetNewRNibReader(storage), storage
+}
+
+func readAllPages(t *testing.T, pager NodebIdPager) ([]int, []string) {
+	var pageSizes []int
+	var names []string
+	for pager.Next() {
+		pageSizes = append(pageSizes, len(pager.Page()))
+		for _, nbIdentity := range pager.Page() {
+			names = append(names, nbIdentity.GetInventoryName())
+		}
+	}
+	assert.Nil(t, pager.Err())
+	return pageSizes, names
+}
+
+func TestPageNodebIds(t *testing.T) {
+	w, _ := initNodebIdPager(t, 5, 7)
+	pager := w.PageNodebIds(context.Background(), 5)
+	total, err := pager.Total()
+	assert.Nil(t, err)
+	assert.Equal(t, 12, total)
+	pageSizes, names := readAllPages(t, pager)
+	assert.Equal(t, []int{5, 5, 2}, pageSizes)
+	assert.Len(t, names, 12)
+	assert.Equal(t, "ENB_", names[4][:4])
+	assert.Equal(t, "GNB_", names[5][:4])
+	assert.False(t, pager.Next())
+	assert.Nil(t, pager.Page())
+	_, secondNames := readAllPages(t, w.PageNodebIds(context.Background(), 3))
+	assert.Equal(t, names, secondNames)
+}
+
+func TestPageNodebIdsDefaultPageSize(t *testing.T) {
+	w, _ := initNodebIdPager(t, 3, 0)
+	pageSizes, _ := readAllPages(t, w.PageNodebIds(context.Background(), 0))
+	assert.Equal(t, []int{3}, pageSizes)
+}
+
+func TestPageNodebIdsEmpty(t *testing.T) {
+	w, _ := initNodebIdPager(t, 0, 0)
+	pager := w.PageNodebIds(context.Background(), 10)
+	assert.False(t, pager.Next())
+	assert.Nil(t, pager.Err())
+	total, err := pager.Total()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, total)
+}
+
+func TestPageNodebIdsCorruptIdentity(t *testing.T) {
+	w, storage := initNodebIdPager(t, 1, 0)
+	assert.Nil(t, storage.AddMember(common.GetRNibNamespace(), entities.Node_GNB.String(), "data"))
+	pager := w.PageNodebIds(context.Background(), 10)
+	assert.False(t, pager.Next())
+	assert.True(t, errors.Is(pager.Err(), common.ErrCorruptData))
+	assert.False(t, pager.Next())
+}
+
+func TestPageNodebIdsSdlError(t *testing.T) {
+	w, sdlInstanceMock := initSdlSyncStorageMock()
+	sdlInstanceMock.On("GetMembers", common.GetRNibNamespace(), entities.Node_ENB.String()).Return([]string(nil), errors.New("expected error"))
+	sdlInstanceMock.On("GroupSize", common.GetRNibNamespace(), entities.Node_ENB.String()).Return(0, errors.New("expected error"))
+	pager := w.PageNodebIds(context.Background(), 10)
+	assert.False(t, pager.Next())
+	assert.True(t, errors.Is(pager.Err(), common.ErrStorageUnavailable))
+	_, err := pager.Total()
+	assert.True(t, errors.Is(err, common.ErrStorageUnavailable))
+}
+
+func TestPageNodebIdsCancelledContext(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	pager := GetNewContextRNibReader(storage).PageNodebIds(ctx, 10)
+	assert.False(t, pager.Next())
+	assert.True(t, errors.Is(pager.Err(), common.ErrTimeout))
+}
@@ -0,0 +1,92 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"context"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+)
+
+/*
+NodebStatistics summarizes the nodeb population. The maps are keyed by the enum names,
+the gNodeb node type (e.g. CU, DU) and the associated E2T instance address, where nodebs without one are counted under "".
+*/
+type NodebStatistics struct {
+	Total              int            `json:"total"`
+	ByConnectionStatus map[string]int `json:"byConnectionStatus"`
+	ByNodeType         map[string]int `json:"byNodeType"`
+	ByGnbNodeType      map[string]int `json:"byGnbNodeType"`
+	ByE2TInstance      map[string]int `json:"byE2tInstance"`
+	LteCells           int            `json:"lteCells"`
+	NrCells            int            `json:"nrCells"`
+}
+
+func newNodebStatistics() *NodebStatistics {
+	return &NodebStatistics{
+		ByConnectionStatus: map[string]int{},
+		ByNodeType:         map[string]int{},
+		ByGnbNodeType:      map[string]int{},
+		ByE2TInstance:      map[string]int{},
+	}
+}
+
+func (s *NodebStatistics) add(nodeb *entities.NodebInfo) {
+	s.Total++
+	s.ByConnectionStatus[nodeb.GetConnectionStatus().String()]++
+	s.ByNodeType[nodeb.GetNodeType().String()]++
+	if nodeb.GetNodeType() == entities.Node_GNB {
+		s.ByGnbNodeType[nodeb.GetGnbNodeType()]++
+	}
+	s.ByE2TInstance[nodeb.GetAssociatedE2TInstanceAddress()]++
+	s.LteCells += len(nodeb.GetEnb().GetServedCells())
+	s.NrCells += len(nodeb.GetGnb().GetServedNrCells())
+}
+
+/*
+getNodebStatistics pages through the nodeb identities and reads the nodeb entities of each page in a single batch.
+Identities whose nodeb entity no longer exists are not counted.
+*/
+func (w *rNibReaderInstance) getNodebStatistics(ctx context.Context) (*NodebStatistics, error) {
+	statistics := newNodebStatistics()
+	pager := w.pageNodebIds(ctx, DefaultNodebIdsPageSize)
+	for pager.Next() {
+		inventoryNames := make([]string, 0, len(pager.Page()))
+		for _, nbIdentity := range pager.Page() {
+			inventoryNames = append(inventoryNames, nbIdentity.GetInventoryName())
+		}
+		nodebs, rNibErrors, err := w.getNodebs(ctx, inventoryNames)
+		if err != nil {
+			return nil, err
+		}
+		for _, rNibErr := range rNibErrors {
+			if common.GetErrorCode(rNibErr) != common.NotFound {
+				return nil, rNibErr
+			}
+		}
+		for _, nodeb := range nodebs {
+			statistics.add(nodeb)
+		}
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+	return statistics, nil
+}
@@ -0,0 +1,99 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"context"
+	"errors"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetCountNodebs(t *testing.T) {
+	w, _ := initNodebIdPager(t, 2, 3)
+	enbCount, err := w.GetCountEnbList()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, enbCount)
+	gnbCount, err := w.GetCountGnbList()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, gnbCount)
+	count, err := w.GetCountNodebs()
+	assert.Nil(t, err)
+	assert.Equal(t, 5, count)
+}
+
+func TestGetCountNodebsSdlError(t *testing.T) {
+	w, sdlInstanceMock := initSdlSyncStorageMock()
+	sdlInstanceMock.On("GroupSize", common.GetRNibNamespace(), entities.Node_ENB.String()).Return(0, errors.New("expected error"))
+	count, err := w.GetCountNodebs()
+	assert.Equal(t, 0, count)
+	assert.True(t, errors.Is(err, common.ErrStorageUnavailable))
+}
+
+func TestGetNodebStatistics(t *testing.T) {
+	w, storage := initFindNodebs(t)
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:          "gnb_3",
+		NodeType:         entities.Node_GNB,
+		ConnectionStatus: entities.ConnectionStatus_CONNECTED,
+		GlobalNbId:       &entities.GlobalNbId{PlmnId: "02f829", NbId: "004"},
+		GnbNodeType:      "DU",
+		Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{ServedNrCells: []*entities.ServedNRCell{
+			{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: "nr_1"}},
+			{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: "nr_2"}},
+		}}},
+	})
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:          "enb_2",
+		NodeType:         entities.Node_ENB,
+		ConnectionStatus: entities.ConnectionStatus_CONNECTED,
+		GlobalNbId:       &entities.GlobalNbId{PlmnId: "02f829", NbId: "005"},
+		Configuration: &entities.NodebInfo_Enb{Enb: &entities.Enb{ServedCells: []*entities.ServedCellInfo{
+			{CellId: "lte_1"},
+		}}},
+	})
+	statistics, err := w.GetNodebStatistics()
+	assert.Nil(t, err)
+	assert.Equal(t, &NodebStatistics{
+		Total:              5,
+		ByConnectionStatus: map[string]int{"CONNECTED": 3, "DISCONNECTED": 2},
+		ByNodeType:         map[string]int{"ENB": 2, "GNB": 3},
+		ByGnbNodeType:      map[string]int{"CU": 1, "DU": 2},
+		ByE2TInstance:      map[string]int{"10.0.2.15:38000": 2, "10.0.2.16:38000": 1, "": 2},
+		LteCells:           1,
+		NrCells:            2,
+	}, statistics)
+}
+
+func TestGetNodebStatisticsEmpty(t *testing.T) {
+	statistics, err := GetNewContextRNibReader(common.NewInMemorySdlSyncStorage()).GetNodebStatistics(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, newNodebStatistics(), statistics)
+}
+
+func TestGetNodebStatisticsCorruptNodeb(t *testing.T) {
+	w, storage := initFindNodebs(t)
+	assert.Nil(t, storage.Set(common.GetRNibNamespace(), "RAN:gnb_2", "data"))
+	statistics, err := w.GetNodebStatistics()
+	assert.Nil(t, statistics)
+	assert.True(t, errors.Is(err, common.ErrCorruptData))
+}
@@ -21,11 +21,14 @@
 package reader
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
 	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
 	"github.com/golang/protobuf/proto"
 	"reflect"
+	"sort"
 )
 
 const E2TAddressesKey = "E2TAddresses"
@@ -36,6 +39,16 @@ type rNibReaderInstance struct {
 	ns         string
 }
 
+/*
+NodebGlobalId identifies a nodeb by its global Id and, for a gNB-CU-UP or a gNB-DU, by its CU-UP or DU Id,
+as GetNodebByGlobalNbId does
+*/
+type NodebGlobalId struct {
+	GlobalNbId *entities.GlobalNbId
+	CuupId     string
+	DuId       string
+}
+
 /*
 RNibReader interface allows retrieving data from redis BD by various keys
 */
@@ -44,6 +57,18 @@ type RNibReader interface {
 	GetNodeb(inventoryName string) (*entities.NodebInfo, error)
 	// GetNodebByGlobalNbId retrieves responding nodeb entity from redis DB by nodeb global Id
 	GetNodebByGlobalNbId(nodeType entities.Node_Type, globalNbId *entities.GlobalNbId, cuupId string,duid string) (*entities.NodebInfo, error)
+	// GetNodebs retrieves the nodeb entities of the given inventory names in a single redis DB round trip.
+	// The found entities and the errors of the missing, invalid or corrupt ones are both keyed by inventory name
+	GetNodebs(inventoryNames []string) (map[string]*entities.NodebInfo, map[string]error, error)
+	// GetNodebsByGlobalNbIds retrieves the nodeb entities of the given global Ids in a single redis DB round trip.
+	// The found entities and the errors of the missing, invalid or corrupt ones are both keyed by the global Id key, e.g. GNB:<plmnId>:<nbId>[:<cuupId>|<duId>]
+	GetNodebsByGlobalNbIds(nodeType entities.Node_Type, globalNbIds []NodebGlobalId) (map[string]*entities.NodebInfo, map[string]error, error)
+	// FindNodebs retrieves the nodeb entities matching the filter, sorted by inventory name
+	FindNodebs(filter NodebFilter) ([]*entities.NodebInfo, error)
+	// FindNodebIds retrieves the identities of the nodebs matching the filter, sorted by inventory name
+	FindNodebIds(filter NodebFilter) ([]*entities.NbIdentity, error)
+	// PageNodebIds returns a pager unmarshalling the eNodeb and gNodeb identity entities in pages of at most pageSize
+	PageNodebIds(ctx context.Context, pageSize int) NodebIdPager
 	// GetCellList retrieves the list of cell entities belonging to responding nodeb entity from redis DB by nodeb inventory name
 	GetCellList(inventoryName string) (*entities.Cells, error)
 	// GetListGnbIds retrieves the list of gNodeb identity entities
@@ -52,10 +77,22 @@ type RNibReader interface {
 	GetListEnbIds() ([]*entities.NbIdentity, error)
 	// Close closes reader's pool
 	GetCountGnbList() (int, error)
+	// GetCountEnbList retrieves the number of eNodeb identity entities
+	GetCountEnbList() (int, error)
+	// GetCountNodebs retrieves the number of eNodeb and gNodeb identity entities
+	GetCountNodebs() (int, error)
+	// GetNodebStatistics counts the nodeb entities by connection status, node type, gNodeb node type and E2T instance, and their served cells
+	GetNodebStatistics() (*NodebStatistics, error)
 	// GetCell retrieves the cell entity belonging to responding nodeb from redis DB by nodeb inventory name and cell pci
 	GetCell(inventoryName string, pci uint32) (*entities.Cell, error)
 	// GetCellById retrieves the cell entity from redis DB by cell type and cell Id
 	GetCellById(cellType entities.Cell_Type, cellId string) (*entities.Cell, error)
+	// GetCellByCgi retrieves the cell entity from redis DB by cell type and cell global Id, an NR CGI or an ECGI
+	GetCellByCgi(cellType entities.Cell_Type, cgi string) (*entities.Cell, error)
+	// GetCellsByPlmn retrieves the cell entities serving the PLMN, LTE cells first, each sorted by cell Id
+	GetCellsByPlmn(plmnId string) ([]*entities.Cell, error)
+	// GetCellsByTac retrieves the cell entities of the tracking area, LTE cells first, each sorted by cell Id
+	GetCellsByTac(tac string) ([]*entities.Cell, error)
 	// GetListNodebIds returns the full list of Nodeb identity entities
 	GetListNodebIds() ([]*entities.NbIdentity, error)
 	// GetRanLoadInformation retrieves nodeb load information entity from redis DB by nodeb inventory name
@@ -70,6 +107,12 @@ type RNibReader interface {
 	GetGeneralConfiguration() (*entities.GeneralConfiguration, error)
 
         GetRanFunctionDefinition(inventoryName string, Oid string) ([]string, error)
+	// GetRanFunctions retrieves the RAN functions advertised by the gNodeb by nodeb inventory name
+	GetRanFunctions(inventoryName string) ([]*entities.RanFunction, error)
+	// GetRanFunctionById retrieves the RAN function advertised by the nodeb by nodeb inventory name and RAN function id
+	GetRanFunctionById(inventoryName string, ranFunctionId uint32) (*entities.RanFunction, error)
+	// FindNodebsSupportingRanFunction retrieves the nodebs advertising the RAN function OID with at least minRevision, sorted by inventory name
+	FindNodebsSupportingRanFunction(oid string, minRevision uint32) ([]*entities.NodebInfo, error)
 }
 
 //GetNewRNibReader returns reference to RNibReader
@@ -83,18 +126,21 @@ func GetNewRNibReader(storage common.ISdlSyncStorage) RNibReader {
 
 //GetRanFunctionDefinition from the OID
 func (w *rNibReaderInstance) GetRanFunctionDefinition(inventoryName string, oid string) ([]string, error){
-    nb, err := w.GetNodeb (inventoryName)
-    if (nb.GetGnb() != nil) {
-        ranFunction := nb.GetGnb().RanFunctions
-        functionDefinitionList := make([]string, 0)
-        for _, ranFunction := range ranFunction {
-            if (oid == ranFunction.RanFunctionOid) {
-                functionDefinitionList = append(functionDefinitionList ,ranFunction.RanFunctionDefinition)
-                }
-        }
-        return functionDefinitionList, err
+    return w.getRanFunctionDefinition(context.Background(), inventoryName, oid)
+}
+
+func (w *rNibReaderInstance) getRanFunctionDefinition(ctx context.Context, inventoryName string, oid string) ([]string, error){
+    ranFunctions, err := w.getRanFunctions(ctx, inventoryName)
+    if err != nil {
+        return nil, err
+    }
+    functionDefinitionList := make([]string, 0)
+    for _, ranFunction := range ranFunctions {
+        if (oid == ranFunction.RanFunctionOid) {
+            functionDefinitionList = append(functionDefinitionList ,ranFunction.RanFunctionDefinition)
+            }
     }
-    return nil, common.NewResourceNotFoundErrorf("#rNibReader.GetCellList - served cells not found. Responding node RAN name: %    s.", inventoryName)
+    return functionDefinitionList, nil
 }
 
 //GetRNibReader returns reference to RNibReader
@@ -108,34 +154,220 @@ func GetRNibReader(sdl common.ISdlInstance) RNibReader {
 }
 
 func (w *rNibReaderInstance) GetNodeb(inventoryName string) (*entities.NodebInfo, error) {
+	return w.getNodeb(context.Background(), inventoryName)
+}
+
+func (w *rNibReaderInstance) GetNodebByGlobalNbId(nodeType entities.Node_Type, globalNbId *entities.GlobalNbId, cuupid string, duid string) (*entities.NodebInfo, error) {
+	return w.getNodebByGlobalNbId(context.Background(), nodeType, globalNbId, cuupid, duid)
+}
+
+func (w *rNibReaderInstance) GetNodebs(inventoryNames []string) (map[string]*entities.NodebInfo, map[string]error, error) {
+	return w.getNodebs(context.Background(), inventoryNames)
+}
+
+func (w *rNibReaderInstance) PageNodebIds(ctx context.Context, pageSize int) NodebIdPager {
+	return w.pageNodebIds(ctx, pageSize)
+}
+
+func (w *rNibReaderInstance) FindNodebs(filter NodebFilter) ([]*entities.NodebInfo, error) {
+	_, nodebs, err := w.findNodebs(context.Background(), filter)
+	return nodebs, err
+}
+
+func (w *rNibReaderInstance) FindNodebIds(filter NodebFilter) ([]*entities.NbIdentity, error) {
+	nbIdentities, _, err := w.findNodebs(context.Background(), filter)
+	return nbIdentities, err
+}
+
+func (w *rNibReaderInstance) GetNodebsByGlobalNbIds(nodeType entities.Node_Type, globalNbIds []NodebGlobalId) (map[string]*entities.NodebInfo, map[string]error, error) {
+	return w.getNodebsByGlobalNbIds(context.Background(), nodeType, globalNbIds)
+}
+
+func (w *rNibReaderInstance) GetCellList(inventoryName string) (*entities.Cells, error) {
+	return w.getCellList(context.Background(), inventoryName)
+}
+
+func (w *rNibReaderInstance) GetListGnbIds() ([]*entities.NbIdentity, error) {
+	return w.getListNodebIdsByType(context.Background(), entities.Node_GNB.String())
+}
+
+func (w *rNibReaderInstance) GetListEnbIds() ([]*entities.NbIdentity, error) {
+	return w.getListNodebIdsByType(context.Background(), entities.Node_ENB.String())
+}
+
+func (w *rNibReaderInstance) GetCountGnbList() (int, error) {
+	return w.getCountGnbList(context.Background())
+}
+
+func (w *rNibReaderInstance) GetCountEnbList() (int, error) {
+	return w.getCountEnbList(context.Background())
+}
+
+func (w *rNibReaderInstance) GetCountNodebs() (int, error) {
+	return w.getCountNodebs(context.Background())
+}
+
+func (w *rNibReaderInstance) GetNodebStatistics() (*NodebStatistics, error) {
+	return w.getNodebStatistics(context.Background())
+}
+
+func (w *rNibReaderInstance) GetRanFunctions(inventoryName string) ([]*entities.RanFunction, error) {
+	return w.getRanFunctions(context.Background(), inventoryName)
+}
+
+func (w *rNibReaderInstance) GetRanFunctionById(inventoryName string, ranFunctionId uint32) (*entities.RanFunction, error) {
+	return w.getRanFunctionById(context.Background(), inventoryName, ranFunctionId)
+}
+
+func (w *rNibReaderInstance) FindNodebsSupportingRanFunction(oid string, minRevision uint32) ([]*entities.NodebInfo, error) {
+	return w.findNodebsSupportingRanFunction(context.Background(), oid, minRevision)
+}
+
+func (w *rNibReaderInstance) GetCell(inventoryName string, pci uint32) (*entities.Cell, error) {
+	return w.getCell(context.Background(), inventoryName, pci)
+}
+
+func (w *rNibReaderInstance) GetCellById(cellType entities.Cell_Type, cellId string) (*entities.Cell, error) {
+	return w.getCellById(context.Background(), cellType, cellId)
+}
+
+func (w *rNibReaderInstance) GetCellByCgi(cellType entities.Cell_Type, cgi string) (*entities.Cell, error) {
+	return w.getCellByCgi(context.Background(), cellType, cgi)
+}
+
+func (w *rNibReaderInstance) GetCellsByPlmn(plmnId string) ([]*entities.Cell, error) {
+	return w.getCellsByPlmn(context.Background(), plmnId)
+}
+
+func (w *rNibReaderInstance) GetCellsByTac(tac string) ([]*entities.Cell, error) {
+	return w.getCellsByTac(context.Background(), tac)
+}
+
+func (w *rNibReaderInstance) GetListNodebIds() ([]*entities.NbIdentity, error) {
+	return w.getListNodebIds(context.Background())
+}
+
+func (w *rNibReaderInstance) GetRanLoadInformation(inventoryName string) (*entities.RanLoadInformation, error) {
+	return w.getRanLoadInformation(context.Background(), inventoryName)
+}
+
+func (w *rNibReaderInstance) GetE2TInstance(address string) (*entities.E2TInstance, error) {
+	return w.getE2TInstance(context.Background(), address)
+}
+
+func (w *rNibReaderInstance) GetE2TInstances(addresses []string) ([]*entities.E2TInstance, error) {
+	return w.getE2TInstances(context.Background(), addresses)
+}
+
+func (w *rNibReaderInstance) GetE2TAddresses() ([]string, error) {
+	return w.getE2TAddresses(context.Background())
+}
+
+func (w *rNibReaderInstance) GetGeneralConfiguration() (*entities.GeneralConfiguration, error) {
+	return w.getGeneralConfiguration(context.Background())
+}
+
+func (w *rNibReaderInstance) getNodeb(ctx context.Context, inventoryName string) (*entities.NodebInfo, error) {
 	key, rNibErr := common.ValidateAndBuildNodeBNameKey(inventoryName)
 	if rNibErr != nil {
 		return nil, rNibErr
 	}
 	nbInfo := &entities.NodebInfo{}
-	err := w.getByKeyAndUnmarshal(key, nbInfo)
+	err := w.getByKeyAndUnmarshal(ctx, key, nbInfo)
 	if err != nil {
 		return nil, err
 	}
 	return nbInfo, nil
 }
 
-func (w *rNibReaderInstance) GetNodebByGlobalNbId(nodeType entities.Node_Type, globalNbId *entities.GlobalNbId, cuupid string, duid string) (*entities.NodebInfo, error) {
+func (w *rNibReaderInstance) getNodebByGlobalNbId(ctx context.Context, nodeType entities.Node_Type, globalNbId *entities.GlobalNbId, cuupid string, duid string) (*entities.NodebInfo, error) {
 	key, rNibErr := common.ValidateAndBuildNodeBIdKey(nodeType.String(), globalNbId.GetPlmnId(), globalNbId.GetNbId(), cuupid, duid)
 	if rNibErr != nil {
 		return nil, rNibErr
 	}
 	nbInfo := &entities.NodebInfo{}
-	err := w.getByKeyAndUnmarshal(key, nbInfo)
+	err := w.getByKeyAndUnmarshal(ctx, key, nbInfo)
 	if err != nil {
 		return nil, err
 	}
 	return nbInfo, nil
 }
 
-func (w *rNibReaderInstance) GetCellList(inventoryName string) (*entities.Cells, error) {
+func (w *rNibReaderInstance) getNodebs(ctx context.Context, inventoryNames []string) (map[string]*entities.NodebInfo, map[string]error, error) {
+	keys := make(map[string]string, len(inventoryNames))
+	rNibErrors := map[string]error{}
+	for _, inventoryName := range inventoryNames {
+		key, rNibErr := common.ValidateAndBuildNodeBNameKey(inventoryName)
+		if rNibErr != nil {
+			rNibErrors[inventoryName] = rNibErr
+			continue
+		}
+		keys[inventoryName] = key
+	}
+	return w.getNodebsByKeys(ctx, keys, rNibErrors)
+}
+
+func (w *rNibReaderInstance) getNodebsByGlobalNbIds(ctx context.Context, nodeType entities.Node_Type, globalNbIds []NodebGlobalId) (map[string]*entities.NodebInfo, map[string]error, error) {
+	keys := make(map[string]string, len(globalNbIds))
+	rNibErrors := map[string]error{}
+	for _, id := range globalNbIds {
+		globalNbId := id.GlobalNbId
+		key, rNibErr := common.ValidateAndBuildNodeBIdKey(nodeType.String(), globalNbId.GetPlmnId(), globalNbId.GetNbId(), id.CuupId, id.DuId)
+		if rNibErr != nil {
+			rNibErrors[buildInvalidNodebGlobalIdKey(nodeType, id)] = rNibErr
+			continue
+		}
+		keys[key] = key
+	}
+	return w.getNodebsByKeys(ctx, keys, rNibErrors)
+}
+
+// buildInvalidNodebGlobalIdKey builds the key an invalid global Id is reported under, as ValidateAndBuildNodeBIdKey would
+func buildInvalidNodebGlobalIdKey(nodeType entities.Node_Type, id NodebGlobalId) string {
+	key := fmt.Sprintf("%s:%s:%s", nodeType.String(), id.GlobalNbId.GetPlmnId(), id.GlobalNbId.GetNbId())
+	if id.CuupId != "" && id.DuId == "" {
+		return key + ":" + id.CuupId
+	}
+	if id.DuId != "" && id.CuupId == "" {
+		return key + ":" + id.DuId
+	}
+	return key
+}
+
+// getNodebsByKeys reads the nodeb entities of all the given keys at once and returns them by the ids the keys were built from
+func (w *rNibReaderInstance) getNodebsByKeys(ctx context.Context, keys map[string]string, rNibErrors map[string]error) (map[string]*entities.NodebInfo, map[string]error, error) {
+	nodebs := make(map[string]*entities.NodebInfo, len(keys))
+	if len(keys) == 0 {
+		return nodebs, rNibErrors, nil
+	}
+	sdlKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		sdlKeys = append(sdlKeys, key)
+	}
+	sort.Strings(sdlKeys)
+	data, err := w.get(ctx, sdlKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+	for id, key := range keys {
+		if data == nil || data[key] == nil {
+			rNibErrors[id] = common.NewResourceNotFoundErrorForKey("*entities.NodebInfo", key, "#rNibReader.getNodebsByKeys - entity of type *entities.NodebInfo not found. Key: %s", key)
+			continue
+		}
+		nbInfo := &entities.NodebInfo{}
+		err = proto.Unmarshal([]byte(data[key].(string)), nbInfo)
+		if err != nil {
+			rNibErrors[id] = common.NewCorruptDataError("*entities.NodebInfo", key, err)
+			continue
+		}
+		nodebs[id] = nbInfo
+	}
+	return nodebs, rNibErrors, nil
+}
+
+func (w *rNibReaderInstance) getCellList(ctx context.Context, inventoryName string) (*entities.Cells, error) {
 	cells := &entities.Cells{}
-	nb, err := w.GetNodeb(inventoryName)
+	nb, err := w.getNodeb(ctx, inventoryName)
 	if err != nil {
 		return nil, err
 	}
@@ -152,42 +384,57 @@ func (w *rNibReaderInstance) GetCellList(inventoryName string) (*entities.Cells,
 	return nil, common.NewResourceNotFoundErrorf("#rNibReader.GetCellList - served cells not found. Responding node RAN name: %s.", inventoryName)
 }
 
-func (w *rNibReaderInstance) GetListGnbIds() ([]*entities.NbIdentity, error) {
-	return w.getListNodebIdsByType(entities.Node_GNB.String())
+func (w *rNibReaderInstance) getCountGnbList(ctx context.Context) (int, error) {
+	return w.getGroupSize(ctx, entities.Node_GNB.String())
 }
 
-func (w *rNibReaderInstance) GetListEnbIds() ([]*entities.NbIdentity, error) {
-	return w.getListNodebIdsByType(entities.Node_ENB.String())
+func (w *rNibReaderInstance) getCountEnbList(ctx context.Context) (int, error) {
+	return w.getGroupSize(ctx, entities.Node_ENB.String())
 }
 
-func (w *rNibReaderInstance) GetCountGnbList() (int, error) {
-	var size int64
-	var err error
-	if w.sdlStorage != nil {
-		size, err = w.sdlStorage.GroupSize(w.ns, entities.Node_GNB.String())
-	} else {
-		size, err = w.sdl.GroupSize(entities.Node_GNB.String())
+func (w *rNibReaderInstance) getCountNodebs(ctx context.Context) (int, error) {
+	enbCount, err := w.getCountEnbList(ctx)
+	if err != nil {
+		return 0, err
 	}
+	gnbCount, err := w.getCountGnbList(ctx)
 	if err != nil {
-		return 0, common.NewInternalError(err)
+		return 0, err
+	}
+	return enbCount + gnbCount, nil
+}
+
+func (w *rNibReaderInstance) getGroupSize(ctx context.Context, group string) (int, error) {
+	var size int64
+	err := callWithContext(ctx, func() error {
+		var err error
+		if w.sdlStorage != nil {
+			size, err = w.sdlStorage.GroupSize(w.ns, group)
+		} else {
+			size, err = w.sdl.GroupSize(group)
+		}
+		return err
+	})
+	if err != nil {
+		return 0, wrapSdlError(err)
 	}
 	return int(size), nil
 }
 
-func (w *rNibReaderInstance) GetCell(inventoryName string, pci uint32) (*entities.Cell, error) {
+func (w *rNibReaderInstance) getCell(ctx context.Context, inventoryName string, pci uint32) (*entities.Cell, error) {
 	key, rNibErr := common.ValidateAndBuildCellNamePciKey(inventoryName, pci)
 	if rNibErr != nil {
 		return nil, rNibErr
 	}
 	cell := &entities.Cell{}
-	err := w.getByKeyAndUnmarshal(key, cell)
+	err := w.getByKeyAndUnmarshal(ctx, key, cell)
 	if err != nil {
 		return nil, err
 	}
 	return cell, err
 }
 
-func (w *rNibReaderInstance) GetCellById(cellType entities.Cell_Type, cellId string) (*entities.Cell, error) {
+func (w *rNibReaderInstance) getCellById(ctx context.Context, cellType entities.Cell_Type, cellId string) (*entities.Cell, error) {
 	var key string
 	var rNibErr error
 	if cellType == entities.Cell_LTE_CELL {
@@ -201,79 +448,62 @@ func (w *rNibReaderInstance) GetCellById(cellType entities.Cell_Type, cellId str
 		return nil, rNibErr
 	}
 	cell := &entities.Cell{}
-	err := w.getByKeyAndUnmarshal(key, cell)
+	err := w.getByKeyAndUnmarshal(ctx, key, cell)
 	if err != nil {
 		return nil, err
 	}
 	return cell, err
 }
 
-func (w *rNibReaderInstance) GetListNodebIds() ([]*entities.NbIdentity, error) {
-	var dataEnb, dataGnb []string
-	var err error
-	if w.sdlStorage != nil {
-		dataEnb, err = w.sdlStorage.GetMembers(w.ns, entities.Node_ENB.String())
-	} else {
-		dataEnb, err = w.sdl.GetMembers(entities.Node_ENB.String())
-	}
+func (w *rNibReaderInstance) getListNodebIds(ctx context.Context) ([]*entities.NbIdentity, error) {
+	dataEnb, err := w.getMembers(ctx, entities.Node_ENB.String())
 	if err != nil {
-		return nil, common.NewInternalError(err)
-	}
-	if w.sdlStorage != nil {
-		dataGnb, err = w.sdlStorage.GetMembers(w.ns, entities.Node_GNB.String())
-	} else {
-		dataGnb, err = w.sdl.GetMembers(entities.Node_GNB.String())
+		return nil, err
 	}
+	dataGnb, err := w.getMembers(ctx, entities.Node_GNB.String())
 	if err != nil {
-		return nil, common.NewInternalError(err)
+		return nil, err
 	}
 	allIds := append(dataEnb, dataGnb...)
 	data, rnibErr := w.unmarshalIdentityList(allIds)
 	return data, rnibErr
 }
 
-func (w *rNibReaderInstance) GetRanLoadInformation(inventoryName string) (*entities.RanLoadInformation, error) {
+func (w *rNibReaderInstance) getRanLoadInformation(ctx context.Context, inventoryName string) (*entities.RanLoadInformation, error) {
 	key, rNibErr := common.ValidateAndBuildRanLoadInformationKey(inventoryName)
 	if rNibErr != nil {
 		return nil, rNibErr
 	}
 	loadInfo := &entities.RanLoadInformation{}
-	err := w.getByKeyAndUnmarshal(key, loadInfo)
+	err := w.getByKeyAndUnmarshal(ctx, key, loadInfo)
 	if err != nil {
 		return nil, err
 	}
 	return loadInfo, err
 }
 
-func (w *rNibReaderInstance) GetE2TInstance(address string) (*entities.E2TInstance, error) {
+func (w *rNibReaderInstance) getE2TInstance(ctx context.Context, address string) (*entities.E2TInstance, error) {
 	key, rNibErr := common.ValidateAndBuildE2TInstanceKey(address)
 	if rNibErr != nil {
 		return nil, rNibErr
 	}
 	e2tInstance := &entities.E2TInstance{}
-	err := w.getByKeyAndUnmarshalJson(key, e2tInstance)
+	err := w.getByKeyAndUnmarshalJson(ctx, key, e2tInstance)
 	if err != nil {
 		return nil, err
 	}
 	return e2tInstance, err
 }
 
-func (w *rNibReaderInstance) GetE2TInstances(addresses []string) ([]*entities.E2TInstance, error) {
-	var data map[string]interface{}
-	var err error
-
+func (w *rNibReaderInstance) getE2TInstances(ctx context.Context, addresses []string) ([]*entities.E2TInstance, error) {
 	keys := common.MapE2TAddressesToKeys(addresses)
 
 	e2tInstances := []*entities.E2TInstance{}
 
-	if w.sdlStorage != nil {
-		data, err = w.sdlStorage.Get(w.ns, keys)
-	} else {
-		data, err = w.sdl.Get(keys)
-	}
+	data, err := w.get(ctx, keys)
 
 	if err != nil {
-		return []*entities.E2TInstance{}, common.NewInternalError(err)
+		return []*entities.E2TInstance{}, err
 	}
 
 	if len(data) == 0 {
@@ -296,90 +526,106 @@ func (w *rNibReaderInstance) GetE2TInstances(addresses []string) ([]*entities.E2
 	return e2tInstances, nil
 }
 
-func (w *rNibReaderInstance) GetE2TAddresses() ([]string, error) {
+func (w *rNibReaderInstance) getE2TAddresses(ctx context.Context) ([]string, error) {
 	var e2tAddresses []string
-	err := w.getByKeyAndUnmarshalJson(E2TAddressesKey, &e2tAddresses)
+	err := w.getByKeyAndUnmarshalJson(ctx, E2TAddressesKey, &e2tAddresses)
 	if err != nil {
 		return nil, err
 	}
 	return e2tAddresses, err
 }
 
-func (w *rNibReaderInstance) GetGeneralConfiguration() (*entities.GeneralConfiguration, error) {
+func (w *rNibReaderInstance) getGeneralConfiguration(ctx context.Context) (*entities.GeneralConfiguration, error) {
 	config := &entities.GeneralConfiguration{}
 	key := common.BuildGeneralConfigurationKey()
 
-	err := w.getByKeyAndUnmarshalJson(key, config)
+	err := w.getByKeyAndUnmarshalJson(ctx, key, config)
 
 	return config, err
 }
 
-func (w *rNibReaderInstance) getByKeyAndUnmarshalJson(key string, entity interface{}) error {
-	var data map[string]interface{}
-	var err error
-	if w.sdlStorage != nil {
-		data, err = w.sdlStorage.Get(w.ns, []string{key})
-	} else {
-		data, err = w.sdl.Get([]string{key})
-	}
+func (w *rNibReaderInstance) getByKeyAndUnmarshalJson(ctx context.Context, key string, entity interface{}) error {
+	data, err := w.get(ctx, []string{key})
 
 	if err != nil {
-		return common.NewInternalError(err)
+		return err
 	}
 
 	if data != nil && data[key] != nil {
 		err = json.Unmarshal([]byte(data[key].(string)), entity)
 		if err != nil {
-			return common.NewInternalError(err)
+			return common.NewCorruptDataError(reflect.TypeOf(entity).String(), key, err)
 		}
 		return nil
 	}
-	return common.NewResourceNotFoundErrorf("#rNibReader.getByKeyAndUnmarshalJson - entity of type %s not found. Key: %s", reflect.TypeOf(entity).String(), key)
+	return common.NewResourceNotFoundErrorForKey(reflect.TypeOf(entity).String(), key, "#rNibReader.getByKeyAndUnmarshalJson - entity of type %s not found. Key: %s", reflect.TypeOf(entity).String(), key)
 }
 
-func (w *rNibReaderInstance) getByKeyAndUnmarshal(key string, entity proto.Message) error {
-	var data map[string]interface{}
-	var err error
-	if w.sdlStorage != nil {
-		data, err = w.sdlStorage.Get(w.ns, []string{key})
-	} else {
-		data, err = w.sdl.Get([]string{key})
-	}
+func (w *rNibReaderInstance) getByKeyAndUnmarshal(ctx context.Context, key string, entity proto.Message) error {
+	data, err := w.get(ctx, []string{key})
 
 	if err != nil {
-		return common.NewInternalError(err)
+		return err
 	}
 	if data != nil && data[key] != nil {
 		err = proto.Unmarshal([]byte(data[key].(string)), entity)
 		if err != nil {
-			return common.NewInternalError(err)
+			return common.NewCorruptDataError(reflect.TypeOf(entity).String(), key, err)
 		}
 		return nil
 	}
-	return common.NewResourceNotFoundErrorf("#rNibReader.getByKeyAndUnmarshal - entity of type %s not found. Key: %s", reflect.TypeOf(entity).String(), key)
+	return common.NewResourceNotFoundErrorForKey(reflect.TypeOf(entity).String(), key, "#rNibReader.getByKeyAndUnmarshal - entity of type %s not found. Key: %s", reflect.TypeOf(entity).String(), key)
 }
 
-func (w *rNibReaderInstance) getListNodebIdsByType(nbType string) ([]*entities.NbIdentity, error) {
-	var data []string
-	var err error
-	if w.sdlStorage != nil {
-		data, err = w.sdlStorage.GetMembers(w.ns, nbType)
-	} else {
-		data, err = w.sdl.GetMembers(nbType)
-	}
+func (w *rNibReaderInstance) getListNodebIdsByType(ctx context.Context, nbType string) ([]*entities.NbIdentity, error) {
+	data, err := w.getMembers(ctx, nbType)
 	if err != nil {
-		return nil, common.NewInternalError(err)
+		return nil, err
 	}
 	return w.unmarshalIdentityList(data)
 }
 
+func (w *rNibReaderInstance) get(ctx context.Context, keys []string) (map[string]interface{}, error) {
+	var data map[string]interface{}
+	err := callWithContext(ctx, func() error {
+		var err error
+		if w.sdlStorage != nil {
+			data, err = w.sdlStorage.Get(w.ns, keys)
+		} else {
+			data, err = w.sdl.Get(keys)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, wrapSdlError(err)
+	}
+	return data, nil
+}
+
+func (w *rNibReaderInstance) getMembers(ctx context.Context, group string) ([]string, error) {
+	var data []string
+	err := callWithContext(ctx, func() error {
+		var err error
+		if w.sdlStorage != nil {
+			data, err = w.sdlStorage.GetMembers(w.ns, group)
+		} else {
+			data, err = w.sdl.GetMembers(group)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, wrapSdlError(err)
+	}
+	return data, nil
+}
+
 func (w *rNibReaderInstance) unmarshalIdentityList(data []string) ([]*entities.NbIdentity, error) {
 	var members []*entities.NbIdentity
 	for _, d := range data {
 		member := entities.NbIdentity{}
 		err := proto.Unmarshal([]byte(d), &member)
 		if err != nil {
-			return nil, common.NewInternalError(err)
+			return nil, common.NewCorruptDataError("*entities.NbIdentity", "", err)
 		}
 		members = append(members, &member)
 	}
@@ -667,14 +667,14 @@ func TestGetNodebByIdDeprecated(t *testing.T) {
 
 	plmnId := "02f829"
 	nbId := "4a952a0a"
-	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId)
+	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId, "", "")
 	if rNibErr != nil {
 		t.Errorf("Failed to validate nodeb identity, plmnId: %s, nbId: %s", plmnId, nbId)
 	}
 	ret := map[string]interface{}{key: string(data)}
 	sdlInstanceMock.On("Get", []string{key}).Return(ret, e)
 	globalNbId := &entities.GlobalNbId{PlmnId: plmnId, NbId: nbId}
-	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId)
+	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId, "", "")
 	assert.Nil(t, er)
 	assert.Equal(t, getNb.Ip, nb.Ip)
 	assert.Equal(t, getNb.Port, nb.Port)
@@ -688,14 +688,14 @@ func TestGetNodebByIdNotFoundFailureEnbDeprecated(t *testing.T) {
 	nbId := "4a952a0a"
 	w, sdlInstanceMock := initSdlInstanceMock()
 	var e error
-	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId)
+	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId, "", "")
 	if rNibErr != nil {
 		t.Errorf("Failed to validate nodeb identity, plmnId: %s, nbId: %s", plmnId, nbId)
 	}
 	var ret map[string]interface{}
 	sdlInstanceMock.On("Get", []string{key}).Return(ret, e)
 	globalNbId := &entities.GlobalNbId{PlmnId: plmnId, NbId: nbId}
-	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId)
+	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId, "", "")
 	assert.NotNil(t, er)
 	assert.Nil(t, getNb)
 	assert.IsType(t, &common.ResourceNotFoundError{}, er)
@@ -707,14 +707,14 @@ func TestGetNodebByIdNotFoundFailureGnbDeprecated(t *testing.T) {
 	nbId := "4a952a0a"
 	w, sdlInstanceMock := initSdlInstanceMock()
 	var e error
-	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_GNB.String(), plmnId, nbId)
+	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_GNB.String(), plmnId, nbId, "", "")
 	if rNibErr != nil {
 		t.Errorf("Failed to validate nodeb identity, plmnId: %s, nbId: %s", plmnId, nbId)
 	}
 	var ret map[string]interface{}
 	sdlInstanceMock.On("Get", []string{key}).Return(ret, e)
 	globalNbId := &entities.GlobalNbId{PlmnId: plmnId, NbId: nbId}
-	getNb, er := w.GetNodebByGlobalNbId(entities.Node_GNB, globalNbId)
+	getNb, er := w.GetNodebByGlobalNbId(entities.Node_GNB, globalNbId, "", "")
 	assert.NotNil(t, er)
 	assert.Nil(t, getNb)
 	assert.IsType(t, &common.ResourceNotFoundError{}, er)
@@ -725,7 +725,7 @@ func TestGetNodeByIdUnmarshalFailureDeprecated(t *testing.T) {
 	plmnId := "02f829"
 	nbId := "4a952a0a"
 	w, sdlInstanceMock := initSdlInstanceMock()
-	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId)
+	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId, "", "")
 	if rNibErr != nil {
 		t.Errorf("Failed to validate nodeb identity, plmnId: %s, nbId: %s", plmnId, nbId)
 	}
@@ -734,7 +734,7 @@ func TestGetNodeByIdUnmarshalFailureDeprecated(t *testing.T) {
 	ret[key] = "data"
 	sdlInstanceMock.On("Get", []string{key}).Return(ret, e)
 	globalNbId := &entities.GlobalNbId{PlmnId: plmnId, NbId: nbId}
-	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId)
+	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId, "", "")
 	assert.NotNil(t, er)
 	assert.Nil(t, getNb)
 	assert.IsType(t, &common.InternalError{}, er)
@@ -747,7 +747,7 @@ func TestGetNodeByIdSdlgoFailureDeprecated(t *testing.T) {
 	errMsg := "expected Sdlgo error"
 	errMsgExpected := "expected Sdlgo error"
 	w, sdlInstanceMock := initSdlInstanceMock()
-	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_GNB.String(), plmnId, nbId)
+	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_GNB.String(), plmnId, nbId, "", "")
 	if rNibErr != nil {
 		t.Errorf("Failed to validate nodeb identity, plmnId: %s, nbId: %s", plmnId, nbId)
 	}
@@ -755,7 +755,7 @@ func TestGetNodeByIdSdlgoFailureDeprecated(t *testing.T) {
 	var ret map[string]interface{}
 	sdlInstanceMock.On("Get", []string{key}).Return(ret, e)
 	globalNbId := &entities.GlobalNbId{PlmnId: plmnId, NbId: nbId}
-	getNb, er := w.GetNodebByGlobalNbId(entities.Node_GNB, globalNbId)
+	getNb, er := w.GetNodebByGlobalNbId(entities.Node_GNB, globalNbId, "", "")
 	assert.NotNil(t, er)
 	assert.Nil(t, getNb)
 	assert.IsType(t, &common.InternalError{}, er)
@@ -668,14 +668,14 @@ func TestGetNodebById(t *testing.T) {
 
 	plmnId := "02f829"
 	nbId := "4a952a0a"
-	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId)
+	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId, "", "")
 	if rNibErr != nil {
 		t.Errorf("Failed to validate nodeb identity, plmnId: %s, nbId: %s", plmnId, nbId)
 	}
 	ret := map[string]interface{}{key: string(data)}
 	sdlInstanceMock.On("Get", common.GetRNibNamespace(), []string{key}).Return(ret, e)
 	globalNbId := &entities.GlobalNbId{PlmnId: plmnId, NbId: nbId}
-	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId)
+	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId, "", "")
 	assert.Nil(t, er)
 	assert.Equal(t, getNb.Ip, nb.Ip)
 	assert.Equal(t, getNb.Port, nb.Port)
@@ -689,14 +689,14 @@ func TestGetNodebByIdNotFoundFailureEnb(t *testing.T) {
 	nbId := "4a952a0a"
 	w, sdlInstanceMock := initSdlSyncStorageMock()
 	var e error
-	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId)
+	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId, "", "")
 	if rNibErr != nil {
 		t.Errorf("Failed to validate nodeb identity, plmnId: %s, nbId: %s", plmnId, nbId)
 	}
 	var ret map[string]interface{}
 	sdlInstanceMock.On("Get", common.GetRNibNamespace(), []string{key}).Return(ret, e)
 	globalNbId := &entities.GlobalNbId{PlmnId: plmnId, NbId: nbId}
-	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId)
+	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId, "", "")
 	assert.NotNil(t, er)
 	assert.Nil(t, getNb)
 	assert.IsType(t, &common.ResourceNotFoundError{}, er)
@@ -708,14 +708,14 @@ func TestGetNodebByIdNotFoundFailureGnb(t *testing.T) {
 	nbId := "4a952a0a"
 	w, sdlInstanceMock := initSdlSyncStorageMock()
 	var e error
-	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_GNB.String(), plmnId, nbId)
+	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_GNB.String(), plmnId, nbId, "", "")
 	if rNibErr != nil {
 		t.Errorf("Failed to validate nodeb identity, plmnId: %s, nbId: %s", plmnId, nbId)
 	}
 	var ret map[string]interface{}
 	sdlInstanceMock.On("Get", common.GetRNibNamespace(), []string{key}).Return(ret, e)
 	globalNbId := &entities.GlobalNbId{PlmnId: plmnId, NbId: nbId}
-	getNb, er := w.GetNodebByGlobalNbId(entities.Node_GNB, globalNbId)
+	getNb, er := w.GetNodebByGlobalNbId(entities.Node_GNB, globalNbId, "", "")
 	assert.NotNil(t, er)
 	assert.Nil(t, getNb)
 	assert.IsType(t, &common.ResourceNotFoundError{}, er)
@@ -726,7 +726,7 @@ func TestGetNodeByIdUnmarshalFailure(t *testing.T) {
 	plmnId := "02f829"
 	nbId := "4a952a0a"
 	w, sdlInstanceMock := initSdlSyncStorageMock()
-	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId)
+	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_ENB.String(), plmnId, nbId, "", "")
 	if rNibErr != nil {
 		t.Errorf("Failed to validate nodeb identity, plmnId: %s, nbId: %s", plmnId, nbId)
 	}
@@ -735,7 +735,7 @@ func TestGetNodeByIdUnmarshalFailure(t *testing.T) {
 	ret[key] = "data"
 	sdlInstanceMock.On("Get", common.GetRNibNamespace(), []string{key}).Return(ret, e)
 	globalNbId := &entities.GlobalNbId{PlmnId: plmnId, NbId: nbId}
-	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId)
+	getNb, er := w.GetNodebByGlobalNbId(entities.Node_ENB, globalNbId, "", "")
 	assert.NotNil(t, er)
 	assert.Nil(t, getNb)
 	assert.IsType(t, &common.InternalError{}, er)
@@ -748,7 +748,7 @@ func TestGetNodeByIdSdlgoFailure(t *testing.T) {
 	errMsg := "expected Sdlgo error"
 	errMsgExpected := "expected Sdlgo error"
 	w, sdlInstanceMock := initSdlSyncStorageMock()
-	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_GNB.String(), plmnId, nbId)
+	key, rNibErr := common.ValidateAndBuildNodeBIdKey(entities.Node_GNB.String(), plmnId, nbId, "", "")
 	if rNibErr != nil {
 		t.Errorf("Failed to validate nodeb identity, plmnId: %s, nbId: %s", plmnId, nbId)
 	}
@@ -756,7 +756,7 @@ func TestGetNodeByIdSdlgoFailure(t *testing.T) {
 	var ret map[string]interface{}
 	sdlInstanceMock.On("Get", common.GetRNibNamespace(), []string{key}).Return(ret, e)
 	globalNbId := &entities.GlobalNbId{PlmnId: plmnId, NbId: nbId}
-	getNb, er := w.GetNodebByGlobalNbId(entities.Node_GNB, globalNbId)
+	getNb, er := w.GetNodebByGlobalNbId(entities.Node_GNB, globalNbId, "", "")
 	assert.NotNil(t, er)
 	assert.Nil(t, getNb)
 	assert.IsType(t, &common.InternalError{}, er)
@@ -1271,6 +1271,85 @@ func TestGetRanFunctionDefinition(t *testing.T) {
     assert.Nil(t, ranFuncs)
 }
 
+func TestGetRanFunctionDefinitionNodebNotFound(t *testing.T) {
+    name := "name"
+    w, sdlInstanceMock := initSdlSyncStorageMock()
+    redisKey, rNibErr := common.ValidateAndBuildNodeBNameKey(name)
+    if rNibErr != nil {
+        t.Errorf("#rNibReader_test.TestGetRanFunctionDefinitionNodebNotFound - failed to validate key parameter")
+    }
+    var e error
+    sdlInstanceMock.On("Get", common.GetRNibNamespace(), []string{redisKey}).Return(map[string]interface{}{}, e)
+    ranFuncs, er := w.GetRanFunctionDefinition(name, "1.3.6.1.4.1.1.2.2.2")
+    assert.IsType(t, &common.ResourceNotFoundError{}, er)
+    assert.Nil(t, ranFuncs)
+}
+
+func TestGetNodebs(t *testing.T) {
+	w, sdlInstanceMock := initSdlSyncStorageMock()
+	nb := entities.NodebInfo{RanName: "name1", Ip: "localhost"}
+	data, err := proto.Marshal(&nb)
+	if err != nil {
+		t.Errorf("#rNibReader_test.TestGetNodebs - Failed to marshal NodebInfo entity. Error: %v", err)
+	}
+	ret := map[string]interface{}{"RAN:name1": string(data), "RAN:name3": "data"}
+	sdlInstanceMock.On("Get", common.GetRNibNamespace(), []string{"RAN:name1", "RAN:name2", "RAN:name3"}).Return(ret, nil)
+	nodebs, rNibErrors, er := w.GetNodebs([]string{"name1", "name2", "name3", ""})
+	assert.Nil(t, er)
+	assert.Len(t, nodebs, 1)
+	assert.Equal(t, "localhost", nodebs["name1"].GetIp())
+	assert.Len(t, rNibErrors, 3)
+	assert.IsType(t, &common.ResourceNotFoundError{}, rNibErrors["name2"])
+	assert.EqualValues(t, "#rNibReader.getNodebsByKeys - entity of type *entities.NodebInfo not found. Key: RAN:name2", rNibErrors["name2"].Error())
+	assert.IsType(t, &common.InternalError{}, rNibErrors["name3"])
+	assert.IsType(t, &common.ValidationError{}, rNibErrors[""])
+	sdlInstanceMock.AssertNumberOfCalls(t, "Get", 1)
+}
+
+func TestGetNodebsNoValidNames(t *testing.T) {
+	w, sdlInstanceMock := initSdlSyncStorageMock()
+	nodebs, rNibErrors, er := w.GetNodebs([]string{""})
+	assert.Nil(t, er)
+	assert.Empty(t, nodebs)
+	assert.Len(t, rNibErrors, 1)
+	sdlInstanceMock.AssertNotCalled(t, "Get")
+}
+
+func TestGetNodebsSdlgoFailure(t *testing.T) {
+	w, sdlInstanceMock := initSdlSyncStorageMock()
+	var ret map[string]interface{}
+	sdlInstanceMock.On("Get", common.GetRNibNamespace(), []string{"RAN:name1"}).Return(ret, errors.New("expected Sdlgo error"))
+	nodebs, rNibErrors, er := w.GetNodebs([]string{"name1"})
+	assert.Nil(t, nodebs)
+	assert.Nil(t, rNibErrors)
+	assert.IsType(t, &common.InternalError{}, er)
+}
+
+func TestGetNodebsByGlobalNbIds(t *testing.T) {
+	w, sdlInstanceMock := initSdlSyncStorageMock()
+	nb := entities.NodebInfo{RanName: "name1", GlobalNbId: &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"}}
+	data, err := proto.Marshal(&nb)
+	if err != nil {
+		t.Errorf("#rNibReader_test.TestGetNodebsByGlobalNbIds - Failed to marshal NodebInfo entity. Error: %v", err)
+	}
+	ret := map[string]interface{}{"GNB:02f829:4a952a0a": string(data), "GNB:02f829:4a952a0a:2": string(data)}
+	sdlInstanceMock.On("Get", common.GetRNibNamespace(), []string{"GNB:02f829:4a952a0a", "GNB:02f829:4a952a0a:2", "GNB:02f829:4a952a0b"}).Return(ret, nil)
+	globalNbIds := []NodebGlobalId{
+		{GlobalNbId: &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"}},
+		{GlobalNbId: &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"}, DuId: "2"},
+		{GlobalNbId: &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0b"}},
+		{GlobalNbId: &entities.GlobalNbId{NbId: "4a952a0c"}, CuupId: "1"},
+	}
+	nodebs, rNibErrors, er := w.GetNodebsByGlobalNbIds(entities.Node_GNB, globalNbIds)
+	assert.Nil(t, er)
+	assert.Len(t, nodebs, 2)
+	assert.Equal(t, "name1", nodebs["GNB:02f829:4a952a0a"].GetRanName())
+	assert.Equal(t, "name1", nodebs["GNB:02f829:4a952a0a:2"].GetRanName())
+	assert.Len(t, rNibErrors, 2)
+	assert.IsType(t, &common.ResourceNotFoundError{}, rNibErrors["GNB:02f829:4a952a0b"])
+	assert.IsType(t, &common.ValidationError{}, rNibErrors["GNB::4a952a0c:1"])
+}
+
 //integration tests
 //
 //func TestGetEnbInteg(t *testing.T){
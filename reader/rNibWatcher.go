@@ -0,0 +1,224 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"context"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"sync"
+)
+
+const defaultWatchBufferSize = 100
+
+type NodebEventKind string
+
+const (
+	NodebAdded        NodebEventKind = common.RanAddedEvent
+	NodebUpdated      NodebEventKind = common.RanUpdatedEvent
+	NodebDeleted      NodebEventKind = common.RanDeletedEvent
+	NodebConnected    NodebEventKind = common.RanConnectedEvent
+	NodebDisconnected NodebEventKind = common.RanDisconnectedEvent
+)
+
+/*
+NodebEvent is a change of a nodeb published on one of the RAN channels.
+Nodeb holds the nodeb entity read after the event when WatchOptions.FetchNodeb is set, and Err the error of that read.
+*/
+type NodebEvent struct {
+	Channel       string
+	InventoryName string
+	Kind          NodebEventKind
+	Nodeb         *entities.NodebInfo
+	Err           error
+}
+
+/*
+WatchOptions configures Watch. Channels defaults to the RAN manipulation and state change channels.
+*/
+type WatchOptions struct {
+	Channels   []string
+	FetchNodeb bool
+	BufferSize int
+}
+
+/*
+channelSubscriptions shares one SDL subscription per storage, namespace and channel between the watches of the channel,
+since SDL UnsubscribeChannel removes every callback of the channel. Subscribing and unsubscribing are serialized by mutex,
+while the dispatch of the events only reads the callbacks, so that a storage waiting for its pending deliveries on
+UnsubscribeChannel cannot deadlock.
+*/
+type channelSubscriptions struct {
+	mutex          sync.Mutex
+	callbacksMutex sync.RWMutex
+	nextId         int
+	callbacks      map[subscriptionKey]map[int]func(string, ...string)
+}
+
+type subscriptionKey struct {
+	storage common.ISdlSyncStorage
+	ns      string
+	channel string
+}
+
+var watchSubscriptions = &channelSubscriptions{callbacks: map[subscriptionKey]map[int]func(string, ...string){}}
+
+type rawRanEvent struct {
+	channel string
+	event   string
+}
+
+/*
+Watch subscribes to the RAN channels and delivers the published nodeb events until the context is done.
+The returned channel is closed once the context is done and the subscription has been removed.
+Watches of the same storage share its channel subscriptions, the channels are unsubscribed when the last one is done.
+*/
+func Watch(ctx context.Context, storage common.ISdlSyncStorage, options WatchOptions) (<-chan *NodebEvent, error) {
+	channels := options.Channels
+	if len(channels) == 0 {
+		channels = []string{common.RanManipulationMessageChannel, common.StateChangeMessageChannel}
+	}
+	bufferSize := options.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultWatchBufferSize
+	}
+	ns := common.GetRNibNamespace()
+	rawEvents := make(chan rawRanEvent, bufferSize)
+	cb := func(channel string, events ...string) {
+		for _, event := range events {
+			select {
+			case rawEvents <- rawRanEvent{channel: channel, event: event}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+	unsubscribe, err := watchSubscriptions.subscribe(storage, ns, cb, channels)
+	if err != nil {
+		return nil, common.NewStorageUnavailableError(err)
+	}
+
+	reader := &rNibReaderInstance{sdlStorage: storage, ns: ns}
+	nodebEvents := make(chan *NodebEvent, bufferSize)
+	go func() {
+		defer close(nodebEvents)
+		defer unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case raw := <-rawEvents:
+				nodebEvent := buildNodebEvent(ctx, reader, raw, options.FetchNodeb)
+				if nodebEvent == nil {
+					continue
+				}
+				select {
+				case nodebEvents <- nodebEvent:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return nodebEvents, nil
+}
+
+func buildNodebEvent(ctx context.Context, reader *rNibReaderInstance, raw rawRanEvent, fetchNodeb bool) *NodebEvent {
+	inventoryName, kind, err := common.ParseRanEvent(raw.event)
+	if err != nil {
+		return nil
+	}
+	nodebEvent := &NodebEvent{
+		Channel:       raw.channel,
+		InventoryName: inventoryName,
+		Kind:          NodebEventKind(kind),
+	}
+	if fetchNodeb && nodebEvent.Kind != NodebDeleted {
+		nodebEvent.Nodeb, nodebEvent.Err = reader.getNodeb(ctx, inventoryName)
+	}
+	return nodebEvent
+}
+
+// subscribe adds the callback to the channels, subscribing the ones no watch uses yet, and returns its removal
+func (s *channelSubscriptions) subscribe(storage common.ISdlSyncStorage, ns string, cb func(string, ...string), channels []string) (func(), error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var unsubscribed []string
+	s.callbacksMutex.RLock()
+	for _, channel := range channels {
+		if len(s.callbacks[subscriptionKey{storage: storage, ns: ns, channel: channel}]) == 0 {
+			unsubscribed = append(unsubscribed, channel)
+		}
+	}
+	s.callbacksMutex.RUnlock()
+	if len(unsubscribed) > 0 {
+		err := storage.SubscribeChannel(ns, s.dispatcher(storage, ns), unsubscribed...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.callbacksMutex.Lock()
+	s.nextId++
+	id := s.nextId
+	for _, channel := range channels {
+		key := subscriptionKey{storage: storage, ns: ns, channel: channel}
+		if s.callbacks[key] == nil {
+			s.callbacks[key] = map[int]func(string, ...string){}
+		}
+		s.callbacks[key][id] = cb
+	}
+	s.callbacksMutex.Unlock()
+	return func() {
+		s.unsubscribe(storage, ns, id, channels)
+	}, nil
+}
+
+func (s *channelSubscriptions) unsubscribe(storage common.ISdlSyncStorage, ns string, id int, channels []string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var unused []string
+	s.callbacksMutex.Lock()
+	for _, channel := range channels {
+		key := subscriptionKey{storage: storage, ns: ns, channel: channel}
+		delete(s.callbacks[key], id)
+		if len(s.callbacks[key]) == 0 {
+			delete(s.callbacks, key)
+			unused = append(unused, channel)
+		}
+	}
+	s.callbacksMutex.Unlock()
+	if len(unused) > 0 {
+		_ = storage.UnsubscribeChannel(ns, unused...)
+	}
+}
+
+func (s *channelSubscriptions) dispatcher(storage common.ISdlSyncStorage, ns string) func(string, ...string) {
+	return func(channel string, events ...string) {
+		s.callbacksMutex.RLock()
+		callbacks := make([]func(string, ...string), 0, len(s.callbacks[subscriptionKey{storage: storage, ns: ns, channel: channel}]))
+		for _, cb := range s.callbacks[subscriptionKey{storage: storage, ns: ns, channel: channel}] {
+			callbacks = append(callbacks, cb)
+		}
+		s.callbacksMutex.RUnlock()
+		for _, cb := range callbacks {
+			cb(channel, events...)
+		}
+	}
+}
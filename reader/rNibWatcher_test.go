@@ -0,0 +1,171 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package reader
+
+import (
+	"context"
+	"errors"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var defaultWatchChannels = []string{common.RanManipulationMessageChannel, common.StateChangeMessageChannel}
+
+func initWatch(t *testing.T, ctx context.Context, options WatchOptions) (<-chan *NodebEvent, *MockSdlSyncStorage, chan func(string, ...string)) {
+	sdlStorageMock := new(MockSdlSyncStorage)
+	callbacks := make(chan func(string, ...string), 1)
+	sdlStorageMock.On("SubscribeChannel", common.GetRNibNamespace(), mock.Anything, defaultWatchChannels).Run(func(args mock.Arguments) {
+		callbacks <- args.Get(1).(func(string, ...string))
+	}).Return(nil)
+	sdlStorageMock.On("UnsubscribeChannel", common.GetRNibNamespace(), defaultWatchChannels).Return(nil)
+	events, err := Watch(ctx, sdlStorageMock, options)
+	assert.Nil(t, err)
+	return events, sdlStorageMock, callbacks
+}
+
+func receiveNodebEvent(t *testing.T, events <-chan *NodebEvent) *NodebEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("#rNibWatcher_test.receiveNodebEvent - no event received")
+	}
+	return nil
+}
+
+func TestWatchDeliversEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, _, callbacks := initWatch(t, ctx, WatchOptions{})
+	cb := <-callbacks
+	cb(common.StateChangeMessageChannel, "gnb_734_733_b5c67788_CONNECTED")
+	cb(common.RanManipulationMessageChannel, "invalid", "enb_1_DELETED")
+
+	event := receiveNodebEvent(t, events)
+	assert.Equal(t, common.StateChangeMessageChannel, event.Channel)
+	assert.Equal(t, "gnb_734_733_b5c67788", event.InventoryName)
+	assert.Equal(t, NodebConnected, event.Kind)
+	assert.Nil(t, event.Nodeb)
+
+	event = receiveNodebEvent(t, events)
+	assert.Equal(t, "enb_1", event.InventoryName)
+	assert.Equal(t, NodebDeleted, event.Kind)
+}
+
+func TestWatchFetchesNodeb(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, sdlStorageMock, callbacks := initWatch(t, ctx, WatchOptions{FetchNodeb: true})
+	nb := entities.NodebInfo{RanName: "name", ConnectionStatus: entities.ConnectionStatus_DISCONNECTED}
+	data, err := proto.Marshal(&nb)
+	if err != nil {
+		t.Errorf("#rNibWatcher_test.TestWatchFetchesNodeb - Failed to marshal NodebInfo entity. Error: %v", err)
+	}
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"RAN:name"}).Return(map[string]interface{}{"RAN:name": string(data)}, nil)
+	cb := <-callbacks
+	cb(common.StateChangeMessageChannel, "name_DISCONNECTED")
+
+	event := receiveNodebEvent(t, events)
+	assert.Equal(t, NodebDisconnected, event.Kind)
+	assert.Nil(t, event.Err)
+	assert.Equal(t, entities.ConnectionStatus_DISCONNECTED, event.Nodeb.GetConnectionStatus())
+}
+
+func TestWatchFetchNodebFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, sdlStorageMock, callbacks := initWatch(t, ctx, WatchOptions{FetchNodeb: true})
+	var ret map[string]interface{}
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"RAN:name"}).Return(ret, nil)
+	cb := <-callbacks
+	cb(common.RanManipulationMessageChannel, "name_UPDATED")
+
+	event := receiveNodebEvent(t, events)
+	assert.Equal(t, NodebUpdated, event.Kind)
+	assert.Nil(t, event.Nodeb)
+	assert.IsType(t, &common.ResourceNotFoundError{}, event.Err)
+}
+
+func TestWatchUnsubscribesWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	events, sdlStorageMock, _ := initWatch(t, ctx, WatchOptions{})
+	cancel()
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("#rNibWatcher_test.TestWatchUnsubscribesWhenContextDone - events channel not closed")
+	}
+	sdlStorageMock.AssertCalled(t, "UnsubscribeChannel", common.GetRNibNamespace(), defaultWatchChannels)
+}
+
+func TestWatchSubscribeFailure(t *testing.T) {
+	sdlStorageMock := new(MockSdlSyncStorage)
+	sdlStorageMock.On("SubscribeChannel", common.GetRNibNamespace(), mock.Anything, []string{"CHANNEL"}).Return(errors.New("expected error"))
+	events, err := Watch(context.Background(), sdlStorageMock, WatchOptions{Channels: []string{"CHANNEL"}})
+	assert.Nil(t, events)
+	assert.IsType(t, &common.InternalError{}, err)
+}
+
+func TestWatchInMemoryStorage(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := Watch(ctx, storage, WatchOptions{FetchNodeb: true})
+	assert.Nil(t, err)
+	nb := entities.NodebInfo{RanName: "name", ConnectionStatus: entities.ConnectionStatus_CONNECTED}
+	data, err := proto.Marshal(&nb)
+	if err != nil {
+		t.Errorf("#rNibWatcher_test.TestWatchInMemoryStorage - Failed to marshal NodebInfo entity. Error: %v", err)
+	}
+	err = storage.SetAndPublish(common.GetRNibNamespace(), []string{common.StateChangeMessageChannel, "name_CONNECTED"}, "RAN:name", data)
+	assert.Nil(t, err)
+
+	event := receiveNodebEvent(t, events)
+	assert.Equal(t, NodebConnected, event.Kind)
+	assert.Nil(t, event.Err)
+	assert.Equal(t, entities.ConnectionStatus_CONNECTED, event.Nodeb.GetConnectionStatus())
+}
+
+func TestWatchesShareSubscription(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	first, err := Watch(ctx, storage, WatchOptions{})
+	assert.Nil(t, err)
+	secondCtx, secondCancel := context.WithCancel(context.Background())
+	second, err := Watch(secondCtx, storage, WatchOptions{})
+	assert.Nil(t, err)
+
+	assert.Nil(t, storage.SetAndPublish(common.GetRNibNamespace(), []string{common.StateChangeMessageChannel, "name_CONNECTED"}, "key", "data"))
+	assert.Equal(t, NodebConnected, receiveNodebEvent(t, first).Kind)
+	assert.Equal(t, NodebConnected, receiveNodebEvent(t, second).Kind)
+
+	secondCancel()
+	for range second {
+	}
+	assert.Nil(t, storage.SetAndPublish(common.GetRNibNamespace(), []string{common.StateChangeMessageChannel, "name_DISCONNECTED"}, "key", "data"))
+	assert.Equal(t, NodebDisconnected, receiveNodebEvent(t, first).Kind)
+}
@@ -0,0 +1,82 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package reader
+
+import (
+	"context"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+)
+
+/*
+getRanFunctions returns the RAN functions advertised by the gNodeb saved under inventoryName.
+Nodebs without a gNodeb configuration yield a ResourceNotFoundError, since only the gNodeb configuration holds RAN functions.
+*/
+func (w *rNibReaderInstance) getRanFunctions(ctx context.Context, inventoryName string) ([]*entities.RanFunction, error) {
+	nodeb, err := w.getNodeb(ctx, inventoryName)
+	if err != nil {
+		return nil, err
+	}
+	if nodeb.GetGnb() != nil {
+		return nodeb.GetGnb().GetRanFunctions(), nil
+	}
+	return nil, common.NewResourceNotFoundErrorf("#rNibReader.getRanFunctions - ran functions not found. Responding node RAN name: %s.", inventoryName)
+}
+
+func (w *rNibReaderInstance) getRanFunctionById(ctx context.Context, inventoryName string, ranFunctionId uint32) (*entities.RanFunction, error) {
+	ranFunctions, err := w.getRanFunctions(ctx, inventoryName)
+	if err != nil {
+		return nil, err
+	}
+	for _, ranFunction := range ranFunctions {
+		if ranFunction.GetRanFunctionId() == ranFunctionId {
+			return ranFunction, nil
+		}
+	}
+	return nil, common.NewResourceNotFoundErrorForKey("*entities.RanFunction", inventoryName, "#rNibReader.getRanFunctionById - ran function %d not found. Responding node RAN name: %s.", ranFunctionId, inventoryName)
+}
+
+/*
+findNodebsSupportingRanFunction returns the nodebs, sorted by inventory name, advertising a RAN function
+with the given OID whose revision is at least minRevision.
+*/
+func (w *rNibReaderInstance) findNodebsSupportingRanFunction(ctx context.Context, oid string, minRevision uint32) ([]*entities.NodebInfo, error) {
+	_, nodebs, err := w.findNodebs(ctx, NodebFilter{})
+	if err != nil {
+		return nil, err
+	}
+	supportingNodebs := make([]*entities.NodebInfo, 0)
+	for _, nodeb := range nodebs {
+		if supportsRanFunction(nodeb, oid, minRevision) {
+			supportingNodebs = append(supportingNodebs, nodeb)
+		}
+	}
+	return supportingNodebs, nil
+}
+
+func supportsRanFunction(nodeb *entities.NodebInfo, oid string, minRevision uint32) bool {
+	for _, ranFunction := range nodeb.GetGnb().GetRanFunctions() {
+		if ranFunction.GetRanFunctionOid() == oid && ranFunction.GetRanFunctionRevision() >= minRevision {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package reader
+
+import (
+	"context"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const (
+	kpmOid = "1.3.6.1.4.1.53148.1.2.2.2"
+	rcOid  = "1.3.6.1.4.1.53148.1.1.2.3"
+)
+
+func initRanFunctions(t *testing.T) (RNibReader, common.ISdlSyncStorage) {
+	storage := common.NewInMemorySdlSyncStorage()
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:  "gnb_1",
+		NodeType: entities.Node_GNB,
+		Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{RanFunctions: []*entities.RanFunction{
+			{RanFunctionId: 2, RanFunctionOid: kpmOid, RanFunctionRevision: 2, RanFunctionDefinition: "kpm v2"},
+			{RanFunctionId: 3, RanFunctionOid: rcOid, RanFunctionRevision: 1, RanFunctionDefinition: "rc v1"},
+		}}},
+	})
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:  "gnb_2",
+		NodeType: entities.Node_GNB,
+		Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{RanFunctions: []*entities.RanFunction{
+			{RanFunctionId: 2, RanFunctionOid: kpmOid, RanFunctionRevision: 1, RanFunctionDefinition: "kpm v1"},
+		}}},
+	})
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:       "enb_1",
+		NodeType:      entities.Node_ENB,
+		Configuration: &entities.NodebInfo_Enb{Enb: &entities.Enb{}},
+	})
+	saveNodebForFilter(t, storage, &entities.NodebInfo{
+		RanName:  "enb_2",
+		NodeType: entities.Node_ENB,
+	})
+	return GetNewRNibReader(storage), storage
+}
+
+func TestGetRanFunctions(t *testing.T) {
+	w, _ := initRanFunctions(t)
+	ranFunctions, err := w.GetRanFunctions("gnb_1")
+	assert.Nil(t, err)
+	assert.Len(t, ranFunctions, 2)
+	assert.Equal(t, kpmOid, ranFunctions[0].GetRanFunctionOid())
+}
+
+func TestGetRanFunctionsNodebWithoutGnbConfiguration(t *testing.T) {
+	w, _ := initRanFunctions(t)
+	for _, name := range []string{"enb_1", "enb_2"} {
+		ranFunctions, err := w.GetRanFunctions(name)
+		assert.Nil(t, ranFunctions)
+		assert.IsType(t, &common.ResourceNotFoundError{}, err)
+	}
+}
+
+func TestGetRanFunctionsNodebNotFound(t *testing.T) {
+	w, _ := initRanFunctions(t)
+	ranFunctions, err := w.GetRanFunctions("gnb_3")
+	assert.Nil(t, ranFunctions)
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+}
+
+func TestGetRanFunctionsValidationError(t *testing.T) {
+	w, _ := initRanFunctions(t)
+	ranFunctions, err := w.GetRanFunctions("")
+	assert.Nil(t, ranFunctions)
+	assert.IsType(t, &common.ValidationError{}, err)
+}
+
+func TestGetRanFunctionById(t *testing.T) {
+	w, _ := initRanFunctions(t)
+	ranFunction, err := w.GetRanFunctionById("gnb_1", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "rc v1", ranFunction.GetRanFunctionDefinition())
+}
+
+func TestGetRanFunctionByIdNotFound(t *testing.T) {
+	w, _ := initRanFunctions(t)
+	ranFunction, err := w.GetRanFunctionById("gnb_1", 7)
+	assert.Nil(t, ranFunction)
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+	assert.Equal(t, "#rNibReader.getRanFunctionById - ran function 7 not found. Responding node RAN name: gnb_1.", err.Error())
+}
+
+func TestFindNodebsSupportingRanFunction(t *testing.T) {
+	w, _ := initRanFunctions(t)
+	tests := []struct {
+		name        string
+		oid         string
+		minRevision uint32
+		expected    []string
+	}{
+		{name: "any revision", oid: kpmOid, expected: []string{"gnb_1", "gnb_2"}},
+		{name: "min revision", oid: kpmOid, minRevision: 2, expected: []string{"gnb_1"}},
+		{name: "revision too high", oid: kpmOid, minRevision: 4, expected: []string{}},
+		{name: "other oid", oid: rcOid, minRevision: 1, expected: []string{"gnb_1"}},
+		{name: "unknown oid", oid: "1.2.3", expected: []string{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			nodebs, err := w.FindNodebsSupportingRanFunction(tc.oid, tc.minRevision)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, nodebNames(nodebs))
+		})
+	}
+}
+
+func TestContextFindNodebsSupportingRanFunction(t *testing.T) {
+	_, storage := initRanFunctions(t)
+	w := GetNewContextRNibReader(storage)
+	nodebs, err := w.FindNodebsSupportingRanFunction(context.Background(), rcOid, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"gnb_1"}, nodebNames(nodebs))
+	ranFunction, err := w.GetRanFunctionById(context.Background(), "gnb_2", 2)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, ranFunction.GetRanFunctionRevision())
+}
+
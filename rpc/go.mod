@@ -0,0 +1,32 @@
+module gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/rpc
+
+go 1.17
+
+require (
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader v1.2.7
+	gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer v1.2.7
+	github.com/golang/protobuf v1.4.2
+	github.com/stretchr/testify v1.3.0
+	google.golang.org/grpc v1.32.0
+	google.golang.org/protobuf v1.23.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/stretchr/objx v0.1.0 // indirect
+	golang.org/x/net v0.0.0-20190311183353-d8887717615a // indirect
+	golang.org/x/sys v0.0.0-20190215142949-d0b11bdaac8a // indirect
+	golang.org/x/text v0.3.0 // indirect
+	google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55 // indirect
+)
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common => ../common
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities => ../entities
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader => ../reader
+
+replace gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer => ../writer
@@ -0,0 +1,87 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package rpc
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"io"
+)
+
+/*
+nodebIdPager is the client side of the PageNodebIds stream.
+The first message of the stream holds only the total, each following one is a page.
+*/
+type nodebIdPager struct {
+	stream  RNibService_PageNodebIdsClient
+	started bool
+	total   int
+	page    []*entities.NbIdentity
+	err     error
+}
+
+func (p *nodebIdPager) start() error {
+	if p.err != nil || p.started {
+		return p.err
+	}
+	p.started = true
+	first, err := p.stream.Recv()
+	if err != nil {
+		p.err = fromStatusError(err)
+		return p.err
+	}
+	p.total = int(first.GetTotal())
+	return nil
+}
+
+func (p *nodebIdPager) Next() bool {
+	p.page = nil
+	if p.start() != nil {
+		return false
+	}
+	for {
+		page, err := p.stream.Recv()
+		if err == io.EOF {
+			return false
+		}
+		if err != nil {
+			p.err = fromStatusError(err)
+			return false
+		}
+		if len(page.GetNbIdentities()) > 0 {
+			p.page = page.GetNbIdentities()
+			return true
+		}
+	}
+}
+
+func (p *nodebIdPager) Page() []*entities.NbIdentity {
+	return p.page
+}
+
+func (p *nodebIdPager) Err() error {
+	return p.err
+}
+
+func (p *nodebIdPager) Total() (int, error) {
+	if err := p.start(); err != nil {
+		return 0, err
+	}
+	return p.total, nil
+}
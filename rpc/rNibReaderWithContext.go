@@ -0,0 +1,236 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package rpc
+
+import (
+	"context"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+)
+
+/*
+rNibReaderWithContext serves a ContextRNibReader from a RNibReader. The reads do not take the context,
+it is only checked before each of them.
+*/
+type rNibReaderWithContext struct {
+	reader reader.RNibReader
+}
+
+func (r *rNibReaderWithContext) PageNodebIds(ctx context.Context, pageSize int) reader.NodebIdPager {
+	return r.reader.PageNodebIds(ctx, pageSize)
+}
+
+func (r *rNibReaderWithContext) GetNodeb(ctx context.Context, inventoryName string) (*entities.NodebInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetNodeb(inventoryName)
+}
+
+func (r *rNibReaderWithContext) GetNodebByGlobalNbId(ctx context.Context, nodeType entities.Node_Type, globalNbId *entities.GlobalNbId, cuupId string, duid string) (*entities.NodebInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetNodebByGlobalNbId(nodeType, globalNbId, cuupId, duid)
+}
+
+func (r *rNibReaderWithContext) GetNodebs(ctx context.Context, inventoryNames []string) (map[string]*entities.NodebInfo, map[string]error, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, common.NewContextError(err)
+	}
+	return r.reader.GetNodebs(inventoryNames)
+}
+
+func (r *rNibReaderWithContext) GetNodebsByGlobalNbIds(ctx context.Context, nodeType entities.Node_Type, globalNbIds []reader.NodebGlobalId) (map[string]*entities.NodebInfo, map[string]error, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, common.NewContextError(err)
+	}
+	return r.reader.GetNodebsByGlobalNbIds(nodeType, globalNbIds)
+}
+
+func (r *rNibReaderWithContext) FindNodebs(ctx context.Context, filter reader.NodebFilter) ([]*entities.NodebInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.FindNodebs(filter)
+}
+
+func (r *rNibReaderWithContext) FindNodebIds(ctx context.Context, filter reader.NodebFilter) ([]*entities.NbIdentity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.FindNodebIds(filter)
+}
+
+func (r *rNibReaderWithContext) GetCellList(ctx context.Context, inventoryName string) (*entities.Cells, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetCellList(inventoryName)
+}
+
+func (r *rNibReaderWithContext) GetListGnbIds(ctx context.Context) ([]*entities.NbIdentity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetListGnbIds()
+}
+
+func (r *rNibReaderWithContext) GetListEnbIds(ctx context.Context) ([]*entities.NbIdentity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetListEnbIds()
+}
+
+func (r *rNibReaderWithContext) GetCountGnbList(ctx context.Context) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, common.NewContextError(err)
+	}
+	return r.reader.GetCountGnbList()
+}
+
+func (r *rNibReaderWithContext) GetCountEnbList(ctx context.Context) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, common.NewContextError(err)
+	}
+	return r.reader.GetCountEnbList()
+}
+
+func (r *rNibReaderWithContext) GetCountNodebs(ctx context.Context) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, common.NewContextError(err)
+	}
+	return r.reader.GetCountNodebs()
+}
+
+func (r *rNibReaderWithContext) GetNodebStatistics(ctx context.Context) (*reader.NodebStatistics, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetNodebStatistics()
+}
+
+func (r *rNibReaderWithContext) GetCell(ctx context.Context, inventoryName string, pci uint32) (*entities.Cell, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetCell(inventoryName, pci)
+}
+
+func (r *rNibReaderWithContext) GetCellById(ctx context.Context, cellType entities.Cell_Type, cellId string) (*entities.Cell, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetCellById(cellType, cellId)
+}
+
+func (r *rNibReaderWithContext) GetCellByCgi(ctx context.Context, cellType entities.Cell_Type, cgi string) (*entities.Cell, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetCellByCgi(cellType, cgi)
+}
+
+func (r *rNibReaderWithContext) GetCellsByPlmn(ctx context.Context, plmnId string) ([]*entities.Cell, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetCellsByPlmn(plmnId)
+}
+
+func (r *rNibReaderWithContext) GetCellsByTac(ctx context.Context, tac string) ([]*entities.Cell, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetCellsByTac(tac)
+}
+
+func (r *rNibReaderWithContext) GetListNodebIds(ctx context.Context) ([]*entities.NbIdentity, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetListNodebIds()
+}
+
+func (r *rNibReaderWithContext) GetRanLoadInformation(ctx context.Context, inventoryName string) (*entities.RanLoadInformation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetRanLoadInformation(inventoryName)
+}
+
+func (r *rNibReaderWithContext) GetE2TInstance(ctx context.Context, address string) (*entities.E2TInstance, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetE2TInstance(address)
+}
+
+func (r *rNibReaderWithContext) GetE2TInstances(ctx context.Context, addresses []string) ([]*entities.E2TInstance, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetE2TInstances(addresses)
+}
+
+func (r *rNibReaderWithContext) GetE2TAddresses(ctx context.Context) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetE2TAddresses()
+}
+
+func (r *rNibReaderWithContext) GetGeneralConfiguration(ctx context.Context) (*entities.GeneralConfiguration, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetGeneralConfiguration()
+}
+
+func (r *rNibReaderWithContext) GetRanFunctionDefinition(ctx context.Context, inventoryName string, Oid string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetRanFunctionDefinition(inventoryName, Oid)
+}
+
+func (r *rNibReaderWithContext) GetRanFunctions(ctx context.Context, inventoryName string) ([]*entities.RanFunction, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetRanFunctions(inventoryName)
+}
+
+func (r *rNibReaderWithContext) GetRanFunctionById(ctx context.Context, inventoryName string, ranFunctionId uint32) (*entities.RanFunction, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.GetRanFunctionById(inventoryName, ranFunctionId)
+}
+
+func (r *rNibReaderWithContext) FindNodebsSupportingRanFunction(ctx context.Context, oid string, minRevision uint32) ([]*entities.NodebInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, common.NewContextError(err)
+	}
+	return r.reader.FindNodebsSupportingRanFunction(oid, minRevision)
+}
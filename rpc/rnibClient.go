@@ -0,0 +1,587 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+)
+
+const defaultWatchBufferSize = 100
+
+/*
+RNibClient is an RNibReader backed by a remote RNibService.
+The gRPC statuses returned by the service are converted back to the rNib error types.
+*/
+type RNibClient interface {
+	reader.RNibReader
+	// WatchNodebs streams the nodeb events of the remote R-NIB until the context is done
+	WatchNodebs(ctx context.Context, options reader.WatchOptions) (<-chan *reader.NodebEvent, error)
+}
+
+/*
+ContextRNibClient is a ContextRNibReader backed by a remote RNibService.
+The context of each call is the context of its gRPC call, so that a call given up by the client is abandoned by the server.
+*/
+type ContextRNibClient interface {
+	reader.ContextRNibReader
+	// WatchNodebs streams the nodeb events of the remote R-NIB until the context is done
+	WatchNodebs(ctx context.Context, options reader.WatchOptions) (<-chan *reader.NodebEvent, error)
+}
+
+type rNibClientInstance struct {
+	contextClient *contextRNibClientInstance
+}
+
+type contextRNibClientInstance struct {
+	client RNibServiceClient
+}
+
+//GetNewRNibClient returns reference to RNibClient
+func GetNewRNibClient(conn grpc.ClientConnInterface) RNibClient {
+	return &rNibClientInstance{
+		contextClient: &contextRNibClientInstance{client: NewRNibServiceClient(conn)},
+	}
+}
+
+//GetNewContextRNibClient returns reference to ContextRNibClient
+func GetNewContextRNibClient(conn grpc.ClientConnInterface) ContextRNibClient {
+	return &contextRNibClientInstance{
+		client: NewRNibServiceClient(conn),
+	}
+}
+
+func (c *rNibClientInstance) GetNodeb(inventoryName string) (*entities.NodebInfo, error) {
+	return c.contextClient.GetNodeb(context.Background(), inventoryName)
+}
+
+func (c *rNibClientInstance) GetNodebByGlobalNbId(nodeType entities.Node_Type, globalNbId *entities.GlobalNbId, cuupId string, duid string) (*entities.NodebInfo, error) {
+	return c.contextClient.GetNodebByGlobalNbId(context.Background(), nodeType, globalNbId, cuupId, duid)
+}
+
+func (c *rNibClientInstance) GetNodebs(inventoryNames []string) (map[string]*entities.NodebInfo, map[string]error, error) {
+	return c.contextClient.GetNodebs(context.Background(), inventoryNames)
+}
+
+func (c *rNibClientInstance) GetNodebsByGlobalNbIds(nodeType entities.Node_Type, globalNbIds []reader.NodebGlobalId) (map[string]*entities.NodebInfo, map[string]error, error) {
+	return c.contextClient.GetNodebsByGlobalNbIds(context.Background(), nodeType, globalNbIds)
+}
+
+func (c *rNibClientInstance) FindNodebs(filter reader.NodebFilter) ([]*entities.NodebInfo, error) {
+	return c.contextClient.FindNodebs(context.Background(), filter)
+}
+
+func (c *rNibClientInstance) FindNodebIds(filter reader.NodebFilter) ([]*entities.NbIdentity, error) {
+	return c.contextClient.FindNodebIds(context.Background(), filter)
+}
+
+func (c *rNibClientInstance) GetCellList(inventoryName string) (*entities.Cells, error) {
+	return c.contextClient.GetCellList(context.Background(), inventoryName)
+}
+
+func (c *rNibClientInstance) GetListGnbIds() ([]*entities.NbIdentity, error) {
+	return c.contextClient.GetListGnbIds(context.Background())
+}
+
+func (c *rNibClientInstance) GetListEnbIds() ([]*entities.NbIdentity, error) {
+	return c.contextClient.GetListEnbIds(context.Background())
+}
+
+func (c *rNibClientInstance) GetCountGnbList() (int, error) {
+	return c.contextClient.GetCountGnbList(context.Background())
+}
+
+func (c *rNibClientInstance) GetCountEnbList() (int, error) {
+	return c.contextClient.GetCountEnbList(context.Background())
+}
+
+func (c *rNibClientInstance) GetCountNodebs() (int, error) {
+	return c.contextClient.GetCountNodebs(context.Background())
+}
+
+func (c *rNibClientInstance) GetNodebStatistics() (*reader.NodebStatistics, error) {
+	return c.contextClient.GetNodebStatistics(context.Background())
+}
+
+func (c *rNibClientInstance) GetCell(inventoryName string, pci uint32) (*entities.Cell, error) {
+	return c.contextClient.GetCell(context.Background(), inventoryName, pci)
+}
+
+func (c *rNibClientInstance) GetCellById(cellType entities.Cell_Type, cellId string) (*entities.Cell, error) {
+	return c.contextClient.GetCellById(context.Background(), cellType, cellId)
+}
+
+func (c *rNibClientInstance) GetCellByCgi(cellType entities.Cell_Type, cgi string) (*entities.Cell, error) {
+	return c.contextClient.GetCellByCgi(context.Background(), cellType, cgi)
+}
+
+func (c *rNibClientInstance) GetCellsByPlmn(plmnId string) ([]*entities.Cell, error) {
+	return c.contextClient.GetCellsByPlmn(context.Background(), plmnId)
+}
+
+func (c *rNibClientInstance) GetCellsByTac(tac string) ([]*entities.Cell, error) {
+	return c.contextClient.GetCellsByTac(context.Background(), tac)
+}
+
+func (c *rNibClientInstance) GetListNodebIds() ([]*entities.NbIdentity, error) {
+	return c.contextClient.GetListNodebIds(context.Background())
+}
+
+func (c *rNibClientInstance) PageNodebIds(ctx context.Context, pageSize int) reader.NodebIdPager {
+	return c.contextClient.PageNodebIds(ctx, pageSize)
+}
+
+func (c *rNibClientInstance) GetRanLoadInformation(inventoryName string) (*entities.RanLoadInformation, error) {
+	return c.contextClient.GetRanLoadInformation(context.Background(), inventoryName)
+}
+
+func (c *rNibClientInstance) GetE2TInstance(address string) (*entities.E2TInstance, error) {
+	return c.contextClient.GetE2TInstance(context.Background(), address)
+}
+
+func (c *rNibClientInstance) GetE2TInstances(addresses []string) ([]*entities.E2TInstance, error) {
+	return c.contextClient.GetE2TInstances(context.Background(), addresses)
+}
+
+func (c *rNibClientInstance) GetE2TAddresses() ([]string, error) {
+	return c.contextClient.GetE2TAddresses(context.Background())
+}
+
+func (c *rNibClientInstance) GetGeneralConfiguration() (*entities.GeneralConfiguration, error) {
+	return c.contextClient.GetGeneralConfiguration(context.Background())
+}
+
+func (c *rNibClientInstance) GetRanFunctionDefinition(inventoryName string, oid string) ([]string, error) {
+	return c.contextClient.GetRanFunctionDefinition(context.Background(), inventoryName, oid)
+}
+
+func (c *rNibClientInstance) GetRanFunctions(inventoryName string) ([]*entities.RanFunction, error) {
+	return c.contextClient.GetRanFunctions(context.Background(), inventoryName)
+}
+
+func (c *rNibClientInstance) GetRanFunctionById(inventoryName string, ranFunctionId uint32) (*entities.RanFunction, error) {
+	return c.contextClient.GetRanFunctionById(context.Background(), inventoryName, ranFunctionId)
+}
+
+func (c *rNibClientInstance) FindNodebsSupportingRanFunction(oid string, minRevision uint32) ([]*entities.NodebInfo, error) {
+	return c.contextClient.FindNodebsSupportingRanFunction(context.Background(), oid, minRevision)
+}
+
+func (c *rNibClientInstance) WatchNodebs(ctx context.Context, options reader.WatchOptions) (<-chan *reader.NodebEvent, error) {
+	return c.contextClient.WatchNodebs(ctx, options)
+}
+
+func (c *contextRNibClientInstance) GetNodeb(ctx context.Context, inventoryName string) (*entities.NodebInfo, error) {
+	nodeb, err := c.client.GetNodeb(ctx, &GetNodebRequest{InventoryName: inventoryName})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return nodeb, nil
+}
+
+func (c *contextRNibClientInstance) GetNodebByGlobalNbId(ctx context.Context, nodeType entities.Node_Type, globalNbId *entities.GlobalNbId, cuupId string, duid string) (*entities.NodebInfo, error) {
+	nodeb, err := c.client.GetNodebByGlobalNbId(ctx, &GetNodebByGlobalNbIdRequest{
+		NodeType:   nodeType,
+		GlobalNbId: globalNbId,
+		CuUpId:     cuupId,
+		DuId:       duid,
+	})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return nodeb, nil
+}
+
+func (c *contextRNibClientInstance) GetNodebs(ctx context.Context, inventoryNames []string) (map[string]*entities.NodebInfo, map[string]error, error) {
+	response, err := c.client.GetNodebs(ctx, &GetNodebsRequest{InventoryNames: inventoryNames})
+	if err != nil {
+		return nil, nil, fromStatusError(err)
+	}
+	nodebs, errs := fromGetNodebsResponse(response)
+	return nodebs, errs, nil
+}
+
+func (c *contextRNibClientInstance) GetNodebsByGlobalNbIds(ctx context.Context, nodeType entities.Node_Type, globalNbIds []reader.NodebGlobalId) (map[string]*entities.NodebInfo, map[string]error, error) {
+	response, err := c.client.GetNodebsByGlobalNbIds(ctx, &GetNodebsByGlobalNbIdsRequest{NodeType: nodeType, GlobalNbIds: fromNodebGlobalIds(globalNbIds)})
+	if err != nil {
+		return nil, nil, fromStatusError(err)
+	}
+	nodebs, errs := fromGetNodebsResponse(response)
+	return nodebs, errs, nil
+}
+
+func (c *contextRNibClientInstance) FindNodebs(ctx context.Context, filter reader.NodebFilter) ([]*entities.NodebInfo, error) {
+	list, err := c.client.FindNodebs(ctx, fromNodebFilter(filter))
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	nodebs := list.GetNodebs()
+	if nodebs == nil {
+		nodebs = []*entities.NodebInfo{}
+	}
+	return nodebs, nil
+}
+
+func (c *contextRNibClientInstance) FindNodebIds(ctx context.Context, filter reader.NodebFilter) ([]*entities.NbIdentity, error) {
+	return fromNbIdentityList(c.client.FindNodebIds(ctx, fromNodebFilter(filter)))
+}
+
+func (c *contextRNibClientInstance) GetCellList(ctx context.Context, inventoryName string) (*entities.Cells, error) {
+	cells, err := c.client.GetCellList(ctx, &GetCellListRequest{InventoryName: inventoryName})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return cells, nil
+}
+
+func (c *contextRNibClientInstance) GetListGnbIds(ctx context.Context) ([]*entities.NbIdentity, error) {
+	return fromNbIdentityList(c.client.GetListGnbIds(ctx, &Empty{}))
+}
+
+func (c *contextRNibClientInstance) GetListEnbIds(ctx context.Context) ([]*entities.NbIdentity, error) {
+	return fromNbIdentityList(c.client.GetListEnbIds(ctx, &Empty{}))
+}
+
+func (c *contextRNibClientInstance) GetCountGnbList(ctx context.Context) (int, error) {
+	count, err := c.client.GetCountGnbList(ctx, &Empty{})
+	if err != nil {
+		return 0, fromStatusError(err)
+	}
+	return int(count.GetCount()), nil
+}
+
+func (c *contextRNibClientInstance) GetCountEnbList(ctx context.Context) (int, error) {
+	count, err := c.client.GetCountEnbList(ctx, &Empty{})
+	if err != nil {
+		return 0, fromStatusError(err)
+	}
+	return int(count.GetCount()), nil
+}
+
+func (c *contextRNibClientInstance) GetCountNodebs(ctx context.Context) (int, error) {
+	count, err := c.client.GetCountNodebs(ctx, &Empty{})
+	if err != nil {
+		return 0, fromStatusError(err)
+	}
+	return int(count.GetCount()), nil
+}
+
+func (c *contextRNibClientInstance) GetNodebStatistics(ctx context.Context) (*reader.NodebStatistics, error) {
+	statistics, err := c.client.GetNodebStatistics(ctx, &Empty{})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return &reader.NodebStatistics{
+		Total:              int(statistics.GetTotal()),
+		ByConnectionStatus: toCounts(statistics.GetByConnectionStatus()),
+		ByNodeType:         toCounts(statistics.GetByNodeType()),
+		ByGnbNodeType:      toCounts(statistics.GetByGnbNodeType()),
+		ByE2TInstance:      toCounts(statistics.GetByE2TInstance()),
+		LteCells:           int(statistics.GetLteCells()),
+		NrCells:            int(statistics.GetNrCells()),
+	}, nil
+}
+
+func (c *contextRNibClientInstance) GetCell(ctx context.Context, inventoryName string, pci uint32) (*entities.Cell, error) {
+	cell, err := c.client.GetCell(ctx, &GetCellRequest{InventoryName: inventoryName, Pci: pci})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return cell, nil
+}
+
+func (c *contextRNibClientInstance) GetCellById(ctx context.Context, cellType entities.Cell_Type, cellId string) (*entities.Cell, error) {
+	cell, err := c.client.GetCellById(ctx, &GetCellByIdRequest{CellType: cellType, CellId: cellId})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return cell, nil
+}
+
+func (c *contextRNibClientInstance) GetCellByCgi(ctx context.Context, cellType entities.Cell_Type, cgi string) (*entities.Cell, error) {
+	cell, err := c.client.GetCellByCgi(ctx, &GetCellByCgiRequest{CellType: cellType, Cgi: cgi})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return cell, nil
+}
+
+func (c *contextRNibClientInstance) GetCellsByPlmn(ctx context.Context, plmnId string) ([]*entities.Cell, error) {
+	return fromCellList(c.client.GetCellsByPlmn(ctx, &GetCellsByPlmnRequest{PlmnId: plmnId}))
+}
+
+func (c *contextRNibClientInstance) GetCellsByTac(ctx context.Context, tac string) ([]*entities.Cell, error) {
+	return fromCellList(c.client.GetCellsByTac(ctx, &GetCellsByTacRequest{Tac: tac}))
+}
+
+func (c *contextRNibClientInstance) GetListNodebIds(ctx context.Context) ([]*entities.NbIdentity, error) {
+	return fromNbIdentityList(c.client.GetListNodebIds(ctx, &Empty{}))
+}
+
+func (c *contextRNibClientInstance) PageNodebIds(ctx context.Context, pageSize int) reader.NodebIdPager {
+	stream, err := c.client.PageNodebIds(ctx, &PageNodebIdsRequest{PageSize: int32(pageSize)})
+	if err != nil {
+		return &nodebIdPager{err: fromStatusError(err)}
+	}
+	return &nodebIdPager{stream: stream}
+}
+
+func (c *contextRNibClientInstance) GetRanLoadInformation(ctx context.Context, inventoryName string) (*entities.RanLoadInformation, error) {
+	loadInfo, err := c.client.GetRanLoadInformation(ctx, &GetRanLoadInformationRequest{InventoryName: inventoryName})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return loadInfo, nil
+}
+
+func (c *contextRNibClientInstance) GetE2TInstance(ctx context.Context, address string) (*entities.E2TInstance, error) {
+	e2tInstance, err := c.client.GetE2TInstance(ctx, &GetE2TInstanceRequest{Address: address})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return toE2TInstance(e2tInstance), nil
+}
+
+func (c *contextRNibClientInstance) GetE2TInstances(ctx context.Context, addresses []string) ([]*entities.E2TInstance, error) {
+	list, err := c.client.GetE2TInstances(ctx, &GetE2TInstancesRequest{Addresses: addresses})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	e2tInstances := make([]*entities.E2TInstance, 0, len(list.GetE2TInstances()))
+	for _, e2tInstance := range list.GetE2TInstances() {
+		e2tInstances = append(e2tInstances, toE2TInstance(e2tInstance))
+	}
+	return e2tInstances, nil
+}
+
+func (c *contextRNibClientInstance) GetE2TAddresses(ctx context.Context) ([]string, error) {
+	list, err := c.client.GetE2TAddresses(ctx, &Empty{})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return list.GetAddresses(), nil
+}
+
+func (c *contextRNibClientInstance) GetGeneralConfiguration(ctx context.Context) (*entities.GeneralConfiguration, error) {
+	config, err := c.client.GetGeneralConfiguration(ctx, &Empty{})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return &entities.GeneralConfiguration{EnableRic: config.GetEnableRic()}, nil
+}
+
+func (c *contextRNibClientInstance) GetRanFunctionDefinition(ctx context.Context, inventoryName string, oid string) ([]string, error) {
+	list, err := c.client.GetRanFunctionDefinition(ctx, &GetRanFunctionDefinitionRequest{InventoryName: inventoryName, Oid: oid})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return list.GetRanFunctionDefinitions(), nil
+}
+
+func (c *contextRNibClientInstance) GetRanFunctions(ctx context.Context, inventoryName string) ([]*entities.RanFunction, error) {
+	list, err := c.client.GetRanFunctions(ctx, &GetRanFunctionsRequest{InventoryName: inventoryName})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	ranFunctions := list.GetRanFunctions()
+	if ranFunctions == nil {
+		ranFunctions = []*entities.RanFunction{}
+	}
+	return ranFunctions, nil
+}
+
+func (c *contextRNibClientInstance) GetRanFunctionById(ctx context.Context, inventoryName string, ranFunctionId uint32) (*entities.RanFunction, error) {
+	ranFunction, err := c.client.GetRanFunctionById(ctx, &GetRanFunctionByIdRequest{InventoryName: inventoryName, RanFunctionId: ranFunctionId})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	return ranFunction, nil
+}
+
+func (c *contextRNibClientInstance) FindNodebsSupportingRanFunction(ctx context.Context, oid string, minRevision uint32) ([]*entities.NodebInfo, error) {
+	list, err := c.client.FindNodebsSupportingRanFunction(ctx, &FindNodebsSupportingRanFunctionRequest{Oid: oid, MinRevision: minRevision})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	nodebs := list.GetNodebs()
+	if nodebs == nil {
+		nodebs = []*entities.NodebInfo{}
+	}
+	return nodebs, nil
+}
+
+/*
+WatchNodebs opens a WatchNodebs stream and delivers its events until the context is done or the stream ends.
+A stream failing before the context is done delivers a last event holding only the error in Err.
+The returned channel is closed once the stream ends.
+*/
+func (c *contextRNibClientInstance) WatchNodebs(ctx context.Context, options reader.WatchOptions) (<-chan *reader.NodebEvent, error) {
+	stream, err := c.client.WatchNodebs(ctx, &WatchNodebsRequest{Channels: options.Channels, FetchNodeb: options.FetchNodeb})
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	bufferSize := options.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultWatchBufferSize
+	}
+	events := make(chan *reader.NodebEvent, bufferSize)
+	go func() {
+		defer close(events)
+		for {
+			nodebEvent, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					select {
+					case events <- &reader.NodebEvent{Err: fromStatusError(err)}:
+					case <-ctx.Done():
+					}
+				}
+				return
+			}
+			event := &reader.NodebEvent{
+				Channel:       nodebEvent.GetChannel(),
+				InventoryName: nodebEvent.GetInventoryName(),
+				Kind:          reader.NodebEventKind(nodebEvent.GetKind()),
+				Nodeb:         nodebEvent.GetNodeb(),
+			}
+			if nodebEvent.GetError() != nil {
+				event.Err = fromError(nodebEvent.GetError())
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+func fromNbIdentityList(list *NbIdentityList, err error) ([]*entities.NbIdentity, error) {
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	nbIdentities := list.GetNbIdentities()
+	if nbIdentities == nil {
+		nbIdentities = []*entities.NbIdentity{}
+	}
+	return nbIdentities, nil
+}
+
+func fromCellList(list *CellList, err error) ([]*entities.Cell, error) {
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+	cells := list.GetCells()
+	if cells == nil {
+		cells = []*entities.Cell{}
+	}
+	return cells, nil
+}
+
+func fromNodebFilter(filter reader.NodebFilter) *NodebFilter {
+	return &NodebFilter{
+		ConnectionStatus:   filter.ConnectionStatus,
+		NodeType:           filter.NodeType,
+		GnbType:            filter.GnbType,
+		EnbType:            filter.EnbType,
+		GnbNodeType:        filter.GnbNodeType,
+		PlmnId:             filter.PlmnId,
+		E2TInstanceAddress: filter.E2TInstanceAddress,
+		SetupFromNetwork:   filter.SetupFromNetwork,
+	}
+}
+
+func fromNodebGlobalIds(ids []reader.NodebGlobalId) []*NodebGlobalId {
+	result := make([]*NodebGlobalId, len(ids))
+	for i, id := range ids {
+		result[i] = &NodebGlobalId{GlobalNbId: id.GlobalNbId, CuUpId: id.CuupId, DuId: id.DuId}
+	}
+	return result
+}
+
+func fromGetNodebsResponse(response *GetNodebsResponse) (map[string]*entities.NodebInfo, map[string]error) {
+	nodebs := make(map[string]*entities.NodebInfo, len(response.GetNodebs()))
+	for key, nodeb := range response.GetNodebs() {
+		nodebs[key] = nodeb
+	}
+	errs := make(map[string]error, len(response.GetErrors()))
+	for key, err := range response.GetErrors() {
+		errs[key] = fromError(err)
+	}
+	return nodebs, errs
+}
+
+func toCounts(counts map[string]int64) map[string]int {
+	result := make(map[string]int, len(counts))
+	for key, count := range counts {
+		result[key] = int(count)
+	}
+	return result
+}
+
+func toE2TInstance(e2tInstance *E2TInstance) *entities.E2TInstance {
+	associatedRanList := e2tInstance.GetAssociatedRanList()
+	if associatedRanList == nil {
+		associatedRanList = []string{}
+	}
+	return &entities.E2TInstance{
+		Address:            e2tInstance.GetAddress(),
+		PodName:            e2tInstance.GetPodName(),
+		AssociatedRanList:  associatedRanList,
+		KeepAliveTimestamp: e2tInstance.GetKeepAliveTimestamp(),
+		State:              entities.E2TInstanceState(e2tInstance.GetState()),
+		DeletionTimestamp:  e2tInstance.GetDeletionTimestamp(),
+	}
+}
+
+func buildError(code codes.Code, message string) error {
+	switch code {
+	case codes.NotFound:
+		return common.NewResourceNotFoundError(message)
+	case codes.InvalidArgument:
+		return common.NewValidationError(message)
+	case codes.Unavailable:
+		return common.NewStorageUnavailableError(errors.New(message))
+	case codes.DataLoss:
+		return common.NewCorruptDataError("", "", errors.New(message))
+	case codes.DeadlineExceeded:
+		return common.NewContextError(context.DeadlineExceeded)
+	case codes.Canceled:
+		return common.NewContextError(context.Canceled)
+	}
+	return common.NewInternalError(errors.New(message))
+}
+
+func fromStatusError(err error) error {
+	st := status.Convert(err)
+	return buildError(st.Code(), st.Message())
+}
+
+func fromError(err *Error) error {
+	return buildError(codes.Code(err.GetCode()), err.GetMessage())
+}
@@ -0,0 +1,396 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"testing"
+	"time"
+)
+
+var _ reader.RNibReader = (RNibClient)(nil)
+
+var _ reader.ContextRNibReader = (ContextRNibClient)(nil)
+
+func initRNibClient(t *testing.T) (RNibClient, writer.RNibWriter) {
+	storage := common.NewInMemorySdlSyncStorage()
+	conn := dialRNibServer(t, GetNewContextRNibServer(reader.GetNewContextRNibReader(storage), storage))
+	return GetNewRNibClient(conn), writer.GetNewRNibWriter(storage)
+}
+
+func dialRNibServer(t *testing.T, rNibServer *RNibServer) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	RegisterRNibServiceServer(server, rNibServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	if err != nil {
+		t.Fatalf("#rnibClient_test.initRNibClient - failed to dial: %s", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+	})
+	return conn
+}
+
+func buildGnb(name string) *entities.NodebInfo {
+	return &entities.NodebInfo{
+		RanName:          name,
+		NodeType:         entities.Node_GNB,
+		ConnectionStatus: entities.ConnectionStatus_CONNECTED,
+		GlobalNbId:       &entities.GlobalNbId{PlmnId: "02f829", NbId: name + "_id"},
+		Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{ServedNrCells: []*entities.ServedNRCell{
+			{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: name + "_cell", NrPci: 1}},
+		}}},
+	}
+}
+
+func TestGetNodeb(t *testing.T) {
+	client, w := initRNibClient(t)
+	gnb := buildGnb("gnb_1")
+	assert.Nil(t, w.SaveNodeb(gnb))
+	nodeb, err := client.GetNodeb("gnb_1")
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(gnb, nodeb))
+	nodeb, err = client.GetNodebByGlobalNbId(entities.Node_GNB, gnb.GetGlobalNbId(), "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "gnb_1", nodeb.GetRanName())
+}
+
+func TestGetNodebNotFound(t *testing.T) {
+	client, _ := initRNibClient(t)
+	nodeb, err := client.GetNodeb("gnb_1")
+	assert.Nil(t, nodeb)
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestGetNodebValidationError(t *testing.T) {
+	client, _ := initRNibClient(t)
+	_, err := client.GetNodebByGlobalNbId(entities.Node_GNB, &entities.GlobalNbId{}, "", "")
+	assert.IsType(t, &common.ValidationError{}, err)
+}
+
+func TestGetNodebs(t *testing.T) {
+	client, w := initRNibClient(t)
+	assert.Nil(t, w.SaveNodeb(buildGnb("gnb_1")))
+	nodebs, errs, err := client.GetNodebs([]string{"gnb_1", "gnb_2"})
+	assert.Nil(t, err)
+	assert.Len(t, nodebs, 1)
+	assert.Equal(t, "gnb_1", nodebs["gnb_1"].GetRanName())
+	assert.Len(t, errs, 1)
+	assert.IsType(t, &common.ResourceNotFoundError{}, errs["gnb_2"])
+}
+
+func TestGetNodebsByGlobalNbIds(t *testing.T) {
+	client, w := initRNibClient(t)
+	gnb := buildGnb("gnb_1")
+	assert.Nil(t, w.SaveNodeb(gnb))
+	nodebs, errs, err := client.GetNodebsByGlobalNbIds(entities.Node_GNB, []reader.NodebGlobalId{{GlobalNbId: gnb.GetGlobalNbId()}, {GlobalNbId: gnb.GetGlobalNbId(), DuId: "1"}})
+	assert.Nil(t, err)
+	assert.Len(t, errs, 1)
+	assert.IsType(t, &common.ResourceNotFoundError{}, errs["GNB:02f829:gnb_1_id:1"])
+	assert.Equal(t, "gnb_1", nodebs["GNB:02f829:gnb_1_id"].GetRanName())
+}
+
+func TestFindNodebs(t *testing.T) {
+	client, w := initRNibClient(t)
+	gnb := buildGnb("gnb_1")
+	assert.Nil(t, w.SaveNodeb(gnb))
+	assert.Nil(t, w.AddNbIdentity(entities.Node_GNB, &entities.NbIdentity{InventoryName: "gnb_1", GlobalNbId: gnb.GetGlobalNbId()}))
+	setupFromNetwork := false
+	nodebs, err := client.FindNodebs(reader.NodebFilter{ConnectionStatus: entities.ConnectionStatus_CONNECTED, SetupFromNetwork: &setupFromNetwork})
+	assert.Nil(t, err)
+	assert.Len(t, nodebs, 1)
+	assert.Equal(t, "gnb_1", nodebs[0].GetRanName())
+	nbIdentities, err := client.FindNodebIds(reader.NodebFilter{NodeType: entities.Node_ENB})
+	assert.Nil(t, err)
+	assert.Empty(t, nbIdentities)
+	setupFromNetwork = true
+	nodebs, err = client.FindNodebs(reader.NodebFilter{SetupFromNetwork: &setupFromNetwork})
+	assert.Nil(t, err)
+	assert.Empty(t, nodebs)
+}
+
+func TestGetRanFunctions(t *testing.T) {
+	client, w := initRNibClient(t)
+	gnb := buildGnb("gnb_1")
+	gnb.GetGnb().RanFunctions = []*entities.RanFunction{{RanFunctionId: 2, RanFunctionOid: "1.3.6.1.4.1.53148.1.2.2.2", RanFunctionRevision: 2}}
+	assert.Nil(t, w.SaveNodeb(gnb))
+	assert.Nil(t, w.AddNbIdentity(entities.Node_GNB, &entities.NbIdentity{InventoryName: "gnb_1", GlobalNbId: gnb.GetGlobalNbId()}))
+	ranFunctions, err := client.GetRanFunctions("gnb_1")
+	assert.Nil(t, err)
+	assert.Len(t, ranFunctions, 1)
+	ranFunction, err := client.GetRanFunctionById("gnb_1", 2)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 2, ranFunction.GetRanFunctionRevision())
+	_, err = client.GetRanFunctionById("gnb_1", 3)
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+	nodebs, err := client.FindNodebsSupportingRanFunction("1.3.6.1.4.1.53148.1.2.2.2", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, "gnb_1", nodebs[0].GetRanName())
+	nodebs, err = client.FindNodebsSupportingRanFunction("1.3.6.1.4.1.53148.1.2.2.2", 3)
+	assert.Nil(t, err)
+	assert.Empty(t, nodebs)
+}
+
+func TestGetCells(t *testing.T) {
+	client, w := initRNibClient(t)
+	assert.Nil(t, w.SaveNodeb(buildGnb("gnb_1")))
+	cells, err := client.GetCellList("gnb_1")
+	assert.Nil(t, err)
+	assert.Len(t, cells.GetServedNrCells().GetServedCells(), 1)
+	cell, err := client.GetCell("gnb_1", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, entities.Cell_NR_CELL, cell.GetType())
+	cell, err = client.GetCellById(entities.Cell_NR_CELL, "gnb_1_cell")
+	assert.Nil(t, err)
+	assert.Equal(t, "gnb_1_cell", cell.GetServedNrCell().GetServedNrCellInformation().GetCellId())
+}
+
+func TestGetCellsByIndexes(t *testing.T) {
+	client, w := initRNibClient(t)
+	gnb := buildGnb("gnb_1")
+	gnb.GetGnb().GetServedNrCells()[0].ServedNrCellInformation = &entities.ServedNRCellInformation{
+		CellId: "02f829:4a952a0a50", NrPci: 1, ServedPlmns: []string{"02f829"}, ConfiguredStac: "0001",
+	}
+	assert.Nil(t, w.SaveNodeb(gnb))
+	cell, err := client.GetCellByCgi(entities.Cell_NR_CELL, "02f8294a952a0a50")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), cell.GetServedNrCell().GetServedNrCellInformation().GetNrPci())
+	_, err = client.GetCellByCgi(entities.Cell_NR_CELL, "invalid")
+	assert.IsType(t, &common.ValidationError{}, err)
+	cells, err := client.GetCellsByPlmn("02f829")
+	assert.Nil(t, err)
+	assert.Len(t, cells, 1)
+	cells, err = client.GetCellsByTac("0002")
+	assert.Nil(t, err)
+	assert.Empty(t, cells)
+}
+
+func TestGetNodebIds(t *testing.T) {
+	client, w := initRNibClient(t)
+	gnb := buildGnb("gnb_1")
+	assert.Nil(t, w.AddNbIdentity(entities.Node_GNB, &entities.NbIdentity{InventoryName: "gnb_1", GlobalNbId: gnb.GetGlobalNbId()}))
+	ids, err := client.GetListGnbIds()
+	assert.Nil(t, err)
+	assert.Len(t, ids, 1)
+	ids, err = client.GetListEnbIds()
+	assert.Nil(t, err)
+	assert.Empty(t, ids)
+	ids, err = client.GetListNodebIds()
+	assert.Nil(t, err)
+	assert.Len(t, ids, 1)
+	count, err := client.GetCountGnbList()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	count, err = client.GetCountEnbList()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+	count, err = client.GetCountNodebs()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestGetNodebStatistics(t *testing.T) {
+	client, w := initRNibClient(t)
+	gnb := buildGnb("gnb_1")
+	assert.Nil(t, w.SaveNodeb(gnb))
+	assert.Nil(t, w.AddNbIdentity(entities.Node_GNB, &entities.NbIdentity{InventoryName: "gnb_1", GlobalNbId: gnb.GetGlobalNbId()}))
+	statistics, err := client.GetNodebStatistics()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, statistics.Total)
+	assert.Equal(t, map[string]int{"CONNECTED": 1}, statistics.ByConnectionStatus)
+	assert.Equal(t, map[string]int{"GNB": 1}, statistics.ByNodeType)
+	assert.Equal(t, 1, statistics.NrCells)
+}
+
+func TestPageNodebIds(t *testing.T) {
+	client, w := initRNibClient(t)
+	for _, name := range []string{"gnb_1", "gnb_2", "gnb_3"} {
+		assert.Nil(t, w.AddNbIdentity(entities.Node_GNB, &entities.NbIdentity{InventoryName: name, GlobalNbId: &entities.GlobalNbId{PlmnId: "02f829", NbId: name}}))
+	}
+	pager := client.PageNodebIds(context.Background(), 2)
+	total, err := pager.Total()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, total)
+	var pageSizes []int
+	for pager.Next() {
+		pageSizes = append(pageSizes, len(pager.Page()))
+	}
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, []int{2, 1}, pageSizes)
+}
+
+func TestPageNodebIdsEmpty(t *testing.T) {
+	client, _ := initRNibClient(t)
+	pager := client.PageNodebIds(context.Background(), 2)
+	assert.False(t, pager.Next())
+	assert.Nil(t, pager.Err())
+	total, err := pager.Total()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, total)
+}
+
+func TestGetE2TAndConfiguration(t *testing.T) {
+	client, w := initRNibClient(t)
+	e2tInstance := entities.NewE2TInstance("10.0.2.15:38000", "e2term")
+	e2tInstance.AssociatedRanList = []string{"gnb_1"}
+	assert.Nil(t, w.SaveE2TInstance(e2tInstance))
+	assert.Nil(t, w.SaveE2TAddresses([]string{"10.0.2.15:38000"}))
+	assert.Nil(t, w.SaveGeneralConfiguration(&entities.GeneralConfiguration{EnableRic: true}))
+	assert.Nil(t, w.SaveRanLoadInformation("gnb_1", &entities.RanLoadInformation{LoadTimestamp: 5}))
+	addresses, err := client.GetE2TAddresses()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"10.0.2.15:38000"}, addresses)
+	instance, err := client.GetE2TInstance("10.0.2.15:38000")
+	assert.Nil(t, err)
+	assert.Equal(t, e2tInstance, instance)
+	instances, err := client.GetE2TInstances(addresses)
+	assert.Nil(t, err)
+	assert.Equal(t, []*entities.E2TInstance{e2tInstance}, instances)
+	config, err := client.GetGeneralConfiguration()
+	assert.Nil(t, err)
+	assert.True(t, config.EnableRic)
+	loadInfo, err := client.GetRanLoadInformation("gnb_1")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), loadInfo.GetLoadTimestamp())
+}
+
+func TestWatchNodebs(t *testing.T) {
+	client, w := initRNibClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := client.WatchNodebs(ctx, reader.WatchOptions{FetchNodeb: true})
+	assert.Nil(t, err)
+	// the subscription is made asynchronously by the server, keep saving until the first event arrives
+	var event *reader.NodebEvent
+	for i := 0; i < 100 && event == nil; i++ {
+		assert.Nil(t, w.SaveNodeb(buildGnb("gnb_1")))
+		select {
+		case event = <-events:
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	if event == nil {
+		t.Fatal("#rnibClient_test.TestWatchNodebs - no event received")
+	}
+	assert.Equal(t, common.RanManipulationMessageChannel, event.Channel)
+	assert.Equal(t, reader.NodebAdded, event.Kind)
+	assert.Equal(t, "gnb_1", event.Nodeb.GetRanName())
+	cancel()
+	for range events {
+	}
+}
+
+func TestWatchNodebsStreamFailure(t *testing.T) {
+	conn := dialRNibServer(t, GetNewContextRNibServer(reader.GetNewContextRNibReader(common.NewInMemorySdlSyncStorage()), nil))
+	events, err := GetNewRNibClient(conn).WatchNodebs(context.Background(), reader.WatchOptions{})
+	assert.Nil(t, err)
+	event, ok := <-events
+	assert.True(t, ok)
+	assert.IsType(t, &common.InternalError{}, event.Err)
+	assert.Empty(t, event.InventoryName)
+	_, ok = <-events
+	assert.False(t, ok)
+}
+
+func TestContextClientCanceled(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	conn := dialRNibServer(t, GetNewContextRNibServer(reader.GetNewContextRNibReader(storage), storage))
+	client := GetNewContextRNibClient(conn)
+	assert.Nil(t, writer.GetNewRNibWriter(storage).SaveNodeb(buildGnb("gnb_1")))
+	nodeb, err := client.GetNodeb(context.Background(), "gnb_1")
+	assert.Nil(t, err)
+	assert.Equal(t, "gnb_1", nodeb.GetRanName())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.GetNodeb(ctx, "gnb_1")
+	assert.IsType(t, &common.ContextError{}, err)
+}
+
+func TestServerReadsWithRequestContext(t *testing.T) {
+	server := GetNewContextRNibServer(reader.GetNewContextRNibReader(common.NewInMemorySdlSyncStorage()), nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := server.GetNodeb(ctx, &GetNodebRequest{InventoryName: "gnb_1"})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestServeCachedRNibReader(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	cachedReader := reader.GetNewCachedRNibReader(reader.GetNewRNibReader(storage), reader.CacheConfig{})
+	client := GetNewContextRNibClient(dialRNibServer(t, GetNewRNibServer(cachedReader, nil)))
+	assert.Nil(t, writer.GetNewRNibWriter(storage).SaveNodeb(buildGnb("gnb_1")))
+	for i := 0; i < 2; i++ {
+		nodeb, err := client.GetNodeb(context.Background(), "gnb_1")
+		assert.Nil(t, err)
+		assert.Equal(t, "gnb_1", nodeb.GetRanName())
+	}
+	assert.Equal(t, reader.CacheStats{Hits: 1, Misses: 1, Size: 1}, cachedReader.Stats())
+	_, err := client.GetNodeb(context.Background(), "gnb_2")
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+
+	server := GetNewRNibServer(cachedReader, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = server.GetNodeb(ctx, &GetNodebRequest{InventoryName: "gnb_1"})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Equal(t, uint64(1), cachedReader.Stats().Hits)
+}
+
+func TestWatchNodebsWithoutStorage(t *testing.T) {
+	server := GetNewContextRNibServer(reader.GetNewContextRNibReader(common.NewInMemorySdlSyncStorage()), nil)
+	err := server.WatchNodebs(&WatchNodebsRequest{}, nil)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestStatusCode(t *testing.T) {
+	assert.Equal(t, codes.NotFound, statusCode(common.NewResourceNotFoundError("not found")))
+	assert.Equal(t, codes.InvalidArgument, statusCode(common.NewValidationError("invalid")))
+	assert.Equal(t, codes.DeadlineExceeded, statusCode(common.NewContextError(context.DeadlineExceeded)))
+	assert.Equal(t, codes.Canceled, statusCode(common.NewContextError(context.Canceled)))
+	assert.Equal(t, codes.Internal, statusCode(common.NewInternalError(errors.New("error"))))
+	assert.Equal(t, codes.Unavailable, statusCode(common.NewStorageUnavailableError(errors.New("error"))))
+	assert.Equal(t, codes.DataLoss, statusCode(common.NewCorruptDataError("*entities.NodebInfo", "RAN:gnb_1", errors.New("error"))))
+}
+
+func TestBuildError(t *testing.T) {
+	for _, code := range []codes.Code{codes.NotFound, codes.InvalidArgument, codes.Unavailable, codes.DataLoss, codes.DeadlineExceeded, codes.Canceled, codes.Internal} {
+		assert.Equal(t, code, statusCode(buildError(code, "error")))
+	}
+}
@@ -0,0 +1,384 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package rpc
+
+//go:generate protoc -I . -I ../entities --go_out=. --go-grpc_out=. --go_opt=paths=source_relative --go-grpc_opt=paths=source_relative --go_opt=Mnodeb_info.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go_opt=Mnb_identity.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go_opt=Mnb_types.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go_opt=Mcell.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go_opt=Mcells.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go_opt=Mran_load_information.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go_opt=Mran_function.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go-grpc_opt=Mnodeb_info.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go-grpc_opt=Mnb_identity.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go-grpc_opt=Mnb_types.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go-grpc_opt=Mcell.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go-grpc_opt=Mcells.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go-grpc_opt=Mran_load_information.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities --go-grpc_opt=Mran_function.proto=gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities rnib_service.proto
+
+import (
+	"context"
+	"errors"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+RNibServer serves RNibService on top of a RNibReader or a ContextRNibReader, the latter reading with the context
+of each request. WatchNodebs subscribes to the RAN channels of storage and is unavailable when storage is nil.
+*/
+type RNibServer struct {
+	UnimplementedRNibServiceServer
+	reader  reader.ContextRNibReader
+	storage common.ISdlSyncStorage
+}
+
+//GetNewRNibServer returns reference to RNibServer reading through the given RNibReader, such as a CachedRNibReader
+func GetNewRNibServer(reader reader.RNibReader, storage common.ISdlSyncStorage) *RNibServer {
+	return GetNewContextRNibServer(&rNibReaderWithContext{reader: reader}, storage)
+}
+
+//GetNewContextRNibServer returns reference to RNibServer reading through the given ContextRNibReader
+func GetNewContextRNibServer(reader reader.ContextRNibReader, storage common.ISdlSyncStorage) *RNibServer {
+	return &RNibServer{
+		reader:  reader,
+		storage: storage,
+	}
+}
+
+func (s *RNibServer) GetNodeb(ctx context.Context, request *GetNodebRequest) (*entities.NodebInfo, error) {
+	nodeb, err := s.reader.GetNodeb(ctx, request.GetInventoryName())
+	return nodeb, toStatusError(err)
+}
+
+func (s *RNibServer) GetNodebByGlobalNbId(ctx context.Context, request *GetNodebByGlobalNbIdRequest) (*entities.NodebInfo, error) {
+	nodeb, err := s.reader.GetNodebByGlobalNbId(ctx, request.GetNodeType(), request.GetGlobalNbId(), request.GetCuUpId(), request.GetDuId())
+	return nodeb, toStatusError(err)
+}
+
+func (s *RNibServer) GetNodebs(ctx context.Context, request *GetNodebsRequest) (*GetNodebsResponse, error) {
+	nodebs, errs, err := s.reader.GetNodebs(ctx, request.GetInventoryNames())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return buildGetNodebsResponse(nodebs, errs), nil
+}
+
+func (s *RNibServer) GetNodebsByGlobalNbIds(ctx context.Context, request *GetNodebsByGlobalNbIdsRequest) (*GetNodebsResponse, error) {
+	nodebs, errs, err := s.reader.GetNodebsByGlobalNbIds(ctx, request.GetNodeType(), toNodebGlobalIds(request.GetGlobalNbIds()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return buildGetNodebsResponse(nodebs, errs), nil
+}
+
+func (s *RNibServer) FindNodebs(ctx context.Context, request *NodebFilter) (*NodebInfoList, error) {
+	nodebs, err := s.reader.FindNodebs(ctx, toNodebFilter(request))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &NodebInfoList{Nodebs: nodebs}, nil
+}
+
+func (s *RNibServer) FindNodebIds(ctx context.Context, request *NodebFilter) (*NbIdentityList, error) {
+	return buildNbIdentityList(s.reader.FindNodebIds(ctx, toNodebFilter(request)))
+}
+
+func (s *RNibServer) GetCellList(ctx context.Context, request *GetCellListRequest) (*entities.Cells, error) {
+	cells, err := s.reader.GetCellList(ctx, request.GetInventoryName())
+	return cells, toStatusError(err)
+}
+
+func (s *RNibServer) GetListGnbIds(ctx context.Context, request *Empty) (*NbIdentityList, error) {
+	return buildNbIdentityList(s.reader.GetListGnbIds(ctx))
+}
+
+func (s *RNibServer) GetListEnbIds(ctx context.Context, request *Empty) (*NbIdentityList, error) {
+	return buildNbIdentityList(s.reader.GetListEnbIds(ctx))
+}
+
+func (s *RNibServer) GetCountGnbList(ctx context.Context, request *Empty) (*Count, error) {
+	count, err := s.reader.GetCountGnbList(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &Count{Count: int64(count)}, nil
+}
+
+func (s *RNibServer) GetCountEnbList(ctx context.Context, request *Empty) (*Count, error) {
+	count, err := s.reader.GetCountEnbList(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &Count{Count: int64(count)}, nil
+}
+
+func (s *RNibServer) GetCountNodebs(ctx context.Context, request *Empty) (*Count, error) {
+	count, err := s.reader.GetCountNodebs(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &Count{Count: int64(count)}, nil
+}
+
+func (s *RNibServer) GetNodebStatistics(ctx context.Context, request *Empty) (*NodebStatistics, error) {
+	statistics, err := s.reader.GetNodebStatistics(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &NodebStatistics{
+		Total:              int64(statistics.Total),
+		ByConnectionStatus: fromCounts(statistics.ByConnectionStatus),
+		ByNodeType:         fromCounts(statistics.ByNodeType),
+		ByGnbNodeType:      fromCounts(statistics.ByGnbNodeType),
+		ByE2TInstance:      fromCounts(statistics.ByE2TInstance),
+		LteCells:           int64(statistics.LteCells),
+		NrCells:            int64(statistics.NrCells),
+	}, nil
+}
+
+func (s *RNibServer) GetCell(ctx context.Context, request *GetCellRequest) (*entities.Cell, error) {
+	cell, err := s.reader.GetCell(ctx, request.GetInventoryName(), request.GetPci())
+	return cell, toStatusError(err)
+}
+
+func (s *RNibServer) GetCellById(ctx context.Context, request *GetCellByIdRequest) (*entities.Cell, error) {
+	cell, err := s.reader.GetCellById(ctx, request.GetCellType(), request.GetCellId())
+	return cell, toStatusError(err)
+}
+
+func (s *RNibServer) GetCellByCgi(ctx context.Context, request *GetCellByCgiRequest) (*entities.Cell, error) {
+	cell, err := s.reader.GetCellByCgi(ctx, request.GetCellType(), request.GetCgi())
+	return cell, toStatusError(err)
+}
+
+func (s *RNibServer) GetCellsByPlmn(ctx context.Context, request *GetCellsByPlmnRequest) (*CellList, error) {
+	cells, err := s.reader.GetCellsByPlmn(ctx, request.GetPlmnId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &CellList{Cells: cells}, nil
+}
+
+func (s *RNibServer) GetCellsByTac(ctx context.Context, request *GetCellsByTacRequest) (*CellList, error) {
+	cells, err := s.reader.GetCellsByTac(ctx, request.GetTac())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &CellList{Cells: cells}, nil
+}
+
+func (s *RNibServer) GetListNodebIds(ctx context.Context, request *Empty) (*NbIdentityList, error) {
+	return buildNbIdentityList(s.reader.GetListNodebIds(ctx))
+}
+
+func (s *RNibServer) PageNodebIds(request *PageNodebIdsRequest, stream RNibService_PageNodebIdsServer) error {
+	pager := s.reader.PageNodebIds(stream.Context(), int(request.GetPageSize()))
+	total, err := pager.Total()
+	if err != nil {
+		return toStatusError(err)
+	}
+	if err := stream.Send(&NbIdentityPage{Total: int64(total)}); err != nil {
+		return err
+	}
+	for pager.Next() {
+		if err := stream.Send(&NbIdentityPage{NbIdentities: pager.Page(), Total: int64(total)}); err != nil {
+			return err
+		}
+	}
+	return toStatusError(pager.Err())
+}
+
+func (s *RNibServer) GetRanLoadInformation(ctx context.Context, request *GetRanLoadInformationRequest) (*entities.RanLoadInformation, error) {
+	loadInfo, err := s.reader.GetRanLoadInformation(ctx, request.GetInventoryName())
+	return loadInfo, toStatusError(err)
+}
+
+func (s *RNibServer) GetE2TInstance(ctx context.Context, request *GetE2TInstanceRequest) (*E2TInstance, error) {
+	e2tInstance, err := s.reader.GetE2TInstance(ctx, request.GetAddress())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return fromE2TInstance(e2tInstance), nil
+}
+
+func (s *RNibServer) GetE2TInstances(ctx context.Context, request *GetE2TInstancesRequest) (*E2TInstanceList, error) {
+	e2tInstances, err := s.reader.GetE2TInstances(ctx, request.GetAddresses())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	list := &E2TInstanceList{E2TInstances: make([]*E2TInstance, 0, len(e2tInstances))}
+	for _, e2tInstance := range e2tInstances {
+		list.E2TInstances = append(list.E2TInstances, fromE2TInstance(e2tInstance))
+	}
+	return list, nil
+}
+
+func (s *RNibServer) GetE2TAddresses(ctx context.Context, request *Empty) (*E2TAddressList, error) {
+	addresses, err := s.reader.GetE2TAddresses(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &E2TAddressList{Addresses: addresses}, nil
+}
+
+func (s *RNibServer) GetGeneralConfiguration(ctx context.Context, request *Empty) (*GeneralConfiguration, error) {
+	config, err := s.reader.GetGeneralConfiguration(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &GeneralConfiguration{EnableRic: config.EnableRic}, nil
+}
+
+func (s *RNibServer) GetRanFunctionDefinition(ctx context.Context, request *GetRanFunctionDefinitionRequest) (*RanFunctionDefinitionList, error) {
+	definitions, err := s.reader.GetRanFunctionDefinition(ctx, request.GetInventoryName(), request.GetOid())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &RanFunctionDefinitionList{RanFunctionDefinitions: definitions}, nil
+}
+
+func (s *RNibServer) GetRanFunctions(ctx context.Context, request *GetRanFunctionsRequest) (*RanFunctionList, error) {
+	ranFunctions, err := s.reader.GetRanFunctions(ctx, request.GetInventoryName())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &RanFunctionList{RanFunctions: ranFunctions}, nil
+}
+
+func (s *RNibServer) GetRanFunctionById(ctx context.Context, request *GetRanFunctionByIdRequest) (*entities.RanFunction, error) {
+	ranFunction, err := s.reader.GetRanFunctionById(ctx, request.GetInventoryName(), request.GetRanFunctionId())
+	return ranFunction, toStatusError(err)
+}
+
+func (s *RNibServer) FindNodebsSupportingRanFunction(ctx context.Context, request *FindNodebsSupportingRanFunctionRequest) (*NodebInfoList, error) {
+	nodebs, err := s.reader.FindNodebsSupportingRanFunction(ctx, request.GetOid(), request.GetMinRevision())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &NodebInfoList{Nodebs: nodebs}, nil
+}
+
+func (s *RNibServer) WatchNodebs(request *WatchNodebsRequest, stream RNibService_WatchNodebsServer) error {
+	if s.storage == nil {
+		return status.Error(codes.Unimplemented, "#RNibServer.WatchNodebs - watching nodebs requires a storage")
+	}
+	events, err := reader.Watch(stream.Context(), s.storage, reader.WatchOptions{
+		Channels:   request.GetChannels(),
+		FetchNodeb: request.GetFetchNodeb(),
+	})
+	if err != nil {
+		return toStatusError(err)
+	}
+	for event := range events {
+		nodebEvent := &NodebEvent{
+			Channel:       event.Channel,
+			InventoryName: event.InventoryName,
+			Kind:          string(event.Kind),
+			Nodeb:         event.Nodeb,
+		}
+		if event.Err != nil {
+			nodebEvent.Error = toError(event.Err)
+		}
+		if err := stream.Send(nodebEvent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func buildNbIdentityList(nbIdentities []*entities.NbIdentity, err error) (*NbIdentityList, error) {
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &NbIdentityList{NbIdentities: nbIdentities}, nil
+}
+
+func buildGetNodebsResponse(nodebs map[string]*entities.NodebInfo, errs map[string]error) *GetNodebsResponse {
+	response := &GetNodebsResponse{
+		Nodebs: nodebs,
+		Errors: make(map[string]*Error, len(errs)),
+	}
+	for key, err := range errs {
+		response.Errors[key] = toError(err)
+	}
+	return response
+}
+
+func toNodebFilter(filter *NodebFilter) reader.NodebFilter {
+	return reader.NodebFilter{
+		ConnectionStatus:   filter.GetConnectionStatus(),
+		NodeType:           filter.GetNodeType(),
+		GnbType:            filter.GetGnbType(),
+		EnbType:            filter.GetEnbType(),
+		GnbNodeType:        filter.GetGnbNodeType(),
+		PlmnId:             filter.GetPlmnId(),
+		E2TInstanceAddress: filter.GetE2TInstanceAddress(),
+		SetupFromNetwork:   filter.SetupFromNetwork,
+	}
+}
+
+func toNodebGlobalIds(ids []*NodebGlobalId) []reader.NodebGlobalId {
+	result := make([]reader.NodebGlobalId, len(ids))
+	for i, id := range ids {
+		result[i] = reader.NodebGlobalId{GlobalNbId: id.GetGlobalNbId(), CuupId: id.GetCuUpId(), DuId: id.GetDuId()}
+	}
+	return result
+}
+
+func fromCounts(counts map[string]int) map[string]int64 {
+	result := make(map[string]int64, len(counts))
+	for key, count := range counts {
+		result[key] = int64(count)
+	}
+	return result
+}
+
+func fromE2TInstance(e2tInstance *entities.E2TInstance) *E2TInstance {
+	return &E2TInstance{
+		Address:            e2tInstance.Address,
+		PodName:            e2tInstance.PodName,
+		AssociatedRanList:  e2tInstance.AssociatedRanList,
+		KeepAliveTimestamp: e2tInstance.KeepAliveTimestamp,
+		State:              string(e2tInstance.State),
+		DeletionTimestamp:  e2tInstance.DeletionTimestamp,
+	}
+}
+
+func statusCode(err error) codes.Code {
+	switch common.GetErrorCode(err) {
+	case common.NotFound:
+		return codes.NotFound
+	case common.Validation:
+		return codes.InvalidArgument
+	case common.StorageUnavailable:
+		return codes.Unavailable
+	case common.CorruptData:
+		return codes.DataLoss
+	case common.Timeout:
+		if errors.Is(err, context.Canceled) {
+			return codes.Canceled
+		}
+		return codes.DeadlineExceeded
+	}
+	return codes.Internal
+}
+
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return status.Error(statusCode(err), err.Error())
+}
+
+func toError(err error) *Error {
+	return &Error{Code: uint32(statusCode(err)), Message: err.Error()}
+}
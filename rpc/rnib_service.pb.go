@@ -0,0 +1,3117 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//
+// This source code is part of the near-RT RIC (RAN Intelligent Controller)
+// platform project (RICP).
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.15.8
+// source: rnib_service.proto
+
+package rpc
+
+import (
+	entities "gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Error carries a per-entity error of a batch call. Code is a gRPC status code.
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Error) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetNodebRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InventoryName string `protobuf:"bytes,1,opt,name=inventory_name,json=inventoryName,proto3" json:"inventory_name,omitempty"`
+}
+
+func (x *GetNodebRequest) Reset() {
+	*x = GetNodebRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodebRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodebRequest) ProtoMessage() {}
+
+func (x *GetNodebRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodebRequest.ProtoReflect.Descriptor instead.
+func (*GetNodebRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetNodebRequest) GetInventoryName() string {
+	if x != nil {
+		return x.InventoryName
+	}
+	return ""
+}
+
+type GetNodebByGlobalNbIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeType   entities.Node_Type   `protobuf:"varint,1,opt,name=node_type,json=nodeType,proto3,enum=entities.Node_Type" json:"node_type,omitempty"`
+	GlobalNbId *entities.GlobalNbId `protobuf:"bytes,2,opt,name=global_nb_id,json=globalNbId,proto3" json:"global_nb_id,omitempty"`
+	CuUpId     string               `protobuf:"bytes,3,opt,name=cu_up_id,json=cuUpId,proto3" json:"cu_up_id,omitempty"`
+	DuId       string               `protobuf:"bytes,4,opt,name=du_id,json=duId,proto3" json:"du_id,omitempty"`
+}
+
+func (x *GetNodebByGlobalNbIdRequest) Reset() {
+	*x = GetNodebByGlobalNbIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodebByGlobalNbIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodebByGlobalNbIdRequest) ProtoMessage() {}
+
+func (x *GetNodebByGlobalNbIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodebByGlobalNbIdRequest.ProtoReflect.Descriptor instead.
+func (*GetNodebByGlobalNbIdRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetNodebByGlobalNbIdRequest) GetNodeType() entities.Node_Type {
+	if x != nil {
+		return x.NodeType
+	}
+	return entities.Node_UNKNOWN
+}
+
+func (x *GetNodebByGlobalNbIdRequest) GetGlobalNbId() *entities.GlobalNbId {
+	if x != nil {
+		return x.GlobalNbId
+	}
+	return nil
+}
+
+func (x *GetNodebByGlobalNbIdRequest) GetCuUpId() string {
+	if x != nil {
+		return x.CuUpId
+	}
+	return ""
+}
+
+func (x *GetNodebByGlobalNbIdRequest) GetDuId() string {
+	if x != nil {
+		return x.DuId
+	}
+	return ""
+}
+
+type GetNodebsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InventoryNames []string `protobuf:"bytes,1,rep,name=inventory_names,json=inventoryNames,proto3" json:"inventory_names,omitempty"`
+}
+
+func (x *GetNodebsRequest) Reset() {
+	*x = GetNodebsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodebsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodebsRequest) ProtoMessage() {}
+
+func (x *GetNodebsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodebsRequest.ProtoReflect.Descriptor instead.
+func (*GetNodebsRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetNodebsRequest) GetInventoryNames() []string {
+	if x != nil {
+		return x.InventoryNames
+	}
+	return nil
+}
+
+// NodebGlobalId mirrors reader.NodebGlobalId, the CU-UP or DU Id is set for a gNB-CU-UP or a gNB-DU
+type NodebGlobalId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GlobalNbId *entities.GlobalNbId `protobuf:"bytes,1,opt,name=global_nb_id,json=globalNbId,proto3" json:"global_nb_id,omitempty"`
+	CuUpId     string               `protobuf:"bytes,2,opt,name=cu_up_id,json=cuUpId,proto3" json:"cu_up_id,omitempty"`
+	DuId       string               `protobuf:"bytes,3,opt,name=du_id,json=duId,proto3" json:"du_id,omitempty"`
+}
+
+func (x *NodebGlobalId) Reset() {
+	*x = NodebGlobalId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodebGlobalId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodebGlobalId) ProtoMessage() {}
+
+func (x *NodebGlobalId) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodebGlobalId.ProtoReflect.Descriptor instead.
+func (*NodebGlobalId) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NodebGlobalId) GetGlobalNbId() *entities.GlobalNbId {
+	if x != nil {
+		return x.GlobalNbId
+	}
+	return nil
+}
+
+func (x *NodebGlobalId) GetCuUpId() string {
+	if x != nil {
+		return x.CuUpId
+	}
+	return ""
+}
+
+func (x *NodebGlobalId) GetDuId() string {
+	if x != nil {
+		return x.DuId
+	}
+	return ""
+}
+
+type GetNodebsByGlobalNbIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeType    entities.Node_Type `protobuf:"varint,1,opt,name=node_type,json=nodeType,proto3,enum=entities.Node_Type" json:"node_type,omitempty"`
+	GlobalNbIds []*NodebGlobalId   `protobuf:"bytes,2,rep,name=global_nb_ids,json=globalNbIds,proto3" json:"global_nb_ids,omitempty"`
+}
+
+func (x *GetNodebsByGlobalNbIdsRequest) Reset() {
+	*x = GetNodebsByGlobalNbIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodebsByGlobalNbIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodebsByGlobalNbIdsRequest) ProtoMessage() {}
+
+func (x *GetNodebsByGlobalNbIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodebsByGlobalNbIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetNodebsByGlobalNbIdsRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetNodebsByGlobalNbIdsRequest) GetNodeType() entities.Node_Type {
+	if x != nil {
+		return x.NodeType
+	}
+	return entities.Node_UNKNOWN
+}
+
+func (x *GetNodebsByGlobalNbIdsRequest) GetGlobalNbIds() []*NodebGlobalId {
+	if x != nil {
+		return x.GlobalNbIds
+	}
+	return nil
+}
+
+type GetNodebsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodebs map[string]*entities.NodebInfo `protobuf:"bytes,1,rep,name=nodebs,proto3" json:"nodebs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Errors map[string]*Error              `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetNodebsResponse) Reset() {
+	*x = GetNodebsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodebsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodebsResponse) ProtoMessage() {}
+
+func (x *GetNodebsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodebsResponse.ProtoReflect.Descriptor instead.
+func (*GetNodebsResponse) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetNodebsResponse) GetNodebs() map[string]*entities.NodebInfo {
+	if x != nil {
+		return x.Nodebs
+	}
+	return nil
+}
+
+func (x *GetNodebsResponse) GetErrors() map[string]*Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// NodebFilter mirrors reader.NodebFilter, zero valued fields match any nodeb
+type NodebFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionStatus   entities.ConnectionStatus `protobuf:"varint,1,opt,name=connection_status,json=connectionStatus,proto3,enum=entities.ConnectionStatus" json:"connection_status,omitempty"`
+	NodeType           entities.Node_Type        `protobuf:"varint,2,opt,name=node_type,json=nodeType,proto3,enum=entities.Node_Type" json:"node_type,omitempty"`
+	GnbType            entities.GnbType          `protobuf:"varint,3,opt,name=gnb_type,json=gnbType,proto3,enum=entities.GnbType" json:"gnb_type,omitempty"`
+	EnbType            entities.EnbType          `protobuf:"varint,4,opt,name=enb_type,json=enbType,proto3,enum=entities.EnbType" json:"enb_type,omitempty"`
+	GnbNodeType        string                    `protobuf:"bytes,5,opt,name=gnb_node_type,json=gnbNodeType,proto3" json:"gnb_node_type,omitempty"`
+	PlmnId             string                    `protobuf:"bytes,6,opt,name=plmn_id,json=plmnId,proto3" json:"plmn_id,omitempty"`
+	E2TInstanceAddress string                    `protobuf:"bytes,7,opt,name=e2t_instance_address,json=e2tInstanceAddress,proto3" json:"e2t_instance_address,omitempty"`
+	SetupFromNetwork   *bool                     `protobuf:"varint,8,opt,name=setup_from_network,json=setupFromNetwork,proto3,oneof" json:"setup_from_network,omitempty"`
+}
+
+func (x *NodebFilter) Reset() {
+	*x = NodebFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodebFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodebFilter) ProtoMessage() {}
+
+func (x *NodebFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodebFilter.ProtoReflect.Descriptor instead.
+func (*NodebFilter) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NodebFilter) GetConnectionStatus() entities.ConnectionStatus {
+	if x != nil {
+		return x.ConnectionStatus
+	}
+	return entities.ConnectionStatus_UNKNOWN_CONNECTION_STATUS
+}
+
+func (x *NodebFilter) GetNodeType() entities.Node_Type {
+	if x != nil {
+		return x.NodeType
+	}
+	return entities.Node_UNKNOWN
+}
+
+func (x *NodebFilter) GetGnbType() entities.GnbType {
+	if x != nil {
+		return x.GnbType
+	}
+	return entities.GnbType_UNKNOWN_GNB_TYPE
+}
+
+func (x *NodebFilter) GetEnbType() entities.EnbType {
+	if x != nil {
+		return x.EnbType
+	}
+	return entities.EnbType_UNKNOWN_ENB_TYPE
+}
+
+func (x *NodebFilter) GetGnbNodeType() string {
+	if x != nil {
+		return x.GnbNodeType
+	}
+	return ""
+}
+
+func (x *NodebFilter) GetPlmnId() string {
+	if x != nil {
+		return x.PlmnId
+	}
+	return ""
+}
+
+func (x *NodebFilter) GetE2TInstanceAddress() string {
+	if x != nil {
+		return x.E2TInstanceAddress
+	}
+	return ""
+}
+
+func (x *NodebFilter) GetSetupFromNetwork() bool {
+	if x != nil && x.SetupFromNetwork != nil {
+		return *x.SetupFromNetwork
+	}
+	return false
+}
+
+type NodebInfoList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodebs []*entities.NodebInfo `protobuf:"bytes,1,rep,name=nodebs,proto3" json:"nodebs,omitempty"`
+}
+
+func (x *NodebInfoList) Reset() {
+	*x = NodebInfoList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodebInfoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodebInfoList) ProtoMessage() {}
+
+func (x *NodebInfoList) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodebInfoList.ProtoReflect.Descriptor instead.
+func (*NodebInfoList) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NodebInfoList) GetNodebs() []*entities.NodebInfo {
+	if x != nil {
+		return x.Nodebs
+	}
+	return nil
+}
+
+type GetCellListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InventoryName string `protobuf:"bytes,1,opt,name=inventory_name,json=inventoryName,proto3" json:"inventory_name,omitempty"`
+}
+
+func (x *GetCellListRequest) Reset() {
+	*x = GetCellListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCellListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCellListRequest) ProtoMessage() {}
+
+func (x *GetCellListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCellListRequest.ProtoReflect.Descriptor instead.
+func (*GetCellListRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCellListRequest) GetInventoryName() string {
+	if x != nil {
+		return x.InventoryName
+	}
+	return ""
+}
+
+type NbIdentityList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NbIdentities []*entities.NbIdentity `protobuf:"bytes,1,rep,name=nb_identities,json=nbIdentities,proto3" json:"nb_identities,omitempty"`
+}
+
+func (x *NbIdentityList) Reset() {
+	*x = NbIdentityList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NbIdentityList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NbIdentityList) ProtoMessage() {}
+
+func (x *NbIdentityList) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NbIdentityList.ProtoReflect.Descriptor instead.
+func (*NbIdentityList) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NbIdentityList) GetNbIdentities() []*entities.NbIdentity {
+	if x != nil {
+		return x.NbIdentities
+	}
+	return nil
+}
+
+type PageNodebIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *PageNodebIdsRequest) Reset() {
+	*x = PageNodebIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PageNodebIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageNodebIdsRequest) ProtoMessage() {}
+
+func (x *PageNodebIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageNodebIdsRequest.ProtoReflect.Descriptor instead.
+func (*PageNodebIdsRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PageNodebIdsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type NbIdentityPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NbIdentities []*entities.NbIdentity `protobuf:"bytes,1,rep,name=nb_identities,json=nbIdentities,proto3" json:"nb_identities,omitempty"`
+	Total        int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *NbIdentityPage) Reset() {
+	*x = NbIdentityPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NbIdentityPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NbIdentityPage) ProtoMessage() {}
+
+func (x *NbIdentityPage) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NbIdentityPage.ProtoReflect.Descriptor instead.
+func (*NbIdentityPage) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NbIdentityPage) GetNbIdentities() []*entities.NbIdentity {
+	if x != nil {
+		return x.NbIdentities
+	}
+	return nil
+}
+
+func (x *NbIdentityPage) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type Count struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Count) Reset() {
+	*x = Count{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Count) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Count) ProtoMessage() {}
+
+func (x *Count) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Count.ProtoReflect.Descriptor instead.
+func (*Count) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Count) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// NodebStatistics mirrors reader.NodebStatistics
+type NodebStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total              int64            `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	ByConnectionStatus map[string]int64 `protobuf:"bytes,2,rep,name=by_connection_status,json=byConnectionStatus,proto3" json:"by_connection_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ByNodeType         map[string]int64 `protobuf:"bytes,3,rep,name=by_node_type,json=byNodeType,proto3" json:"by_node_type,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ByGnbNodeType      map[string]int64 `protobuf:"bytes,4,rep,name=by_gnb_node_type,json=byGnbNodeType,proto3" json:"by_gnb_node_type,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ByE2TInstance      map[string]int64 `protobuf:"bytes,5,rep,name=by_e2t_instance,json=byE2tInstance,proto3" json:"by_e2t_instance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LteCells           int64            `protobuf:"varint,6,opt,name=lte_cells,json=lteCells,proto3" json:"lte_cells,omitempty"`
+	NrCells            int64            `protobuf:"varint,7,opt,name=nr_cells,json=nrCells,proto3" json:"nr_cells,omitempty"`
+}
+
+func (x *NodebStatistics) Reset() {
+	*x = NodebStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodebStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodebStatistics) ProtoMessage() {}
+
+func (x *NodebStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodebStatistics.ProtoReflect.Descriptor instead.
+func (*NodebStatistics) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NodebStatistics) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *NodebStatistics) GetByConnectionStatus() map[string]int64 {
+	if x != nil {
+		return x.ByConnectionStatus
+	}
+	return nil
+}
+
+func (x *NodebStatistics) GetByNodeType() map[string]int64 {
+	if x != nil {
+		return x.ByNodeType
+	}
+	return nil
+}
+
+func (x *NodebStatistics) GetByGnbNodeType() map[string]int64 {
+	if x != nil {
+		return x.ByGnbNodeType
+	}
+	return nil
+}
+
+func (x *NodebStatistics) GetByE2TInstance() map[string]int64 {
+	if x != nil {
+		return x.ByE2TInstance
+	}
+	return nil
+}
+
+func (x *NodebStatistics) GetLteCells() int64 {
+	if x != nil {
+		return x.LteCells
+	}
+	return 0
+}
+
+func (x *NodebStatistics) GetNrCells() int64 {
+	if x != nil {
+		return x.NrCells
+	}
+	return 0
+}
+
+type GetCellRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InventoryName string `protobuf:"bytes,1,opt,name=inventory_name,json=inventoryName,proto3" json:"inventory_name,omitempty"`
+	Pci           uint32 `protobuf:"varint,2,opt,name=pci,proto3" json:"pci,omitempty"`
+}
+
+func (x *GetCellRequest) Reset() {
+	*x = GetCellRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCellRequest) ProtoMessage() {}
+
+func (x *GetCellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCellRequest.ProtoReflect.Descriptor instead.
+func (*GetCellRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCellRequest) GetInventoryName() string {
+	if x != nil {
+		return x.InventoryName
+	}
+	return ""
+}
+
+func (x *GetCellRequest) GetPci() uint32 {
+	if x != nil {
+		return x.Pci
+	}
+	return 0
+}
+
+type GetCellByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellType entities.Cell_Type `protobuf:"varint,1,opt,name=cell_type,json=cellType,proto3,enum=entities.Cell_Type" json:"cell_type,omitempty"`
+	CellId   string             `protobuf:"bytes,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+}
+
+func (x *GetCellByIdRequest) Reset() {
+	*x = GetCellByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCellByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCellByIdRequest) ProtoMessage() {}
+
+func (x *GetCellByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCellByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetCellByIdRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCellByIdRequest) GetCellType() entities.Cell_Type {
+	if x != nil {
+		return x.CellType
+	}
+	return entities.Cell_UNKNOWN_CELL
+}
+
+func (x *GetCellByIdRequest) GetCellId() string {
+	if x != nil {
+		return x.CellId
+	}
+	return ""
+}
+
+type GetCellByCgiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellType entities.Cell_Type `protobuf:"varint,1,opt,name=cell_type,json=cellType,proto3,enum=entities.Cell_Type" json:"cell_type,omitempty"`
+	Cgi      string             `protobuf:"bytes,2,opt,name=cgi,proto3" json:"cgi,omitempty"`
+}
+
+func (x *GetCellByCgiRequest) Reset() {
+	*x = GetCellByCgiRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCellByCgiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCellByCgiRequest) ProtoMessage() {}
+
+func (x *GetCellByCgiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCellByCgiRequest.ProtoReflect.Descriptor instead.
+func (*GetCellByCgiRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCellByCgiRequest) GetCellType() entities.Cell_Type {
+	if x != nil {
+		return x.CellType
+	}
+	return entities.Cell_UNKNOWN_CELL
+}
+
+func (x *GetCellByCgiRequest) GetCgi() string {
+	if x != nil {
+		return x.Cgi
+	}
+	return ""
+}
+
+type GetCellsByPlmnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlmnId string `protobuf:"bytes,1,opt,name=plmn_id,json=plmnId,proto3" json:"plmn_id,omitempty"`
+}
+
+func (x *GetCellsByPlmnRequest) Reset() {
+	*x = GetCellsByPlmnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCellsByPlmnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCellsByPlmnRequest) ProtoMessage() {}
+
+func (x *GetCellsByPlmnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCellsByPlmnRequest.ProtoReflect.Descriptor instead.
+func (*GetCellsByPlmnRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCellsByPlmnRequest) GetPlmnId() string {
+	if x != nil {
+		return x.PlmnId
+	}
+	return ""
+}
+
+type GetCellsByTacRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tac string `protobuf:"bytes,1,opt,name=tac,proto3" json:"tac,omitempty"`
+}
+
+func (x *GetCellsByTacRequest) Reset() {
+	*x = GetCellsByTacRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCellsByTacRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCellsByTacRequest) ProtoMessage() {}
+
+func (x *GetCellsByTacRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCellsByTacRequest.ProtoReflect.Descriptor instead.
+func (*GetCellsByTacRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCellsByTacRequest) GetTac() string {
+	if x != nil {
+		return x.Tac
+	}
+	return ""
+}
+
+type CellList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cells []*entities.Cell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *CellList) Reset() {
+	*x = CellList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellList) ProtoMessage() {}
+
+func (x *CellList) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellList.ProtoReflect.Descriptor instead.
+func (*CellList) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CellList) GetCells() []*entities.Cell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type GetRanLoadInformationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InventoryName string `protobuf:"bytes,1,opt,name=inventory_name,json=inventoryName,proto3" json:"inventory_name,omitempty"`
+}
+
+func (x *GetRanLoadInformationRequest) Reset() {
+	*x = GetRanLoadInformationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRanLoadInformationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRanLoadInformationRequest) ProtoMessage() {}
+
+func (x *GetRanLoadInformationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRanLoadInformationRequest.ProtoReflect.Descriptor instead.
+func (*GetRanLoadInformationRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRanLoadInformationRequest) GetInventoryName() string {
+	if x != nil {
+		return x.InventoryName
+	}
+	return ""
+}
+
+type E2TInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address            string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PodName            string   `protobuf:"bytes,2,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	AssociatedRanList  []string `protobuf:"bytes,3,rep,name=associated_ran_list,json=associatedRanList,proto3" json:"associated_ran_list,omitempty"`
+	KeepAliveTimestamp int64    `protobuf:"varint,4,opt,name=keep_alive_timestamp,json=keepAliveTimestamp,proto3" json:"keep_alive_timestamp,omitempty"`
+	State              string   `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	DeletionTimestamp  int64    `protobuf:"varint,6,opt,name=deletion_timestamp,json=deletionTimestamp,proto3" json:"deletion_timestamp,omitempty"`
+}
+
+func (x *E2TInstance) Reset() {
+	*x = E2TInstance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *E2TInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*E2TInstance) ProtoMessage() {}
+
+func (x *E2TInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use E2TInstance.ProtoReflect.Descriptor instead.
+func (*E2TInstance) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *E2TInstance) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *E2TInstance) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *E2TInstance) GetAssociatedRanList() []string {
+	if x != nil {
+		return x.AssociatedRanList
+	}
+	return nil
+}
+
+func (x *E2TInstance) GetKeepAliveTimestamp() int64 {
+	if x != nil {
+		return x.KeepAliveTimestamp
+	}
+	return 0
+}
+
+func (x *E2TInstance) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *E2TInstance) GetDeletionTimestamp() int64 {
+	if x != nil {
+		return x.DeletionTimestamp
+	}
+	return 0
+}
+
+type GetE2TInstanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *GetE2TInstanceRequest) Reset() {
+	*x = GetE2TInstanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetE2TInstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetE2TInstanceRequest) ProtoMessage() {}
+
+func (x *GetE2TInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetE2TInstanceRequest.ProtoReflect.Descriptor instead.
+func (*GetE2TInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetE2TInstanceRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type GetE2TInstancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *GetE2TInstancesRequest) Reset() {
+	*x = GetE2TInstancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetE2TInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetE2TInstancesRequest) ProtoMessage() {}
+
+func (x *GetE2TInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetE2TInstancesRequest.ProtoReflect.Descriptor instead.
+func (*GetE2TInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetE2TInstancesRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type E2TInstanceList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	E2TInstances []*E2TInstance `protobuf:"bytes,1,rep,name=e2t_instances,json=e2tInstances,proto3" json:"e2t_instances,omitempty"`
+}
+
+func (x *E2TInstanceList) Reset() {
+	*x = E2TInstanceList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *E2TInstanceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*E2TInstanceList) ProtoMessage() {}
+
+func (x *E2TInstanceList) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use E2TInstanceList.ProtoReflect.Descriptor instead.
+func (*E2TInstanceList) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *E2TInstanceList) GetE2TInstances() []*E2TInstance {
+	if x != nil {
+		return x.E2TInstances
+	}
+	return nil
+}
+
+type E2TAddressList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *E2TAddressList) Reset() {
+	*x = E2TAddressList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *E2TAddressList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*E2TAddressList) ProtoMessage() {}
+
+func (x *E2TAddressList) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use E2TAddressList.ProtoReflect.Descriptor instead.
+func (*E2TAddressList) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *E2TAddressList) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type GeneralConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnableRic bool `protobuf:"varint,1,opt,name=enable_ric,json=enableRic,proto3" json:"enable_ric,omitempty"`
+}
+
+func (x *GeneralConfiguration) Reset() {
+	*x = GeneralConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneralConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneralConfiguration) ProtoMessage() {}
+
+func (x *GeneralConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneralConfiguration.ProtoReflect.Descriptor instead.
+func (*GeneralConfiguration) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GeneralConfiguration) GetEnableRic() bool {
+	if x != nil {
+		return x.EnableRic
+	}
+	return false
+}
+
+type GetRanFunctionDefinitionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InventoryName string `protobuf:"bytes,1,opt,name=inventory_name,json=inventoryName,proto3" json:"inventory_name,omitempty"`
+	Oid           string `protobuf:"bytes,2,opt,name=oid,proto3" json:"oid,omitempty"`
+}
+
+func (x *GetRanFunctionDefinitionRequest) Reset() {
+	*x = GetRanFunctionDefinitionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRanFunctionDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRanFunctionDefinitionRequest) ProtoMessage() {}
+
+func (x *GetRanFunctionDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRanFunctionDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*GetRanFunctionDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetRanFunctionDefinitionRequest) GetInventoryName() string {
+	if x != nil {
+		return x.InventoryName
+	}
+	return ""
+}
+
+func (x *GetRanFunctionDefinitionRequest) GetOid() string {
+	if x != nil {
+		return x.Oid
+	}
+	return ""
+}
+
+type RanFunctionDefinitionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RanFunctionDefinitions []string `protobuf:"bytes,1,rep,name=ran_function_definitions,json=ranFunctionDefinitions,proto3" json:"ran_function_definitions,omitempty"`
+}
+
+func (x *RanFunctionDefinitionList) Reset() {
+	*x = RanFunctionDefinitionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RanFunctionDefinitionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RanFunctionDefinitionList) ProtoMessage() {}
+
+func (x *RanFunctionDefinitionList) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RanFunctionDefinitionList.ProtoReflect.Descriptor instead.
+func (*RanFunctionDefinitionList) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RanFunctionDefinitionList) GetRanFunctionDefinitions() []string {
+	if x != nil {
+		return x.RanFunctionDefinitions
+	}
+	return nil
+}
+
+type GetRanFunctionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InventoryName string `protobuf:"bytes,1,opt,name=inventory_name,json=inventoryName,proto3" json:"inventory_name,omitempty"`
+}
+
+func (x *GetRanFunctionsRequest) Reset() {
+	*x = GetRanFunctionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRanFunctionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRanFunctionsRequest) ProtoMessage() {}
+
+func (x *GetRanFunctionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRanFunctionsRequest.ProtoReflect.Descriptor instead.
+func (*GetRanFunctionsRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetRanFunctionsRequest) GetInventoryName() string {
+	if x != nil {
+		return x.InventoryName
+	}
+	return ""
+}
+
+type RanFunctionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RanFunctions []*entities.RanFunction `protobuf:"bytes,1,rep,name=ran_functions,json=ranFunctions,proto3" json:"ran_functions,omitempty"`
+}
+
+func (x *RanFunctionList) Reset() {
+	*x = RanFunctionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RanFunctionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RanFunctionList) ProtoMessage() {}
+
+func (x *RanFunctionList) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RanFunctionList.ProtoReflect.Descriptor instead.
+func (*RanFunctionList) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RanFunctionList) GetRanFunctions() []*entities.RanFunction {
+	if x != nil {
+		return x.RanFunctions
+	}
+	return nil
+}
+
+type GetRanFunctionByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InventoryName string `protobuf:"bytes,1,opt,name=inventory_name,json=inventoryName,proto3" json:"inventory_name,omitempty"`
+	RanFunctionId uint32 `protobuf:"varint,2,opt,name=ran_function_id,json=ranFunctionId,proto3" json:"ran_function_id,omitempty"`
+}
+
+func (x *GetRanFunctionByIdRequest) Reset() {
+	*x = GetRanFunctionByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRanFunctionByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRanFunctionByIdRequest) ProtoMessage() {}
+
+func (x *GetRanFunctionByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRanFunctionByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetRanFunctionByIdRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetRanFunctionByIdRequest) GetInventoryName() string {
+	if x != nil {
+		return x.InventoryName
+	}
+	return ""
+}
+
+func (x *GetRanFunctionByIdRequest) GetRanFunctionId() uint32 {
+	if x != nil {
+		return x.RanFunctionId
+	}
+	return 0
+}
+
+type FindNodebsSupportingRanFunctionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Oid         string `protobuf:"bytes,1,opt,name=oid,proto3" json:"oid,omitempty"`
+	MinRevision uint32 `protobuf:"varint,2,opt,name=min_revision,json=minRevision,proto3" json:"min_revision,omitempty"`
+}
+
+func (x *FindNodebsSupportingRanFunctionRequest) Reset() {
+	*x = FindNodebsSupportingRanFunctionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindNodebsSupportingRanFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNodebsSupportingRanFunctionRequest) ProtoMessage() {}
+
+func (x *FindNodebsSupportingRanFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNodebsSupportingRanFunctionRequest.ProtoReflect.Descriptor instead.
+func (*FindNodebsSupportingRanFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FindNodebsSupportingRanFunctionRequest) GetOid() string {
+	if x != nil {
+		return x.Oid
+	}
+	return ""
+}
+
+func (x *FindNodebsSupportingRanFunctionRequest) GetMinRevision() uint32 {
+	if x != nil {
+		return x.MinRevision
+	}
+	return 0
+}
+
+type WatchNodebsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channels defaults to the RAN manipulation and state change channels
+	Channels   []string `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	FetchNodeb bool     `protobuf:"varint,2,opt,name=fetch_nodeb,json=fetchNodeb,proto3" json:"fetch_nodeb,omitempty"`
+}
+
+func (x *WatchNodebsRequest) Reset() {
+	*x = WatchNodebsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchNodebsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNodebsRequest) ProtoMessage() {}
+
+func (x *WatchNodebsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNodebsRequest.ProtoReflect.Descriptor instead.
+func (*WatchNodebsRequest) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WatchNodebsRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *WatchNodebsRequest) GetFetchNodeb() bool {
+	if x != nil {
+		return x.FetchNodeb
+	}
+	return false
+}
+
+type NodebEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel       string              `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	InventoryName string              `protobuf:"bytes,2,opt,name=inventory_name,json=inventoryName,proto3" json:"inventory_name,omitempty"`
+	Kind          string              `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Nodeb         *entities.NodebInfo `protobuf:"bytes,4,opt,name=nodeb,proto3" json:"nodeb,omitempty"`
+	Error         *Error              `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *NodebEvent) Reset() {
+	*x = NodebEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rnib_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodebEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodebEvent) ProtoMessage() {}
+
+func (x *NodebEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rnib_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodebEvent.ProtoReflect.Descriptor instead.
+func (*NodebEvent) Descriptor() ([]byte, []int) {
+	return file_rnib_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *NodebEvent) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *NodebEvent) GetInventoryName() string {
+	if x != nil {
+		return x.InventoryName
+	}
+	return ""
+}
+
+func (x *NodebEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NodebEvent) GetNodeb() *entities.NodebInfo {
+	if x != nil {
+		return x.Nodeb
+	}
+	return nil
+}
+
+func (x *NodebEvent) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_rnib_service_proto protoreflect.FileDescriptor
+
+var file_rnib_service_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x72, 0x6e, 0x69, 0x62, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x72, 0x70, 0x63, 0x1a, 0x10, 0x6e, 0x6f, 0x64, 0x65, 0x62,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x6e, 0x62, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e,
+	0x6e, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a,
+	0x63, 0x65, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x63, 0x65, 0x6c, 0x6c,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x72, 0x61, 0x6e, 0x5f, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x72, 0x61, 0x6e, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x35, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0xb6, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x42, 0x79,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4e, 0x62, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6e, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4e, 0x62, 0x49, 0x64, 0x52,
+	0x0a, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4e, 0x62, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x08, 0x63,
+	0x75, 0x5f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x55, 0x70, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x64, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x75, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x76, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x62,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x67, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x5f, 0x6e, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x4e, 0x62, 0x49, 0x64, 0x52, 0x0a, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4e, 0x62, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x08, 0x63, 0x75, 0x5f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x55, 0x70, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x64, 0x75,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x75, 0x49, 0x64, 0x22,
+	0x89, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x42, 0x79, 0x47,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4e, 0x62, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6e, 0x62,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x49, 0x64, 0x52, 0x0b,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4e, 0x62, 0x49, 0x64, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3a, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x12, 0x3a, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x4e, 0x0a, 0x0b, 0x4e, 0x6f, 0x64,
+	0x65, 0x62, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x0b, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x9d, 0x03, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x47, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x67,
+	0x6e, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x47, 0x6e, 0x62, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x07, 0x67, 0x6e, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x65, 0x6e, 0x62,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07,
+	0x65, 0x6e, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x67, 0x6e, 0x62, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x67, 0x6e, 0x62, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6c, 0x6d, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c,
+	0x6d, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x32, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x65, 0x32, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x12, 0x73, 0x65, 0x74, 0x75, 0x70, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x74, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x73, 0x65,
+	0x74, 0x75, 0x70, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x22, 0x3c, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x2b, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x22, 0x3b,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x0e, 0x4e,
+	0x62, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a,
+	0x0d, 0x6e, 0x62, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e,
+	0x4e, 0x62, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x6e, 0x62, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x13, 0x50, 0x61, 0x67, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x62, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x61, 0x0a, 0x0e,
+	0x4e, 0x62, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x67, 0x65, 0x12, 0x39,
+	0x0a, 0x0d, 0x6e, 0x62, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x2e, 0x4e, 0x62, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x6e, 0x62, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22,
+	0x1d, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb4,
+	0x05, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x5e, 0x0a, 0x14, 0x62, 0x79, 0x5f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x62, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x79, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x62, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x62, 0x79, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x62, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x50, 0x0a, 0x10, 0x62, 0x79, 0x5f, 0x67, 0x6e, 0x62, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x42, 0x79, 0x47, 0x6e, 0x62, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0d, 0x62, 0x79, 0x47, 0x6e, 0x62, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x62, 0x79, 0x5f, 0x65, 0x32, 0x74, 0x5f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x42, 0x79, 0x45, 0x32, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x62, 0x79, 0x45, 0x32, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x74, 0x65, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x72, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6e, 0x72, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x42,
+	0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x40, 0x0a, 0x12, 0x42, 0x79, 0x47, 0x6e, 0x62, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x42, 0x79, 0x45, 0x32, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x63, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x63, 0x69,
+	0x22, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x63, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c, 0x49,
+	0x64, 0x22, 0x59, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x42, 0x79, 0x43, 0x67,
+	0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x6c,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x63, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x67,
+	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x67, 0x69, 0x22, 0x30, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x42, 0x79, 0x50, 0x6c, 0x6d, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x6d, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x22, 0x28,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x42, 0x79, 0x54, 0x61, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x63, 0x22, 0x30, 0x0a, 0x08, 0x43, 0x65, 0x6c, 0x6c,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x43,
+	0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x45, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0xe9, 0x01, 0x0a, 0x0b, 0x45, 0x32, 0x54, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70,
+	0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x61,
+	0x6c, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d,
+	0x0a, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x31, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x45, 0x32, 0x54, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x36, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x32, 0x54, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x0f, 0x45, 0x32, 0x54, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0d, 0x65,
+	0x32, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x32, 0x54, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x0c, 0x65, 0x32, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x22, 0x2e, 0x0a, 0x0e, 0x45, 0x32, 0x54, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x22, 0x35, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x69, 0x63, 0x22, 0x5a, 0x0a, 0x1f, 0x47, 0x65, 0x74,
+	0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6f, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x19, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x61, 0x6e, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x72, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3f, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a,
+	0x0f, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x61, 0x6e, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x2e, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x72, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6a, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x72, 0x61, 0x6e, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x61, 0x6e, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x26, 0x46, 0x69, 0x6e, 0x64,
+	0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6f, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x66, 0x65, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x22, 0xae, 0x01, 0x0a, 0x0a, 0x4e,
+	0x6f, 0x64, 0x65, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x29,
+	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x62, 0x12, 0x20, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xde, 0x0e, 0x0a, 0x0b,
+	0x52, 0x4e, 0x69, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x4d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x42, 0x79,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4e, 0x62, 0x49, 0x64, 0x12, 0x20, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x42, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x4e, 0x62, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x3a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x12, 0x15,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x42, 0x79, 0x47, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x4e, 0x62, 0x49, 0x64, 0x73, 0x12, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x42, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4e,
+	0x62, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x62,
+	0x73, 0x12, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x1a, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x49,
+	0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x4e,
+	0x6f, 0x64, 0x65, 0x62, 0x49, 0x64, 0x73, 0x12, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x4e, 0x62, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x37,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x6e, 0x62, 0x49, 0x64, 0x73, 0x12, 0x0a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x62, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x62, 0x49, 0x64, 0x73, 0x12, 0x0a, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x62, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x6e, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0a,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0a, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x45, 0x6e, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0a, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x62, 0x73, 0x12, 0x0a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x0a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x12, 0x0a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x13,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x43,
+	0x65, 0x6c, 0x6c, 0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x42, 0x79,
+	0x49, 0x64, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c,
+	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x38, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x42, 0x79, 0x43, 0x67, 0x69, 0x12, 0x18, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x42, 0x79, 0x43, 0x67, 0x69, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x3b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c,
+	0x73, 0x42, 0x79, 0x50, 0x6c, 0x6d, 0x6e, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x42, 0x79, 0x50, 0x6c, 0x6d, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x42, 0x79,
+	0x54, 0x61, 0x63, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c,
+	0x6c, 0x73, 0x42, 0x79, 0x54, 0x61, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x49, 0x64, 0x73,
+	0x12, 0x0a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x4e, 0x62, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x50, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x49, 0x64,
+	0x73, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x62, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x4e, 0x62, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x67, 0x65,
+	0x30, 0x01, 0x12, 0x58, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x4c, 0x6f, 0x61, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x52, 0x61, 0x6e, 0x4c, 0x6f, 0x61,
+	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x45, 0x32, 0x54, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x32, 0x54, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x32, 0x54, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x45, 0x32, 0x54, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x1b, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x32, 0x54, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x32, 0x54, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x32, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x32, 0x54, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x0a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x32, 0x54, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0a, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x2e, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a,
+	0x1f, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2b, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x62,
+	0x73, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x6e, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x39, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x62, 0x73,
+	0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65,
+	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x32, 0x5a, 0x30,
+	0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x2e, 0x6f, 0x2d, 0x72, 0x61, 0x6e, 0x2d, 0x73, 0x63, 0x2e,
+	0x6f, 0x72, 0x67, 0x2f, 0x72, 0x2f, 0x72, 0x69, 0x63, 0x2d, 0x70, 0x6c, 0x74, 0x2f, 0x6e, 0x6f,
+	0x64, 0x65, 0x62, 0x2d, 0x72, 0x6e, 0x69, 0x62, 0x2e, 0x67, 0x69, 0x74, 0x2f, 0x72, 0x70, 0x63,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rnib_service_proto_rawDescOnce sync.Once
+	file_rnib_service_proto_rawDescData = file_rnib_service_proto_rawDesc
+)
+
+func file_rnib_service_proto_rawDescGZIP() []byte {
+	file_rnib_service_proto_rawDescOnce.Do(func() {
+		file_rnib_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_rnib_service_proto_rawDescData)
+	})
+	return file_rnib_service_proto_rawDescData
+}
+
+var file_rnib_service_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_rnib_service_proto_goTypes = []interface{}{
+	(*Empty)(nil),                                  // 0: rpc.Empty
+	(*Error)(nil),                                  // 1: rpc.Error
+	(*GetNodebRequest)(nil),                        // 2: rpc.GetNodebRequest
+	(*GetNodebByGlobalNbIdRequest)(nil),            // 3: rpc.GetNodebByGlobalNbIdRequest
+	(*GetNodebsRequest)(nil),                       // 4: rpc.GetNodebsRequest
+	(*NodebGlobalId)(nil),                          // 5: rpc.NodebGlobalId
+	(*GetNodebsByGlobalNbIdsRequest)(nil),          // 6: rpc.GetNodebsByGlobalNbIdsRequest
+	(*GetNodebsResponse)(nil),                      // 7: rpc.GetNodebsResponse
+	(*NodebFilter)(nil),                            // 8: rpc.NodebFilter
+	(*NodebInfoList)(nil),                          // 9: rpc.NodebInfoList
+	(*GetCellListRequest)(nil),                     // 10: rpc.GetCellListRequest
+	(*NbIdentityList)(nil),                         // 11: rpc.NbIdentityList
+	(*PageNodebIdsRequest)(nil),                    // 12: rpc.PageNodebIdsRequest
+	(*NbIdentityPage)(nil),                         // 13: rpc.NbIdentityPage
+	(*Count)(nil),                                  // 14: rpc.Count
+	(*NodebStatistics)(nil),                        // 15: rpc.NodebStatistics
+	(*GetCellRequest)(nil),                         // 16: rpc.GetCellRequest
+	(*GetCellByIdRequest)(nil),                     // 17: rpc.GetCellByIdRequest
+	(*GetCellByCgiRequest)(nil),                    // 18: rpc.GetCellByCgiRequest
+	(*GetCellsByPlmnRequest)(nil),                  // 19: rpc.GetCellsByPlmnRequest
+	(*GetCellsByTacRequest)(nil),                   // 20: rpc.GetCellsByTacRequest
+	(*CellList)(nil),                               // 21: rpc.CellList
+	(*GetRanLoadInformationRequest)(nil),           // 22: rpc.GetRanLoadInformationRequest
+	(*E2TInstance)(nil),                            // 23: rpc.E2TInstance
+	(*GetE2TInstanceRequest)(nil),                  // 24: rpc.GetE2TInstanceRequest
+	(*GetE2TInstancesRequest)(nil),                 // 25: rpc.GetE2TInstancesRequest
+	(*E2TInstanceList)(nil),                        // 26: rpc.E2TInstanceList
+	(*E2TAddressList)(nil),                         // 27: rpc.E2TAddressList
+	(*GeneralConfiguration)(nil),                   // 28: rpc.GeneralConfiguration
+	(*GetRanFunctionDefinitionRequest)(nil),        // 29: rpc.GetRanFunctionDefinitionRequest
+	(*RanFunctionDefinitionList)(nil),              // 30: rpc.RanFunctionDefinitionList
+	(*GetRanFunctionsRequest)(nil),                 // 31: rpc.GetRanFunctionsRequest
+	(*RanFunctionList)(nil),                        // 32: rpc.RanFunctionList
+	(*GetRanFunctionByIdRequest)(nil),              // 33: rpc.GetRanFunctionByIdRequest
+	(*FindNodebsSupportingRanFunctionRequest)(nil), // 34: rpc.FindNodebsSupportingRanFunctionRequest
+	(*WatchNodebsRequest)(nil),                     // 35: rpc.WatchNodebsRequest
+	(*NodebEvent)(nil),                             // 36: rpc.NodebEvent
+	nil,                                            // 37: rpc.GetNodebsResponse.NodebsEntry
+	nil,                                            // 38: rpc.GetNodebsResponse.ErrorsEntry
+	nil,                                            // 39: rpc.NodebStatistics.ByConnectionStatusEntry
+	nil,                                            // 40: rpc.NodebStatistics.ByNodeTypeEntry
+	nil,                                            // 41: rpc.NodebStatistics.ByGnbNodeTypeEntry
+	nil,                                            // 42: rpc.NodebStatistics.ByE2tInstanceEntry
+	(entities.Node_Type)(0),                        // 43: entities.Node.Type
+	(*entities.GlobalNbId)(nil),                    // 44: entities.GlobalNbId
+	(entities.ConnectionStatus)(0),                 // 45: entities.ConnectionStatus
+	(entities.GnbType)(0),                          // 46: entities.GnbType
+	(entities.EnbType)(0),                          // 47: entities.EnbType
+	(*entities.NodebInfo)(nil),                     // 48: entities.NodebInfo
+	(*entities.NbIdentity)(nil),                    // 49: entities.NbIdentity
+	(entities.Cell_Type)(0),                        // 50: entities.Cell.Type
+	(*entities.Cell)(nil),                          // 51: entities.Cell
+	(*entities.RanFunction)(nil),                   // 52: entities.RanFunction
+	(*entities.Cells)(nil),                         // 53: entities.Cells
+	(*entities.RanLoadInformation)(nil),            // 54: entities.RanLoadInformation
+}
+var file_rnib_service_proto_depIdxs = []int32{
+	43, // 0: rpc.GetNodebByGlobalNbIdRequest.node_type:type_name -> entities.Node.Type
+	44, // 1: rpc.GetNodebByGlobalNbIdRequest.global_nb_id:type_name -> entities.GlobalNbId
+	44, // 2: rpc.NodebGlobalId.global_nb_id:type_name -> entities.GlobalNbId
+	43, // 3: rpc.GetNodebsByGlobalNbIdsRequest.node_type:type_name -> entities.Node.Type
+	5,  // 4: rpc.GetNodebsByGlobalNbIdsRequest.global_nb_ids:type_name -> rpc.NodebGlobalId
+	37, // 5: rpc.GetNodebsResponse.nodebs:type_name -> rpc.GetNodebsResponse.NodebsEntry
+	38, // 6: rpc.GetNodebsResponse.errors:type_name -> rpc.GetNodebsResponse.ErrorsEntry
+	45, // 7: rpc.NodebFilter.connection_status:type_name -> entities.ConnectionStatus
+	43, // 8: rpc.NodebFilter.node_type:type_name -> entities.Node.Type
+	46, // 9: rpc.NodebFilter.gnb_type:type_name -> entities.GnbType
+	47, // 10: rpc.NodebFilter.enb_type:type_name -> entities.EnbType
+	48, // 11: rpc.NodebInfoList.nodebs:type_name -> entities.NodebInfo
+	49, // 12: rpc.NbIdentityList.nb_identities:type_name -> entities.NbIdentity
+	49, // 13: rpc.NbIdentityPage.nb_identities:type_name -> entities.NbIdentity
+	39, // 14: rpc.NodebStatistics.by_connection_status:type_name -> rpc.NodebStatistics.ByConnectionStatusEntry
+	40, // 15: rpc.NodebStatistics.by_node_type:type_name -> rpc.NodebStatistics.ByNodeTypeEntry
+	41, // 16: rpc.NodebStatistics.by_gnb_node_type:type_name -> rpc.NodebStatistics.ByGnbNodeTypeEntry
+	42, // 17: rpc.NodebStatistics.by_e2t_instance:type_name -> rpc.NodebStatistics.ByE2tInstanceEntry
+	50, // 18: rpc.GetCellByIdRequest.cell_type:type_name -> entities.Cell.Type
+	50, // 19: rpc.GetCellByCgiRequest.cell_type:type_name -> entities.Cell.Type
+	51, // 20: rpc.CellList.cells:type_name -> entities.Cell
+	23, // 21: rpc.E2TInstanceList.e2t_instances:type_name -> rpc.E2TInstance
+	52, // 22: rpc.RanFunctionList.ran_functions:type_name -> entities.RanFunction
+	48, // 23: rpc.NodebEvent.nodeb:type_name -> entities.NodebInfo
+	1,  // 24: rpc.NodebEvent.error:type_name -> rpc.Error
+	48, // 25: rpc.GetNodebsResponse.NodebsEntry.value:type_name -> entities.NodebInfo
+	1,  // 26: rpc.GetNodebsResponse.ErrorsEntry.value:type_name -> rpc.Error
+	2,  // 27: rpc.RNibService.GetNodeb:input_type -> rpc.GetNodebRequest
+	3,  // 28: rpc.RNibService.GetNodebByGlobalNbId:input_type -> rpc.GetNodebByGlobalNbIdRequest
+	4,  // 29: rpc.RNibService.GetNodebs:input_type -> rpc.GetNodebsRequest
+	6,  // 30: rpc.RNibService.GetNodebsByGlobalNbIds:input_type -> rpc.GetNodebsByGlobalNbIdsRequest
+	8,  // 31: rpc.RNibService.FindNodebs:input_type -> rpc.NodebFilter
+	8,  // 32: rpc.RNibService.FindNodebIds:input_type -> rpc.NodebFilter
+	10, // 33: rpc.RNibService.GetCellList:input_type -> rpc.GetCellListRequest
+	0,  // 34: rpc.RNibService.GetListGnbIds:input_type -> rpc.Empty
+	0,  // 35: rpc.RNibService.GetListEnbIds:input_type -> rpc.Empty
+	0,  // 36: rpc.RNibService.GetCountGnbList:input_type -> rpc.Empty
+	0,  // 37: rpc.RNibService.GetCountEnbList:input_type -> rpc.Empty
+	0,  // 38: rpc.RNibService.GetCountNodebs:input_type -> rpc.Empty
+	0,  // 39: rpc.RNibService.GetNodebStatistics:input_type -> rpc.Empty
+	16, // 40: rpc.RNibService.GetCell:input_type -> rpc.GetCellRequest
+	17, // 41: rpc.RNibService.GetCellById:input_type -> rpc.GetCellByIdRequest
+	18, // 42: rpc.RNibService.GetCellByCgi:input_type -> rpc.GetCellByCgiRequest
+	19, // 43: rpc.RNibService.GetCellsByPlmn:input_type -> rpc.GetCellsByPlmnRequest
+	20, // 44: rpc.RNibService.GetCellsByTac:input_type -> rpc.GetCellsByTacRequest
+	0,  // 45: rpc.RNibService.GetListNodebIds:input_type -> rpc.Empty
+	12, // 46: rpc.RNibService.PageNodebIds:input_type -> rpc.PageNodebIdsRequest
+	22, // 47: rpc.RNibService.GetRanLoadInformation:input_type -> rpc.GetRanLoadInformationRequest
+	24, // 48: rpc.RNibService.GetE2TInstance:input_type -> rpc.GetE2TInstanceRequest
+	25, // 49: rpc.RNibService.GetE2TInstances:input_type -> rpc.GetE2TInstancesRequest
+	0,  // 50: rpc.RNibService.GetE2TAddresses:input_type -> rpc.Empty
+	0,  // 51: rpc.RNibService.GetGeneralConfiguration:input_type -> rpc.Empty
+	29, // 52: rpc.RNibService.GetRanFunctionDefinition:input_type -> rpc.GetRanFunctionDefinitionRequest
+	31, // 53: rpc.RNibService.GetRanFunctions:input_type -> rpc.GetRanFunctionsRequest
+	33, // 54: rpc.RNibService.GetRanFunctionById:input_type -> rpc.GetRanFunctionByIdRequest
+	34, // 55: rpc.RNibService.FindNodebsSupportingRanFunction:input_type -> rpc.FindNodebsSupportingRanFunctionRequest
+	35, // 56: rpc.RNibService.WatchNodebs:input_type -> rpc.WatchNodebsRequest
+	48, // 57: rpc.RNibService.GetNodeb:output_type -> entities.NodebInfo
+	48, // 58: rpc.RNibService.GetNodebByGlobalNbId:output_type -> entities.NodebInfo
+	7,  // 59: rpc.RNibService.GetNodebs:output_type -> rpc.GetNodebsResponse
+	7,  // 60: rpc.RNibService.GetNodebsByGlobalNbIds:output_type -> rpc.GetNodebsResponse
+	9,  // 61: rpc.RNibService.FindNodebs:output_type -> rpc.NodebInfoList
+	11, // 62: rpc.RNibService.FindNodebIds:output_type -> rpc.NbIdentityList
+	53, // 63: rpc.RNibService.GetCellList:output_type -> entities.Cells
+	11, // 64: rpc.RNibService.GetListGnbIds:output_type -> rpc.NbIdentityList
+	11, // 65: rpc.RNibService.GetListEnbIds:output_type -> rpc.NbIdentityList
+	14, // 66: rpc.RNibService.GetCountGnbList:output_type -> rpc.Count
+	14, // 67: rpc.RNibService.GetCountEnbList:output_type -> rpc.Count
+	14, // 68: rpc.RNibService.GetCountNodebs:output_type -> rpc.Count
+	15, // 69: rpc.RNibService.GetNodebStatistics:output_type -> rpc.NodebStatistics
+	51, // 70: rpc.RNibService.GetCell:output_type -> entities.Cell
+	51, // 71: rpc.RNibService.GetCellById:output_type -> entities.Cell
+	51, // 72: rpc.RNibService.GetCellByCgi:output_type -> entities.Cell
+	21, // 73: rpc.RNibService.GetCellsByPlmn:output_type -> rpc.CellList
+	21, // 74: rpc.RNibService.GetCellsByTac:output_type -> rpc.CellList
+	11, // 75: rpc.RNibService.GetListNodebIds:output_type -> rpc.NbIdentityList
+	13, // 76: rpc.RNibService.PageNodebIds:output_type -> rpc.NbIdentityPage
+	54, // 77: rpc.RNibService.GetRanLoadInformation:output_type -> entities.RanLoadInformation
+	23, // 78: rpc.RNibService.GetE2TInstance:output_type -> rpc.E2TInstance
+	26, // 79: rpc.RNibService.GetE2TInstances:output_type -> rpc.E2TInstanceList
+	27, // 80: rpc.RNibService.GetE2TAddresses:output_type -> rpc.E2TAddressList
+	28, // 81: rpc.RNibService.GetGeneralConfiguration:output_type -> rpc.GeneralConfiguration
+	30, // 82: rpc.RNibService.GetRanFunctionDefinition:output_type -> rpc.RanFunctionDefinitionList
+	32, // 83: rpc.RNibService.GetRanFunctions:output_type -> rpc.RanFunctionList
+	52, // 84: rpc.RNibService.GetRanFunctionById:output_type -> entities.RanFunction
+	9,  // 85: rpc.RNibService.FindNodebsSupportingRanFunction:output_type -> rpc.NodebInfoList
+	36, // 86: rpc.RNibService.WatchNodebs:output_type -> rpc.NodebEvent
+	57, // [57:87] is the sub-list for method output_type
+	27, // [27:57] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_rnib_service_proto_init() }
+func file_rnib_service_proto_init() {
+	if File_rnib_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rnib_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodebRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodebByGlobalNbIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodebsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodebGlobalId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodebsByGlobalNbIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodebsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodebFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodebInfoList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCellListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NbIdentityList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PageNodebIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NbIdentityPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Count); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodebStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCellRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCellByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCellByCgiRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCellsByPlmnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCellsByTacRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CellList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRanLoadInformationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*E2TInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetE2TInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetE2TInstancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*E2TInstanceList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*E2TAddressList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneralConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRanFunctionDefinitionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RanFunctionDefinitionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRanFunctionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RanFunctionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRanFunctionByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindNodebsSupportingRanFunctionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchNodebsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rnib_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodebEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rnib_service_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rnib_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   43,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rnib_service_proto_goTypes,
+		DependencyIndexes: file_rnib_service_proto_depIdxs,
+		MessageInfos:      file_rnib_service_proto_msgTypes,
+	}.Build()
+	File_rnib_service_proto = out.File
+	file_rnib_service_proto_rawDesc = nil
+	file_rnib_service_proto_goTypes = nil
+	file_rnib_service_proto_depIdxs = nil
+}
@@ -0,0 +1,1205 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package rpc
+
+import (
+	context "context"
+	entities "gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RNibServiceClient is the client API for RNibService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RNibServiceClient interface {
+	GetNodeb(ctx context.Context, in *GetNodebRequest, opts ...grpc.CallOption) (*entities.NodebInfo, error)
+	GetNodebByGlobalNbId(ctx context.Context, in *GetNodebByGlobalNbIdRequest, opts ...grpc.CallOption) (*entities.NodebInfo, error)
+	GetNodebs(ctx context.Context, in *GetNodebsRequest, opts ...grpc.CallOption) (*GetNodebsResponse, error)
+	GetNodebsByGlobalNbIds(ctx context.Context, in *GetNodebsByGlobalNbIdsRequest, opts ...grpc.CallOption) (*GetNodebsResponse, error)
+	FindNodebs(ctx context.Context, in *NodebFilter, opts ...grpc.CallOption) (*NodebInfoList, error)
+	FindNodebIds(ctx context.Context, in *NodebFilter, opts ...grpc.CallOption) (*NbIdentityList, error)
+	GetCellList(ctx context.Context, in *GetCellListRequest, opts ...grpc.CallOption) (*entities.Cells, error)
+	GetListGnbIds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NbIdentityList, error)
+	GetListEnbIds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NbIdentityList, error)
+	GetCountGnbList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Count, error)
+	GetCountEnbList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Count, error)
+	GetCountNodebs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Count, error)
+	GetNodebStatistics(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodebStatistics, error)
+	GetCell(ctx context.Context, in *GetCellRequest, opts ...grpc.CallOption) (*entities.Cell, error)
+	GetCellById(ctx context.Context, in *GetCellByIdRequest, opts ...grpc.CallOption) (*entities.Cell, error)
+	GetCellByCgi(ctx context.Context, in *GetCellByCgiRequest, opts ...grpc.CallOption) (*entities.Cell, error)
+	GetCellsByPlmn(ctx context.Context, in *GetCellsByPlmnRequest, opts ...grpc.CallOption) (*CellList, error)
+	GetCellsByTac(ctx context.Context, in *GetCellsByTacRequest, opts ...grpc.CallOption) (*CellList, error)
+	GetListNodebIds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NbIdentityList, error)
+	// PageNodebIds streams a first page holding only the total, followed by the pages of identities
+	PageNodebIds(ctx context.Context, in *PageNodebIdsRequest, opts ...grpc.CallOption) (RNibService_PageNodebIdsClient, error)
+	GetRanLoadInformation(ctx context.Context, in *GetRanLoadInformationRequest, opts ...grpc.CallOption) (*entities.RanLoadInformation, error)
+	GetE2TInstance(ctx context.Context, in *GetE2TInstanceRequest, opts ...grpc.CallOption) (*E2TInstance, error)
+	GetE2TInstances(ctx context.Context, in *GetE2TInstancesRequest, opts ...grpc.CallOption) (*E2TInstanceList, error)
+	GetE2TAddresses(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*E2TAddressList, error)
+	GetGeneralConfiguration(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GeneralConfiguration, error)
+	GetRanFunctionDefinition(ctx context.Context, in *GetRanFunctionDefinitionRequest, opts ...grpc.CallOption) (*RanFunctionDefinitionList, error)
+	GetRanFunctions(ctx context.Context, in *GetRanFunctionsRequest, opts ...grpc.CallOption) (*RanFunctionList, error)
+	GetRanFunctionById(ctx context.Context, in *GetRanFunctionByIdRequest, opts ...grpc.CallOption) (*entities.RanFunction, error)
+	FindNodebsSupportingRanFunction(ctx context.Context, in *FindNodebsSupportingRanFunctionRequest, opts ...grpc.CallOption) (*NodebInfoList, error)
+	// WatchNodebs streams the nodeb events published on the RAN channels until the call is cancelled
+	WatchNodebs(ctx context.Context, in *WatchNodebsRequest, opts ...grpc.CallOption) (RNibService_WatchNodebsClient, error)
+}
+
+type rNibServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRNibServiceClient(cc grpc.ClientConnInterface) RNibServiceClient {
+	return &rNibServiceClient{cc}
+}
+
+func (c *rNibServiceClient) GetNodeb(ctx context.Context, in *GetNodebRequest, opts ...grpc.CallOption) (*entities.NodebInfo, error) {
+	out := new(entities.NodebInfo)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetNodeb", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetNodebByGlobalNbId(ctx context.Context, in *GetNodebByGlobalNbIdRequest, opts ...grpc.CallOption) (*entities.NodebInfo, error) {
+	out := new(entities.NodebInfo)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetNodebByGlobalNbId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetNodebs(ctx context.Context, in *GetNodebsRequest, opts ...grpc.CallOption) (*GetNodebsResponse, error) {
+	out := new(GetNodebsResponse)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetNodebs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetNodebsByGlobalNbIds(ctx context.Context, in *GetNodebsByGlobalNbIdsRequest, opts ...grpc.CallOption) (*GetNodebsResponse, error) {
+	out := new(GetNodebsResponse)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetNodebsByGlobalNbIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) FindNodebs(ctx context.Context, in *NodebFilter, opts ...grpc.CallOption) (*NodebInfoList, error) {
+	out := new(NodebInfoList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/FindNodebs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) FindNodebIds(ctx context.Context, in *NodebFilter, opts ...grpc.CallOption) (*NbIdentityList, error) {
+	out := new(NbIdentityList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/FindNodebIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetCellList(ctx context.Context, in *GetCellListRequest, opts ...grpc.CallOption) (*entities.Cells, error) {
+	out := new(entities.Cells)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetCellList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetListGnbIds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NbIdentityList, error) {
+	out := new(NbIdentityList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetListGnbIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetListEnbIds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NbIdentityList, error) {
+	out := new(NbIdentityList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetListEnbIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetCountGnbList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetCountGnbList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetCountEnbList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetCountEnbList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetCountNodebs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetCountNodebs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetNodebStatistics(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NodebStatistics, error) {
+	out := new(NodebStatistics)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetNodebStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetCell(ctx context.Context, in *GetCellRequest, opts ...grpc.CallOption) (*entities.Cell, error) {
+	out := new(entities.Cell)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetCellById(ctx context.Context, in *GetCellByIdRequest, opts ...grpc.CallOption) (*entities.Cell, error) {
+	out := new(entities.Cell)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetCellById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetCellByCgi(ctx context.Context, in *GetCellByCgiRequest, opts ...grpc.CallOption) (*entities.Cell, error) {
+	out := new(entities.Cell)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetCellByCgi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetCellsByPlmn(ctx context.Context, in *GetCellsByPlmnRequest, opts ...grpc.CallOption) (*CellList, error) {
+	out := new(CellList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetCellsByPlmn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetCellsByTac(ctx context.Context, in *GetCellsByTacRequest, opts ...grpc.CallOption) (*CellList, error) {
+	out := new(CellList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetCellsByTac", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetListNodebIds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NbIdentityList, error) {
+	out := new(NbIdentityList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetListNodebIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) PageNodebIds(ctx context.Context, in *PageNodebIdsRequest, opts ...grpc.CallOption) (RNibService_PageNodebIdsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RNibService_ServiceDesc.Streams[0], "/rpc.RNibService/PageNodebIds", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rNibServicePageNodebIdsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RNibService_PageNodebIdsClient interface {
+	Recv() (*NbIdentityPage, error)
+	grpc.ClientStream
+}
+
+type rNibServicePageNodebIdsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rNibServicePageNodebIdsClient) Recv() (*NbIdentityPage, error) {
+	m := new(NbIdentityPage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rNibServiceClient) GetRanLoadInformation(ctx context.Context, in *GetRanLoadInformationRequest, opts ...grpc.CallOption) (*entities.RanLoadInformation, error) {
+	out := new(entities.RanLoadInformation)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetRanLoadInformation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetE2TInstance(ctx context.Context, in *GetE2TInstanceRequest, opts ...grpc.CallOption) (*E2TInstance, error) {
+	out := new(E2TInstance)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetE2TInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetE2TInstances(ctx context.Context, in *GetE2TInstancesRequest, opts ...grpc.CallOption) (*E2TInstanceList, error) {
+	out := new(E2TInstanceList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetE2TInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetE2TAddresses(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*E2TAddressList, error) {
+	out := new(E2TAddressList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetE2TAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetGeneralConfiguration(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GeneralConfiguration, error) {
+	out := new(GeneralConfiguration)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetGeneralConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetRanFunctionDefinition(ctx context.Context, in *GetRanFunctionDefinitionRequest, opts ...grpc.CallOption) (*RanFunctionDefinitionList, error) {
+	out := new(RanFunctionDefinitionList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetRanFunctionDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetRanFunctions(ctx context.Context, in *GetRanFunctionsRequest, opts ...grpc.CallOption) (*RanFunctionList, error) {
+	out := new(RanFunctionList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetRanFunctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) GetRanFunctionById(ctx context.Context, in *GetRanFunctionByIdRequest, opts ...grpc.CallOption) (*entities.RanFunction, error) {
+	out := new(entities.RanFunction)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/GetRanFunctionById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) FindNodebsSupportingRanFunction(ctx context.Context, in *FindNodebsSupportingRanFunctionRequest, opts ...grpc.CallOption) (*NodebInfoList, error) {
+	out := new(NodebInfoList)
+	err := c.cc.Invoke(ctx, "/rpc.RNibService/FindNodebsSupportingRanFunction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rNibServiceClient) WatchNodebs(ctx context.Context, in *WatchNodebsRequest, opts ...grpc.CallOption) (RNibService_WatchNodebsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RNibService_ServiceDesc.Streams[1], "/rpc.RNibService/WatchNodebs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rNibServiceWatchNodebsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RNibService_WatchNodebsClient interface {
+	Recv() (*NodebEvent, error)
+	grpc.ClientStream
+}
+
+type rNibServiceWatchNodebsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rNibServiceWatchNodebsClient) Recv() (*NodebEvent, error) {
+	m := new(NodebEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RNibServiceServer is the server API for RNibService service.
+// All implementations must embed UnimplementedRNibServiceServer
+// for forward compatibility
+type RNibServiceServer interface {
+	GetNodeb(context.Context, *GetNodebRequest) (*entities.NodebInfo, error)
+	GetNodebByGlobalNbId(context.Context, *GetNodebByGlobalNbIdRequest) (*entities.NodebInfo, error)
+	GetNodebs(context.Context, *GetNodebsRequest) (*GetNodebsResponse, error)
+	GetNodebsByGlobalNbIds(context.Context, *GetNodebsByGlobalNbIdsRequest) (*GetNodebsResponse, error)
+	FindNodebs(context.Context, *NodebFilter) (*NodebInfoList, error)
+	FindNodebIds(context.Context, *NodebFilter) (*NbIdentityList, error)
+	GetCellList(context.Context, *GetCellListRequest) (*entities.Cells, error)
+	GetListGnbIds(context.Context, *Empty) (*NbIdentityList, error)
+	GetListEnbIds(context.Context, *Empty) (*NbIdentityList, error)
+	GetCountGnbList(context.Context, *Empty) (*Count, error)
+	GetCountEnbList(context.Context, *Empty) (*Count, error)
+	GetCountNodebs(context.Context, *Empty) (*Count, error)
+	GetNodebStatistics(context.Context, *Empty) (*NodebStatistics, error)
+	GetCell(context.Context, *GetCellRequest) (*entities.Cell, error)
+	GetCellById(context.Context, *GetCellByIdRequest) (*entities.Cell, error)
+	GetCellByCgi(context.Context, *GetCellByCgiRequest) (*entities.Cell, error)
+	GetCellsByPlmn(context.Context, *GetCellsByPlmnRequest) (*CellList, error)
+	GetCellsByTac(context.Context, *GetCellsByTacRequest) (*CellList, error)
+	GetListNodebIds(context.Context, *Empty) (*NbIdentityList, error)
+	// PageNodebIds streams a first page holding only the total, followed by the pages of identities
+	PageNodebIds(*PageNodebIdsRequest, RNibService_PageNodebIdsServer) error
+	GetRanLoadInformation(context.Context, *GetRanLoadInformationRequest) (*entities.RanLoadInformation, error)
+	GetE2TInstance(context.Context, *GetE2TInstanceRequest) (*E2TInstance, error)
+	GetE2TInstances(context.Context, *GetE2TInstancesRequest) (*E2TInstanceList, error)
+	GetE2TAddresses(context.Context, *Empty) (*E2TAddressList, error)
+	GetGeneralConfiguration(context.Context, *Empty) (*GeneralConfiguration, error)
+	GetRanFunctionDefinition(context.Context, *GetRanFunctionDefinitionRequest) (*RanFunctionDefinitionList, error)
+	GetRanFunctions(context.Context, *GetRanFunctionsRequest) (*RanFunctionList, error)
+	GetRanFunctionById(context.Context, *GetRanFunctionByIdRequest) (*entities.RanFunction, error)
+	FindNodebsSupportingRanFunction(context.Context, *FindNodebsSupportingRanFunctionRequest) (*NodebInfoList, error)
+	// WatchNodebs streams the nodeb events published on the RAN channels until the call is cancelled
+	WatchNodebs(*WatchNodebsRequest, RNibService_WatchNodebsServer) error
+	mustEmbedUnimplementedRNibServiceServer()
+}
+
+// UnimplementedRNibServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRNibServiceServer struct {
+}
+
+func (UnimplementedRNibServiceServer) GetNodeb(context.Context, *GetNodebRequest) (*entities.NodebInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeb not implemented")
+}
+func (UnimplementedRNibServiceServer) GetNodebByGlobalNbId(context.Context, *GetNodebByGlobalNbIdRequest) (*entities.NodebInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodebByGlobalNbId not implemented")
+}
+func (UnimplementedRNibServiceServer) GetNodebs(context.Context, *GetNodebsRequest) (*GetNodebsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodebs not implemented")
+}
+func (UnimplementedRNibServiceServer) GetNodebsByGlobalNbIds(context.Context, *GetNodebsByGlobalNbIdsRequest) (*GetNodebsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodebsByGlobalNbIds not implemented")
+}
+func (UnimplementedRNibServiceServer) FindNodebs(context.Context, *NodebFilter) (*NodebInfoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNodebs not implemented")
+}
+func (UnimplementedRNibServiceServer) FindNodebIds(context.Context, *NodebFilter) (*NbIdentityList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNodebIds not implemented")
+}
+func (UnimplementedRNibServiceServer) GetCellList(context.Context, *GetCellListRequest) (*entities.Cells, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCellList not implemented")
+}
+func (UnimplementedRNibServiceServer) GetListGnbIds(context.Context, *Empty) (*NbIdentityList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListGnbIds not implemented")
+}
+func (UnimplementedRNibServiceServer) GetListEnbIds(context.Context, *Empty) (*NbIdentityList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListEnbIds not implemented")
+}
+func (UnimplementedRNibServiceServer) GetCountGnbList(context.Context, *Empty) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCountGnbList not implemented")
+}
+func (UnimplementedRNibServiceServer) GetCountEnbList(context.Context, *Empty) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCountEnbList not implemented")
+}
+func (UnimplementedRNibServiceServer) GetCountNodebs(context.Context, *Empty) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCountNodebs not implemented")
+}
+func (UnimplementedRNibServiceServer) GetNodebStatistics(context.Context, *Empty) (*NodebStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodebStatistics not implemented")
+}
+func (UnimplementedRNibServiceServer) GetCell(context.Context, *GetCellRequest) (*entities.Cell, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCell not implemented")
+}
+func (UnimplementedRNibServiceServer) GetCellById(context.Context, *GetCellByIdRequest) (*entities.Cell, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCellById not implemented")
+}
+func (UnimplementedRNibServiceServer) GetCellByCgi(context.Context, *GetCellByCgiRequest) (*entities.Cell, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCellByCgi not implemented")
+}
+func (UnimplementedRNibServiceServer) GetCellsByPlmn(context.Context, *GetCellsByPlmnRequest) (*CellList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCellsByPlmn not implemented")
+}
+func (UnimplementedRNibServiceServer) GetCellsByTac(context.Context, *GetCellsByTacRequest) (*CellList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCellsByTac not implemented")
+}
+func (UnimplementedRNibServiceServer) GetListNodebIds(context.Context, *Empty) (*NbIdentityList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListNodebIds not implemented")
+}
+func (UnimplementedRNibServiceServer) PageNodebIds(*PageNodebIdsRequest, RNibService_PageNodebIdsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PageNodebIds not implemented")
+}
+func (UnimplementedRNibServiceServer) GetRanLoadInformation(context.Context, *GetRanLoadInformationRequest) (*entities.RanLoadInformation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRanLoadInformation not implemented")
+}
+func (UnimplementedRNibServiceServer) GetE2TInstance(context.Context, *GetE2TInstanceRequest) (*E2TInstance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetE2TInstance not implemented")
+}
+func (UnimplementedRNibServiceServer) GetE2TInstances(context.Context, *GetE2TInstancesRequest) (*E2TInstanceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetE2TInstances not implemented")
+}
+func (UnimplementedRNibServiceServer) GetE2TAddresses(context.Context, *Empty) (*E2TAddressList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetE2TAddresses not implemented")
+}
+func (UnimplementedRNibServiceServer) GetGeneralConfiguration(context.Context, *Empty) (*GeneralConfiguration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGeneralConfiguration not implemented")
+}
+func (UnimplementedRNibServiceServer) GetRanFunctionDefinition(context.Context, *GetRanFunctionDefinitionRequest) (*RanFunctionDefinitionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRanFunctionDefinition not implemented")
+}
+func (UnimplementedRNibServiceServer) GetRanFunctions(context.Context, *GetRanFunctionsRequest) (*RanFunctionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRanFunctions not implemented")
+}
+func (UnimplementedRNibServiceServer) GetRanFunctionById(context.Context, *GetRanFunctionByIdRequest) (*entities.RanFunction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRanFunctionById not implemented")
+}
+func (UnimplementedRNibServiceServer) FindNodebsSupportingRanFunction(context.Context, *FindNodebsSupportingRanFunctionRequest) (*NodebInfoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNodebsSupportingRanFunction not implemented")
+}
+func (UnimplementedRNibServiceServer) WatchNodebs(*WatchNodebsRequest, RNibService_WatchNodebsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNodebs not implemented")
+}
+func (UnimplementedRNibServiceServer) mustEmbedUnimplementedRNibServiceServer() {}
+
+// UnsafeRNibServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RNibServiceServer will
+// result in compilation errors.
+type UnsafeRNibServiceServer interface {
+	mustEmbedUnimplementedRNibServiceServer()
+}
+
+func RegisterRNibServiceServer(s grpc.ServiceRegistrar, srv RNibServiceServer) {
+	s.RegisterService(&RNibService_ServiceDesc, srv)
+}
+
+func _RNibService_GetNodeb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodebRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetNodeb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetNodeb",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetNodeb(ctx, req.(*GetNodebRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetNodebByGlobalNbId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodebByGlobalNbIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetNodebByGlobalNbId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetNodebByGlobalNbId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetNodebByGlobalNbId(ctx, req.(*GetNodebByGlobalNbIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetNodebs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodebsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetNodebs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetNodebs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetNodebs(ctx, req.(*GetNodebsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetNodebsByGlobalNbIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodebsByGlobalNbIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetNodebsByGlobalNbIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetNodebsByGlobalNbIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetNodebsByGlobalNbIds(ctx, req.(*GetNodebsByGlobalNbIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_FindNodebs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodebFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).FindNodebs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/FindNodebs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).FindNodebs(ctx, req.(*NodebFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_FindNodebIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodebFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).FindNodebIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/FindNodebIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).FindNodebIds(ctx, req.(*NodebFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetCellList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCellListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetCellList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetCellList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetCellList(ctx, req.(*GetCellListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetListGnbIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetListGnbIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetListGnbIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetListGnbIds(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetListEnbIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetListEnbIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetListEnbIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetListEnbIds(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetCountGnbList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetCountGnbList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetCountGnbList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetCountGnbList(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetCountEnbList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetCountEnbList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetCountEnbList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetCountEnbList(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetCountNodebs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetCountNodebs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetCountNodebs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetCountNodebs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetNodebStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetNodebStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetNodebStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetNodebStatistics(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetCell(ctx, req.(*GetCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetCellById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCellByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetCellById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetCellById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetCellById(ctx, req.(*GetCellByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetCellByCgi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCellByCgiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetCellByCgi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetCellByCgi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetCellByCgi(ctx, req.(*GetCellByCgiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetCellsByPlmn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCellsByPlmnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetCellsByPlmn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetCellsByPlmn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetCellsByPlmn(ctx, req.(*GetCellsByPlmnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetCellsByTac_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCellsByTacRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetCellsByTac(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetCellsByTac",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetCellsByTac(ctx, req.(*GetCellsByTacRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetListNodebIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetListNodebIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetListNodebIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetListNodebIds(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_PageNodebIds_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PageNodebIdsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RNibServiceServer).PageNodebIds(m, &rNibServicePageNodebIdsServer{stream})
+}
+
+type RNibService_PageNodebIdsServer interface {
+	Send(*NbIdentityPage) error
+	grpc.ServerStream
+}
+
+type rNibServicePageNodebIdsServer struct {
+	grpc.ServerStream
+}
+
+func (x *rNibServicePageNodebIdsServer) Send(m *NbIdentityPage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RNibService_GetRanLoadInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRanLoadInformationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetRanLoadInformation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetRanLoadInformation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetRanLoadInformation(ctx, req.(*GetRanLoadInformationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetE2TInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetE2TInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetE2TInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetE2TInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetE2TInstance(ctx, req.(*GetE2TInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetE2TInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetE2TInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetE2TInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetE2TInstances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetE2TInstances(ctx, req.(*GetE2TInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetE2TAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetE2TAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetE2TAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetE2TAddresses(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetGeneralConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetGeneralConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetGeneralConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetGeneralConfiguration(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetRanFunctionDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRanFunctionDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetRanFunctionDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetRanFunctionDefinition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetRanFunctionDefinition(ctx, req.(*GetRanFunctionDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetRanFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRanFunctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetRanFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetRanFunctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetRanFunctions(ctx, req.(*GetRanFunctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_GetRanFunctionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRanFunctionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).GetRanFunctionById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/GetRanFunctionById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).GetRanFunctionById(ctx, req.(*GetRanFunctionByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_FindNodebsSupportingRanFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNodebsSupportingRanFunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RNibServiceServer).FindNodebsSupportingRanFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.RNibService/FindNodebsSupportingRanFunction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RNibServiceServer).FindNodebsSupportingRanFunction(ctx, req.(*FindNodebsSupportingRanFunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RNibService_WatchNodebs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNodebsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RNibServiceServer).WatchNodebs(m, &rNibServiceWatchNodebsServer{stream})
+}
+
+type RNibService_WatchNodebsServer interface {
+	Send(*NodebEvent) error
+	grpc.ServerStream
+}
+
+type rNibServiceWatchNodebsServer struct {
+	grpc.ServerStream
+}
+
+func (x *rNibServiceWatchNodebsServer) Send(m *NodebEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// RNibService_ServiceDesc is the grpc.ServiceDesc for RNibService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RNibService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpc.RNibService",
+	HandlerType: (*RNibServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNodeb",
+			Handler:    _RNibService_GetNodeb_Handler,
+		},
+		{
+			MethodName: "GetNodebByGlobalNbId",
+			Handler:    _RNibService_GetNodebByGlobalNbId_Handler,
+		},
+		{
+			MethodName: "GetNodebs",
+			Handler:    _RNibService_GetNodebs_Handler,
+		},
+		{
+			MethodName: "GetNodebsByGlobalNbIds",
+			Handler:    _RNibService_GetNodebsByGlobalNbIds_Handler,
+		},
+		{
+			MethodName: "FindNodebs",
+			Handler:    _RNibService_FindNodebs_Handler,
+		},
+		{
+			MethodName: "FindNodebIds",
+			Handler:    _RNibService_FindNodebIds_Handler,
+		},
+		{
+			MethodName: "GetCellList",
+			Handler:    _RNibService_GetCellList_Handler,
+		},
+		{
+			MethodName: "GetListGnbIds",
+			Handler:    _RNibService_GetListGnbIds_Handler,
+		},
+		{
+			MethodName: "GetListEnbIds",
+			Handler:    _RNibService_GetListEnbIds_Handler,
+		},
+		{
+			MethodName: "GetCountGnbList",
+			Handler:    _RNibService_GetCountGnbList_Handler,
+		},
+		{
+			MethodName: "GetCountEnbList",
+			Handler:    _RNibService_GetCountEnbList_Handler,
+		},
+		{
+			MethodName: "GetCountNodebs",
+			Handler:    _RNibService_GetCountNodebs_Handler,
+		},
+		{
+			MethodName: "GetNodebStatistics",
+			Handler:    _RNibService_GetNodebStatistics_Handler,
+		},
+		{
+			MethodName: "GetCell",
+			Handler:    _RNibService_GetCell_Handler,
+		},
+		{
+			MethodName: "GetCellById",
+			Handler:    _RNibService_GetCellById_Handler,
+		},
+		{
+			MethodName: "GetCellByCgi",
+			Handler:    _RNibService_GetCellByCgi_Handler,
+		},
+		{
+			MethodName: "GetCellsByPlmn",
+			Handler:    _RNibService_GetCellsByPlmn_Handler,
+		},
+		{
+			MethodName: "GetCellsByTac",
+			Handler:    _RNibService_GetCellsByTac_Handler,
+		},
+		{
+			MethodName: "GetListNodebIds",
+			Handler:    _RNibService_GetListNodebIds_Handler,
+		},
+		{
+			MethodName: "GetRanLoadInformation",
+			Handler:    _RNibService_GetRanLoadInformation_Handler,
+		},
+		{
+			MethodName: "GetE2TInstance",
+			Handler:    _RNibService_GetE2TInstance_Handler,
+		},
+		{
+			MethodName: "GetE2TInstances",
+			Handler:    _RNibService_GetE2TInstances_Handler,
+		},
+		{
+			MethodName: "GetE2TAddresses",
+			Handler:    _RNibService_GetE2TAddresses_Handler,
+		},
+		{
+			MethodName: "GetGeneralConfiguration",
+			Handler:    _RNibService_GetGeneralConfiguration_Handler,
+		},
+		{
+			MethodName: "GetRanFunctionDefinition",
+			Handler:    _RNibService_GetRanFunctionDefinition_Handler,
+		},
+		{
+			MethodName: "GetRanFunctions",
+			Handler:    _RNibService_GetRanFunctions_Handler,
+		},
+		{
+			MethodName: "GetRanFunctionById",
+			Handler:    _RNibService_GetRanFunctionById_Handler,
+		},
+		{
+			MethodName: "FindNodebsSupportingRanFunction",
+			Handler:    _RNibService_FindNodebsSupportingRanFunction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PageNodebIds",
+			Handler:       _RNibService_PageNodebIds_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchNodebs",
+			Handler:       _RNibService_WatchNodebs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rnib_service.proto",
+}
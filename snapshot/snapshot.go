@@ -0,0 +1,388 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+/*
+Package snapshot exports the R-NIB namespace into a portable archive and imports such archives into any storage.
+An archive is a stream of JSON lines: a header giving the archive format, its version and its number of keys, followed
+by a record per key holding either the key value or the group members, tagged with the entity type decoded from it.
+*/
+package snapshot
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer"
+	"github.com/golang/protobuf/proto"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	// Format identifies the R-NIB snapshot archives
+	Format = "rnib-snapshot"
+	// Version is the archive format version written by Export, Import reads the versions up to it
+	Version = 1
+)
+
+type EntityType string
+
+const (
+	NodebInfoEntity            EntityType = "NodebInfo"
+	CellEntity                 EntityType = "Cell"
+	RanLoadInformationEntity   EntityType = "RanLoadInformation"
+	E2TInstanceEntity          EntityType = "E2TInstance"
+	E2TAddressesEntity         EntityType = "E2TAddresses"
+	GeneralConfigurationEntity EntityType = "GeneralConfiguration"
+	// NbIdentitySetEntity is an identity set, its members are NbIdentity entities
+	NbIdentitySetEntity EntityType = "NbIdentitySet"
+	// CellIndexEntity is a PLMN or TAC cell index, its members are cell keys
+	CellIndexEntity EntityType = "CellIndex"
+	// RawEntity is a value which does not decode as any R-NIB entity
+	RawEntity EntityType = "Raw"
+)
+
+/*
+Options tune the snapshot export and import
+*/
+type Options struct {
+	// Nodebs restricts the snapshot to the given nodebs: their entity, global Id, cell and load information keys,
+	// their identities and cell index members, and the E2T instances they are associated with. Every key is kept when empty.
+	Nodebs []string
+	// Clear removes every key of the namespace before importing, it cannot be combined with Nodebs
+	Clear bool
+}
+
+/*
+Snapshotter exports the R-NIB namespace of a storage into an archive and imports archives into it
+*/
+type Snapshotter interface {
+	// Export writes the archive of the namespace keys
+	Export(ctx context.Context, w io.Writer) error
+	// Import reads a whole archive, then sets its keys and replaces its groups, or only adds their members when filtering nodebs.
+	// It is not atomic: the values are set at once, but the namespace is cleared and each group written by separate requests,
+	// so a storage failure can leave the archive partially imported.
+	Import(ctx context.Context, r io.Reader) error
+}
+
+type header struct {
+	Format    string `json:"format"`
+	Version   int    `json:"version"`
+	Namespace string `json:"namespace"`
+	Keys      int    `json:"keys"`
+}
+
+type record struct {
+	Key     string     `json:"key"`
+	Type    EntityType `json:"type"`
+	Value   []byte     `json:"value,omitempty"`
+	Members [][]byte   `json:"members,omitempty"`
+}
+
+type snapshotter struct {
+	storage common.ISdlSyncStorage
+	ns      string
+	options Options
+}
+
+// GetNewSnapshotter returns reference to Snapshotter of the R-NIB namespace of the storage
+func GetNewSnapshotter(storage common.ISdlSyncStorage, options Options) Snapshotter {
+	return &snapshotter{
+		storage: storage,
+		ns:      common.GetRNibNamespace(),
+		options: options,
+	}
+}
+
+func (s *snapshotter) Export(ctx context.Context, w io.Writer) error {
+	records, err := s.readRecords(ctx)
+	if err != nil {
+		return err
+	}
+	records = filterRecords(records, s.options.Nodebs)
+	bw := bufio.NewWriter(w)
+	encoder := json.NewEncoder(bw)
+	err = encoder.Encode(&header{Format: Format, Version: Version, Namespace: s.ns, Keys: len(records)})
+	if err != nil {
+		return common.NewInternalError(err)
+	}
+	for _, r := range records {
+		if err := encoder.Encode(r); err != nil {
+			return common.NewInternalError(err)
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return common.NewInternalError(err)
+	}
+	return nil
+}
+
+func (s *snapshotter) Import(ctx context.Context, r io.Reader) error {
+	if s.options.Clear && len(s.options.Nodebs) > 0 {
+		return common.NewValidationError("#snapshot.Import - the namespace cannot be cleared when importing the keys of some nodebs only")
+	}
+	records, err := readArchive(r)
+	if err != nil {
+		return err
+	}
+	records = filterRecords(records, s.options.Nodebs)
+	// The context is not checked once writing has started, so that a cancellation does not leave the namespace cleared
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s.options.Clear {
+		if err := s.storage.RemoveAll(s.ns); err != nil {
+			return common.NewStorageUnavailableError(err)
+		}
+	}
+	var pairs []interface{}
+	var groups []*record
+	for _, r := range records {
+		if len(r.Members) == 0 {
+			pairs = append(pairs, r.Key, r.Value)
+		} else {
+			groups = append(groups, r)
+		}
+	}
+	if len(pairs) > 0 {
+		if err := s.storage.Set(s.ns, pairs...); err != nil {
+			return common.NewStorageUnavailableError(err)
+		}
+	}
+	for _, r := range groups {
+		if err := s.importGroup(r); err != nil {
+			return common.NewStorageUnavailableError(err)
+		}
+	}
+	return nil
+}
+
+func (s *snapshotter) importGroup(r *record) error {
+	if len(s.options.Nodebs) == 0 {
+		if err := s.storage.RemoveGroup(s.ns, r.Key); err != nil {
+			return err
+		}
+	}
+	members := make([]interface{}, len(r.Members))
+	for i, member := range r.Members {
+		members[i] = member
+	}
+	return s.storage.AddMember(s.ns, r.Key, members...)
+}
+
+func (s *snapshotter) readRecords(ctx context.Context) ([]*record, error) {
+	keys, err := s.storage.GetAll(s.ns)
+	if err != nil {
+		return nil, common.NewStorageUnavailableError(err)
+	}
+	sort.Strings(keys)
+	var records []*record
+	var valueRecords []*record
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		r := &record{Key: key}
+		records = append(records, r)
+		if !common.IsGroupKey(key) {
+			valueRecords = append(valueRecords, r)
+			continue
+		}
+		members, err := s.storage.GetMembers(s.ns, key)
+		if err != nil {
+			return nil, common.NewStorageUnavailableError(err)
+		}
+		r.Members = make([][]byte, len(members))
+		for i, member := range members {
+			r.Members[i] = []byte(member)
+		}
+		r.Type = groupType(key)
+	}
+	for start := 0; start < len(valueRecords); start += common.GetBatchSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		batch := valueRecords[start:]
+		if len(batch) > common.GetBatchSize {
+			batch = batch[:common.GetBatchSize]
+		}
+		batchKeys := make([]string, len(batch))
+		for i, r := range batch {
+			batchKeys[i] = r.Key
+		}
+		values, err := s.storage.Get(s.ns, batchKeys)
+		if err != nil {
+			return nil, common.NewStorageUnavailableError(err)
+		}
+		for _, r := range batch {
+			if values[r.Key] == nil {
+				// Removed since the keys were listed
+				continue
+			}
+			r.Value, err = common.ValueToBytes(values[r.Key])
+			if err != nil {
+				return nil, common.NewInternalError(fmt.Errorf("#snapshot.Export - key %s: %w", r.Key, err))
+			}
+			r.Type = valueType(r.Key, r.Value)
+		}
+	}
+	var present []*record
+	for _, r := range records {
+		if r.Value != nil || len(r.Members) != 0 {
+			present = append(present, r)
+		}
+	}
+	return present, nil
+}
+
+func readArchive(r io.Reader) ([]*record, error) {
+	decoder := json.NewDecoder(bufio.NewReader(r))
+	var h header
+	if err := decoder.Decode(&h); err != nil {
+		return nil, common.NewValidationError(fmt.Sprintf("#snapshot.Import - invalid archive header: %s", err))
+	}
+	if h.Format != Format || h.Version < 1 || h.Version > Version {
+		return nil, common.NewValidationError(fmt.Sprintf("#snapshot.Import - unsupported archive format %q version %d", h.Format, h.Version))
+	}
+	var records []*record
+	for {
+		r := &record{}
+		err := decoder.Decode(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, common.NewValidationError(fmt.Sprintf("#snapshot.Import - invalid archive record: %s", err))
+		}
+		if r.Key == "" {
+			return nil, common.NewValidationError("#snapshot.Import - archive record without key")
+		}
+		records = append(records, r)
+	}
+	if len(records) != h.Keys {
+		return nil, common.NewValidationError(fmt.Sprintf("#snapshot.Import - archive holds %d keys, its header announces %d", len(records), h.Keys))
+	}
+	return records, nil
+}
+
+// filterRecords keeps the records of the given nodebs, as decoded from the records themselves
+func filterRecords(records []*record, nodebs []string) []*record {
+	if len(nodebs) == 0 {
+		return records
+	}
+	byKey := make(map[string]*record, len(records))
+	for _, r := range records {
+		byKey[r.Key] = r
+	}
+	names := map[string]bool{}
+	keys := map[string]bool{}
+	cellKeys := map[string]bool{}
+	for _, name := range nodebs {
+		names[name] = true
+		keys[common.NodebKeyPrefix+name] = true
+		keys[common.RanLoadInformationKeyPrefix+name] = true
+		r, ok := byKey[common.NodebKeyPrefix+name]
+		nodebInfo := &entities.NodebInfo{}
+		if !ok || proto.Unmarshal(r.Value, nodebInfo) != nil {
+			continue
+		}
+		if keyValues, err := writer.BuildNodebKeyValues(nodebInfo); err == nil {
+			for key := range keyValues {
+				keys[key] = true
+			}
+		}
+		for _, members := range writer.BuildNodebCellIndexMembers(nodebInfo) {
+			for _, member := range members {
+				cellKeys[member] = true
+			}
+		}
+		if address := nodebInfo.GetAssociatedE2TInstanceAddress(); address != "" {
+			keys[common.E2TInstanceKeyPrefix+address] = true
+		}
+	}
+	var filtered []*record
+	for _, r := range records {
+		switch {
+		case len(r.Members) == 0:
+			if keys[r.Key] {
+				filtered = append(filtered, r)
+			}
+		case r.Type == NbIdentitySetEntity:
+			filtered = appendMembers(filtered, r, func(member []byte) bool {
+				identity := &entities.NbIdentity{}
+				return proto.Unmarshal(member, identity) == nil && names[identity.GetInventoryName()]
+			})
+		case r.Type == CellIndexEntity:
+			filtered = appendMembers(filtered, r, func(member []byte) bool {
+				return cellKeys[string(member)]
+			})
+		}
+	}
+	return filtered
+}
+
+func appendMembers(records []*record, r *record, keep func(member []byte) bool) []*record {
+	var members [][]byte
+	for _, member := range r.Members {
+		if keep(member) {
+			members = append(members, member)
+		}
+	}
+	if len(members) == 0 {
+		return records
+	}
+	return append(records, &record{Key: r.Key, Type: r.Type, Members: members})
+}
+
+func valueType(key string, value []byte) EntityType {
+	var entityType EntityType
+	var err error
+	switch {
+	case strings.HasPrefix(key, common.NodebKeyPrefix), common.IsNodeBIdKey(key):
+		entityType, err = NodebInfoEntity, proto.Unmarshal(value, &entities.NodebInfo{})
+	case common.IsCellKey(key):
+		entityType, err = CellEntity, proto.Unmarshal(value, &entities.Cell{})
+	case strings.HasPrefix(key, common.RanLoadInformationKeyPrefix):
+		entityType, err = RanLoadInformationEntity, proto.Unmarshal(value, &entities.RanLoadInformation{})
+	case strings.HasPrefix(key, common.E2TInstanceKeyPrefix):
+		entityType, err = E2TInstanceEntity, json.Unmarshal(value, &entities.E2TInstance{})
+	case key == writer.E2TAddressesKey:
+		entityType, err = E2TAddressesEntity, json.Unmarshal(value, &[]string{})
+	case key == common.BuildGeneralConfigurationKey():
+		entityType, err = GeneralConfigurationEntity, json.Unmarshal(value, &entities.GeneralConfiguration{})
+	default:
+		return RawEntity
+	}
+	if err != nil {
+		return RawEntity
+	}
+	return entityType
+}
+
+func groupType(key string) EntityType {
+	if common.IsNbIdentitySetKey(key) {
+		return NbIdentitySetEntity
+	}
+	return CellIndexEntity
+}
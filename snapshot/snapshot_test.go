@@ -0,0 +1,280 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const (
+	e2tAddress      = "10.0.2.15:3213"
+	otherE2tAddress = "10.0.2.16:3213"
+)
+
+func buildGnb(name string, nbId string, cellId string, pci uint32, tac string, e2tAddress string) *entities.NodebInfo {
+	return &entities.NodebInfo{
+		RanName:                      name,
+		NodeType:                     entities.Node_GNB,
+		ConnectionStatus:             entities.ConnectionStatus_CONNECTED,
+		GlobalNbId:                   &entities.GlobalNbId{PlmnId: "02f829", NbId: nbId},
+		AssociatedE2TInstanceAddress: e2tAddress,
+		Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{ServedNrCells: []*entities.ServedNRCell{
+			{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: cellId, NrPci: pci, ServedPlmns: []string{"02f829"}, Stac5G: tac}},
+		}}},
+	}
+}
+
+/*
+initStorage saves an entity of every archived type: two gNBs associated with their own E2T instance and an eNB, all serving
+the same PLMN so that a nodeb filter has to split the cell indexes, the E2T addresses, the general configuration,
+an undecodable load information and an unknown key, both archived as raw values.
+*/
+func initStorage(t *testing.T) *common.InMemorySdlSyncStorage {
+	storage := common.NewInMemorySdlSyncStorage()
+	w := writer.GetNewRNibWriter(storage)
+	gnb1 := buildGnb("gnb_1", "4a952a0a", "02f829:4a952a0a50", 5, "000001", e2tAddress)
+	gnb2 := buildGnb("gnb_2", "4a952b0a", "02f829:4a952b0a60", 6, "000002", otherE2tAddress)
+	enb := &entities.NodebInfo{
+		RanName:          "enb_1",
+		NodeType:         entities.Node_ENB,
+		ConnectionStatus: entities.ConnectionStatus_DISCONNECTED,
+		GlobalNbId:       &entities.GlobalNbId{PlmnId: "02f829", NbId: "007a80"},
+		Configuration: &entities.NodebInfo_Enb{Enb: &entities.Enb{ServedCells: []*entities.ServedCellInfo{
+			{CellId: "02f829:007a80f0", Pci: 7, BroadcastPlmns: []string{"02f829"}, Tac: "0102"},
+		}}},
+	}
+	for _, nb := range []*entities.NodebInfo{gnb1, gnb2, enb} {
+		assert.Nil(t, w.SaveNodeb(nb))
+		assert.Nil(t, w.AddNbIdentity(nb.GetNodeType(), &entities.NbIdentity{InventoryName: nb.GetRanName(), GlobalNbId: nb.GetGlobalNbId(), ConnectionStatus: nb.GetConnectionStatus()}))
+	}
+	for _, nb := range []*entities.NodebInfo{gnb1, gnb2} {
+		e2tInstance := entities.NewE2TInstance(nb.GetAssociatedE2TInstanceAddress(), "e2term")
+		e2tInstance.AssociatedRanList = []string{nb.GetRanName()}
+		assert.Nil(t, w.SaveE2TInstance(e2tInstance))
+	}
+	assert.Nil(t, w.SaveE2TAddresses([]string{e2tAddress, otherE2tAddress}))
+	assert.Nil(t, w.SaveRanLoadInformation("enb_1", &entities.RanLoadInformation{LoadTimestamp: 5}))
+	assert.Nil(t, w.SaveGeneralConfiguration(&entities.GeneralConfiguration{EnableRic: true}))
+	assert.Nil(t, storage.Set(common.GetRNibNamespace(), "LOAD:gnb_2", "\xff", "UNRELATED", "\x00\x01"))
+	return storage
+}
+
+// dump returns every value and group of the namespace, the group members joined
+func dump(t *testing.T, storage common.ISdlSyncStorage) map[string]string {
+	ns := common.GetRNibNamespace()
+	keys, err := storage.GetAll(ns)
+	assert.Nil(t, err)
+	values, err := storage.Get(ns, keys)
+	assert.Nil(t, err)
+	content := map[string]string{}
+	for _, key := range keys {
+		if value, ok := values[key].(string); ok {
+			content[key] = value
+			continue
+		}
+		members, err := storage.GetMembers(ns, key)
+		assert.Nil(t, err)
+		content[key] = "members: " + strings.Join(members, ",")
+	}
+	return content
+}
+
+func export(t *testing.T, storage common.ISdlSyncStorage, options Options) *bytes.Buffer {
+	archive := &bytes.Buffer{}
+	assert.Nil(t, GetNewSnapshotter(storage, options).Export(context.Background(), archive))
+	return archive
+}
+
+func TestExportImport(t *testing.T) {
+	storage := initStorage(t)
+	archive := export(t, storage, Options{})
+
+	lines := strings.Split(strings.TrimSpace(archive.String()), "\n")
+	assert.Len(t, lines, 29)
+	assert.Equal(t, `{"format":"rnib-snapshot","version":1,"namespace":"e2Manager","keys":28}`, lines[0])
+	types := map[string]EntityType{}
+	for _, line := range lines[1:] {
+		var r record
+		assert.Nil(t, json.Unmarshal([]byte(line), &r))
+		types[r.Key] = r.Type
+	}
+	assert.Equal(t, NodebInfoEntity, types["RAN:gnb_1"])
+	assert.Equal(t, NodebInfoEntity, types["GNB:02f829:4a952a0a"])
+	assert.Equal(t, CellEntity, types["NRCGI:02f829:4a952a0a50"])
+	assert.Equal(t, RanLoadInformationEntity, types["LOAD:enb_1"])
+	assert.Equal(t, E2TInstanceEntity, types["E2TInstance:"+e2tAddress])
+	assert.Equal(t, E2TAddressesEntity, types["E2TAddresses"])
+	assert.Equal(t, GeneralConfigurationEntity, types["GENERAL"])
+	assert.Equal(t, NbIdentitySetEntity, types["ENB"])
+	assert.Equal(t, CellIndexEntity, types["TAC_CELLS:0102"])
+	assert.Equal(t, RawEntity, types["LOAD:gnb_2"])
+	assert.Equal(t, RawEntity, types["UNRELATED"])
+
+	restored := common.NewInMemorySdlSyncStorage()
+	assert.Nil(t, GetNewSnapshotter(restored, Options{}).Import(context.Background(), archive))
+	assert.Equal(t, dump(t, storage), dump(t, restored))
+	nb, err := reader.GetNewRNibReader(restored).GetNodeb("gnb_1")
+	assert.Nil(t, err)
+	assert.Equal(t, e2tAddress, nb.GetAssociatedE2TInstanceAddress())
+}
+
+func TestExportNodebs(t *testing.T) {
+	storage := initStorage(t)
+	archive := export(t, storage, Options{Nodebs: []string{"gnb_1"}})
+
+	restored := common.NewInMemorySdlSyncStorage()
+	assert.Nil(t, GetNewSnapshotter(restored, Options{}).Import(context.Background(), archive))
+	keys, err := restored.GetAll(common.GetRNibNamespace())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"E2TInstance:" + e2tAddress, "GNB", "GNB:02f829:4a952a0a", "NRCELL:02f829:4a952a0a50", "NRCGI:02f829:4a952a0a50", "PCI:gnb_1:05", "PLMN_CELLS:02f829", "RAN:gnb_1", "TAC_CELLS:000001"}, keys)
+	members, err := restored.GetMembers(common.GetRNibNamespace(), "PLMN_CELLS:02f829")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"NRCELL:02f829:4a952a0a50"}, members)
+	members, err = restored.GetMembers(common.GetRNibNamespace(), "GNB")
+	assert.Nil(t, err)
+	assert.Len(t, members, 1)
+}
+
+func TestImportNodebs(t *testing.T) {
+	archive := export(t, initStorage(t), Options{})
+	target := common.NewInMemorySdlSyncStorage()
+	ns := common.GetRNibNamespace()
+	assert.Nil(t, target.AddMember(ns, "PLMN_CELLS:02f829", "NRCELL:other"))
+
+	assert.Nil(t, GetNewSnapshotter(target, Options{Nodebs: []string{"enb_1"}}).Import(context.Background(), archive))
+	content := dump(t, target)
+	assert.Equal(t, "members: CELL:02f829:007a80f0,NRCELL:other", content["PLMN_CELLS:02f829"])
+	assert.Contains(t, content, "LOAD:enb_1")
+	assert.Contains(t, content, "ENB:02f829:007a80")
+	assert.NotContains(t, content, "RAN:gnb_1")
+	assert.NotContains(t, content, "E2TAddresses")
+	assert.Len(t, content, 9)
+}
+
+func TestImportNodebsRejectsClear(t *testing.T) {
+	archive := export(t, initStorage(t), Options{})
+	target := common.NewInMemorySdlSyncStorage()
+	ns := common.GetRNibNamespace()
+	assert.Nil(t, target.Set(ns, "RAN:other", "data"))
+
+	err := GetNewSnapshotter(target, Options{Nodebs: []string{"enb_1"}, Clear: true}).Import(context.Background(), archive)
+	assert.IsType(t, &common.ValidationError{}, err)
+	assert.Equal(t, map[string]string{"RAN:other": "data"}, dump(t, target))
+}
+
+func TestImportReplacesGroupsAndClears(t *testing.T) {
+	storage := initStorage(t)
+	archive := export(t, storage, Options{})
+	target := common.NewInMemorySdlSyncStorage()
+	ns := common.GetRNibNamespace()
+	assert.Nil(t, target.AddMember(ns, "PLMN_CELLS:02f829", "NRCELL:other"))
+	assert.Nil(t, target.Set(ns, "RAN:other", "data"))
+
+	assert.Nil(t, GetNewSnapshotter(target, Options{}).Import(context.Background(), bytes.NewReader(archive.Bytes())))
+	content := dump(t, target)
+	assert.NotContains(t, content["PLMN_CELLS:02f829"], "NRCELL:other")
+	assert.Equal(t, "data", content["RAN:other"])
+
+	assert.Nil(t, GetNewSnapshotter(target, Options{Clear: true}).Import(context.Background(), bytes.NewReader(archive.Bytes())))
+	assert.Equal(t, dump(t, storage), dump(t, target))
+}
+
+func TestImportInvalidArchive(t *testing.T) {
+	archive := export(t, initStorage(t), Options{})
+	lines := strings.SplitAfter(archive.String(), "\n")
+	truncated := strings.Join(lines[:len(lines)-2], "")
+	tests := map[string]string{
+		"not json":    "archive",
+		"format":      `{"format":"other","version":1,"keys":0}`,
+		"version":     `{"format":"rnib-snapshot","version":2,"keys":0}`,
+		"record":      `{"format":"rnib-snapshot","version":1,"keys":1}` + "\n" + `{"key":`,
+		"missing key": `{"format":"rnib-snapshot","version":1,"keys":1}` + "\n" + `{"type":"Raw"}`,
+		"truncated":   truncated,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			target := common.NewInMemorySdlSyncStorage()
+			err := GetNewSnapshotter(target, Options{Clear: true}).Import(context.Background(), bufio.NewReader(strings.NewReader(data)))
+			assert.IsType(t, &common.ValidationError{}, err)
+			assert.Empty(t, dump(t, target))
+		})
+	}
+}
+
+func TestExportImportCanceled(t *testing.T) {
+	storage := initStorage(t)
+	archive := export(t, storage, Options{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, GetNewSnapshotter(storage, Options{}).Export(ctx, &bytes.Buffer{}))
+	target := common.NewInMemorySdlSyncStorage()
+	assert.Nil(t, target.Set(common.GetRNibNamespace(), "RAN:other", "data"))
+	assert.Equal(t, context.Canceled, GetNewSnapshotter(target, Options{Clear: true}).Import(ctx, archive))
+	assert.Equal(t, map[string]string{"RAN:other": "data"}, dump(t, target))
+}
+
+// instrumentedStorage counts the Set requests and returns the value of the unexpected key as an integer
+type instrumentedStorage struct {
+	*common.InMemorySdlSyncStorage
+	sets          int
+	unexpectedKey string
+}
+
+func (s *instrumentedStorage) Set(ns string, pairs ...interface{}) error {
+	s.sets++
+	return s.InMemorySdlSyncStorage.Set(ns, pairs...)
+}
+
+func (s *instrumentedStorage) Get(ns string, keys []string) (map[string]interface{}, error) {
+	values, err := s.InMemorySdlSyncStorage.Get(ns, keys)
+	if _, ok := values[s.unexpectedKey]; ok {
+		values[s.unexpectedKey] = 1
+	}
+	return values, err
+}
+
+func TestImportSetsValuesAtOnce(t *testing.T) {
+	storage := initStorage(t)
+	archive := export(t, storage, Options{})
+	target := &instrumentedStorage{InMemorySdlSyncStorage: common.NewInMemorySdlSyncStorage()}
+	assert.Nil(t, GetNewSnapshotter(target, Options{Clear: true}).Import(context.Background(), archive))
+	assert.Equal(t, 1, target.sets)
+	assert.Equal(t, dump(t, storage), dump(t, target))
+}
+
+func TestExportUnexpectedValueType(t *testing.T) {
+	storage := &instrumentedStorage{InMemorySdlSyncStorage: initStorage(t), unexpectedKey: "UNRELATED"}
+	archive := &bytes.Buffer{}
+	err := GetNewSnapshotter(storage, Options{}).Export(context.Background(), archive)
+	assert.IsType(t, &common.InternalError{}, err)
+	assert.Contains(t, err.Error(), "UNRELATED")
+	assert.Empty(t, archive.String())
+}
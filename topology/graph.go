@@ -0,0 +1,299 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+/*
+Package topology builds the cell level neighbour relation graph of the nodebs saved in rNib.
+Served NR cells and their NR neighbours are keyed by NR CGI, served LTE cells and their E-UTRAN neighbours by ECGI.
+*/
+package topology
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"sort"
+	"strings"
+)
+
+/*
+Cell is a served cell of the graph. Cgi is the normalized NR CGI or ECGI of the cell,
+or its lower cased cell Id when the cell Id does not parse as a CGI.
+*/
+type Cell struct {
+	Cgi           string
+	InventoryName string
+	Cell          *entities.Cell
+}
+
+// Type returns the type of the cell, NR or LTE
+func (c *Cell) Type() entities.Cell_Type {
+	return c.Cell.GetType()
+}
+
+// Pci returns the physical cell Id of the cell
+func (c *Cell) Pci() uint32 {
+	if c.Cell.GetServedNrCell() != nil {
+		return c.Cell.GetServedNrCell().GetServedNrCellInformation().GetNrPci()
+	}
+	return c.Cell.GetServedCellInfo().GetPci()
+}
+
+// Arfcn returns the downlink NR-ARFCN of an NR cell or the downlink EARFCN of an LTE cell, the TDD carrier for a TDD cell
+func (c *Cell) Arfcn() uint64 {
+	if c.Cell.GetServedNrCell() != nil {
+		choiceNrMode := c.Cell.GetServedNrCell().GetServedNrCellInformation().GetChoiceNrMode()
+		if choiceNrMode.GetTdd() != nil {
+			return choiceNrMode.GetTdd().GetNrFreqInfo().GetNrArFcn()
+		}
+		return choiceNrMode.GetFdd().GetDlFreqInfo().GetNrArFcn()
+	}
+	choiceEutraMode := c.Cell.GetServedCellInfo().GetChoiceEutraMode()
+	if choiceEutraMode.GetTdd() != nil {
+		return uint64(choiceEutraMode.GetTdd().GetEarFcn())
+	}
+	return uint64(choiceEutraMode.GetFdd().GetDlearFcn())
+}
+
+/*
+Relation is a directed neighbour relation declared by the From cell. Pci and Arfcn are the physical cell Id
+and the downlink ARFCN the From cell declares for its neighbour. To is nil for a dangling relation, whose neighbour is not a cell served by any nodeb of the graph.
+*/
+type Relation struct {
+	FromCgi string
+	ToCgi   string
+	From    *Cell
+	To      *Cell
+	Pci     uint32
+	Arfcn   uint64
+}
+
+// Dangling returns true when the neighbour is not a cell served by any nodeb of the graph
+func (r *Relation) Dangling() bool {
+	return r.To == nil
+}
+
+/*
+Graph is a directed cell level neighbour relation graph. It is not updated after it is built.
+A CGI served by several cells keeps all of them and their relations, but is looked up and declared as a neighbour
+as the cell of the first nodeb serving it.
+*/
+type Graph struct {
+	cells      map[string]*Cell
+	allCells   []*Cell
+	duplicates map[string][]*Cell
+	relations  map[string][]*Relation
+	reverse    map[string][]*Relation
+}
+
+// Build loads every nodeb through the reader and builds their neighbour relation graph
+func Build(r reader.RNibReader) (*Graph, error) {
+	nodebs, err := r.FindNodebs(reader.NodebFilter{})
+	if err != nil {
+		return nil, err
+	}
+	return BuildFromNodebs(nodebs), nil
+}
+
+// BuildFromNodebs builds the neighbour relation graph of the nodebs
+func BuildFromNodebs(nodebs []*entities.NodebInfo) *Graph {
+	g := &Graph{
+		cells:      map[string]*Cell{},
+		duplicates: map[string][]*Cell{},
+		relations:  map[string][]*Relation{},
+		reverse:    map[string][]*Relation{},
+	}
+	for _, nodeb := range nodebs {
+		for _, servedCell := range nodeb.GetEnb().GetServedCells() {
+			g.addCell(nodeb.GetRanName(), NormalizeEcgi(servedCell.GetCellId()),
+				&entities.Cell{Type: entities.Cell_LTE_CELL, Cell: &entities.Cell_ServedCellInfo{ServedCellInfo: servedCell}})
+		}
+		for _, servedNrCell := range nodeb.GetGnb().GetServedNrCells() {
+			g.addCell(nodeb.GetRanName(), NormalizeNrCgi(servedNrCell.GetServedNrCellInformation().GetCellId()),
+				&entities.Cell{Type: entities.Cell_NR_CELL, Cell: &entities.Cell_ServedNrCell{ServedNrCell: servedNrCell}})
+		}
+	}
+	for _, cell := range g.allCells {
+		for _, neighbour := range cell.Cell.GetServedCellInfo().GetNeighbourInfos() {
+			g.addRelation(cell, NormalizeEcgi(neighbour.GetEcgi()), neighbour.GetPci(), uint64(neighbour.GetEarFcn()))
+		}
+		for _, neighbour := range cell.Cell.GetServedNrCell().GetNrNeighbourInfos() {
+			g.addRelation(cell, NormalizeNrCgi(neighbour.GetNrCgi()), neighbour.GetNrPci(), nrNeighbourArfcn(neighbour))
+		}
+	}
+	for _, relations := range g.relations {
+		sortRelations(relations)
+	}
+	for _, relations := range g.reverse {
+		sortRelations(relations)
+	}
+	return g
+}
+
+// NormalizeNrCgi returns the NR CGI in the "<PLMN identity>:<NR cell identity>" form, or lower cased when it does not parse
+func NormalizeNrCgi(nrCgi string) string {
+	parsed, err := entities.ParseNRCGI(nrCgi)
+	if err != nil {
+		return strings.ToLower(nrCgi)
+	}
+	return parsed.String()
+}
+
+// NormalizeEcgi returns the ECGI in the "<PLMN identity>:<E-UTRAN cell identity>" form, or lower cased when it does not parse
+func NormalizeEcgi(ecgi string) string {
+	parsed, err := entities.ParseECGI(ecgi)
+	if err != nil {
+		return strings.ToLower(ecgi)
+	}
+	return parsed.String()
+}
+
+func (g *Graph) addCell(inventoryName string, cgi string, cell *entities.Cell) {
+	added := &Cell{Cgi: cgi, InventoryName: inventoryName, Cell: cell}
+	g.allCells = append(g.allCells, added)
+	first, ok := g.cells[cgi]
+	if !ok {
+		g.cells[cgi] = added
+		return
+	}
+	if len(g.duplicates[cgi]) == 0 {
+		g.duplicates[cgi] = []*Cell{first}
+	}
+	g.duplicates[cgi] = append(g.duplicates[cgi], added)
+}
+
+func nrNeighbourArfcn(neighbour *entities.NrNeighbourInformation) uint64 {
+	choiceNrMode := neighbour.GetChoiceNrMode()
+	if choiceNrMode.GetTdd() != nil {
+		return choiceNrMode.GetTdd().GetArFcnNrFreqInfo().GetNrArFcn()
+	}
+	return choiceNrMode.GetFdd().GetDlarFcnFreqInfo().GetNrArFcn()
+}
+
+func (g *Graph) addRelation(from *Cell, toCgi string, pci uint32, arfcn uint64) {
+	relation := &Relation{FromCgi: from.Cgi, ToCgi: toCgi, From: from, To: g.cells[toCgi], Pci: pci, Arfcn: arfcn}
+	g.relations[from.Cgi] = append(g.relations[from.Cgi], relation)
+	g.reverse[toCgi] = append(g.reverse[toCgi], relation)
+}
+
+func sortRelations(relations []*Relation) {
+	sort.Slice(relations, func(i, j int) bool {
+		if relations[i].FromCgi != relations[j].FromCgi {
+			return relations[i].FromCgi < relations[j].FromCgi
+		}
+		if relations[i].ToCgi != relations[j].ToCgi {
+			return relations[i].ToCgi < relations[j].ToCgi
+		}
+		return relations[i].From.InventoryName < relations[j].From.InventoryName
+	})
+}
+
+func sortCells(cells []*Cell) {
+	sort.SliceStable(cells, func(i, j int) bool {
+		if cells[i].Cgi != cells[j].Cgi {
+			return cells[i].Cgi < cells[j].Cgi
+		}
+		return cells[i].InventoryName < cells[j].InventoryName
+	})
+}
+
+// Cell returns the served cell of the CGI, given in any form entities.ParseNRCGI or entities.ParseECGI accept
+func (g *Graph) Cell(cgi string) (*Cell, bool) {
+	cell, ok := g.cells[g.normalize(cgi)]
+	return cell, ok
+}
+
+// Cells returns every served cell of the graph, sorted by CGI and inventory name
+func (g *Graph) Cells() []*Cell {
+	cells := append([]*Cell{}, g.allCells...)
+	sortCells(cells)
+	return cells
+}
+
+// DuplicateCells returns the served cells sharing their CGI with a cell of another or the same nodeb, sorted by CGI and inventory name
+func (g *Graph) DuplicateCells() []*Cell {
+	cells := []*Cell{}
+	for _, duplicates := range g.duplicates {
+		cells = append(cells, duplicates...)
+	}
+	sortCells(cells)
+	return cells
+}
+
+// Neighbours returns the relations the cells of the CGI declare, sorted by neighbour CGI
+func (g *Graph) Neighbours(cgi string) []*Relation {
+	return g.relations[g.normalize(cgi)]
+}
+
+// ReverseNeighbours returns the relations declaring the cell as a neighbour, sorted by declaring cell CGI
+func (g *Graph) ReverseNeighbours(cgi string) []*Relation {
+	return g.reverse[g.normalize(cgi)]
+}
+
+// Relations returns all the relations of the graph, sorted by declaring cell and neighbour CGIs
+func (g *Graph) Relations() []*Relation {
+	return g.selectRelations(func(*Relation) bool { return true })
+}
+
+// DanglingRelations returns the relations whose neighbour is not a cell served by any nodeb of the graph
+func (g *Graph) DanglingRelations() []*Relation {
+	return g.selectRelations((*Relation).Dangling)
+}
+
+// MissingReciprocalRelations returns the relations between served cells whose neighbour does not declare the reverse relation
+func (g *Graph) MissingReciprocalRelations() []*Relation {
+	return g.selectRelations(func(relation *Relation) bool {
+		return !relation.Dangling() && !g.hasRelation(relation.ToCgi, relation.FromCgi)
+	})
+}
+
+func (g *Graph) hasRelation(fromCgi string, toCgi string) bool {
+	for _, relation := range g.relations[fromCgi] {
+		if relation.ToCgi == toCgi {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Graph) selectRelations(selected func(*Relation) bool) []*Relation {
+	relations := []*Relation{}
+	for _, cellRelations := range g.relations {
+		for _, relation := range cellRelations {
+			if selected(relation) {
+				relations = append(relations, relation)
+			}
+		}
+	}
+	sortRelations(relations)
+	return relations
+}
+
+// normalize looks the CGI up as an NR CGI first, then as an ECGI
+func (g *Graph) normalize(cgi string) string {
+	if nrCgi := NormalizeNrCgi(cgi); g.known(nrCgi) {
+		return nrCgi
+	}
+	return NormalizeEcgi(cgi)
+}
+
+func (g *Graph) known(cgi string) bool {
+	_, isCell := g.cells[cgi]
+	_, isNeighbour := g.reverse[cgi]
+	return isCell || isNeighbour
+}
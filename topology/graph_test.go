@@ -0,0 +1,158 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package topology
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const (
+	nrCgiA = "02f829:4a952a0a10"
+	nrCgiB = "02f829:4a952a0a20"
+	nrCgiC = "02f829:4a952b0a10"
+	nrCgiD = "02f829:4a952c0a10"
+	ecgiE  = "02f829:007a81f0"
+	ecgiF  = "02f829:007a8200"
+)
+
+func nrCell(cgi string, pci uint32, neighbours ...string) *entities.ServedNRCell {
+	cell := &entities.ServedNRCell{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: cgi, NrPci: pci}}
+	for i, neighbour := range neighbours {
+		cell.NrNeighbourInfos = append(cell.NrNeighbourInfos, &entities.NrNeighbourInformation{NrCgi: neighbour, NrPci: uint32(100 + i)})
+	}
+	return cell
+}
+
+func lteCell(cgi string, pci uint32, neighbours ...string) *entities.ServedCellInfo {
+	cell := &entities.ServedCellInfo{CellId: cgi, Pci: pci}
+	for i, neighbour := range neighbours {
+		cell.NeighbourInfos = append(cell.NeighbourInfos, &entities.NeighbourInformation{Ecgi: neighbour, Pci: uint32(200 + i)})
+	}
+	return cell
+}
+
+func gnb(name string, cells ...*entities.ServedNRCell) *entities.NodebInfo {
+	return &entities.NodebInfo{RanName: name, NodeType: entities.Node_GNB, Configuration: &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{ServedNrCells: cells}}}
+}
+
+func enb(name string, cells ...*entities.ServedCellInfo) *entities.NodebInfo {
+	return &entities.NodebInfo{RanName: name, NodeType: entities.Node_ENB, Configuration: &entities.NodebInfo_Enb{Enb: &entities.Enb{ServedCells: cells}}}
+}
+
+func testNodebs() []*entities.NodebInfo {
+	return []*entities.NodebInfo{
+		gnb("gnb_1", nrCell(nrCgiA, 1, nrCgiC, "02F8294A952A0A20"), nrCell(nrCgiB, 2, nrCgiA)),
+		gnb("gnb_2", nrCell("02f8294a952b0a10", 3, nrCgiD)),
+		enb("enb_1", lteCell(ecgiE, 4, ecgiF), lteCell(ecgiF, 5)),
+	}
+}
+
+func relationPairs(relations []*Relation) [][2]string {
+	pairs := [][2]string{}
+	for _, relation := range relations {
+		pairs = append(pairs, [2]string{relation.FromCgi, relation.ToCgi})
+	}
+	return pairs
+}
+
+func TestBuildFromNodebs(t *testing.T) {
+	g := BuildFromNodebs(testNodebs())
+	assert.Len(t, g.Cells(), 5)
+	cell, ok := g.Cell("02f8294a952b0a10")
+	assert.True(t, ok)
+	assert.Equal(t, "gnb_2", cell.InventoryName)
+	assert.Equal(t, entities.Cell_NR_CELL, cell.Type())
+	assert.Equal(t, uint32(3), cell.Pci())
+	cell, ok = g.Cell(ecgiF)
+	assert.True(t, ok)
+	assert.Equal(t, entities.Cell_LTE_CELL, cell.Type())
+	_, ok = g.Cell(nrCgiD)
+	assert.False(t, ok)
+}
+
+func TestNeighbours(t *testing.T) {
+	g := BuildFromNodebs(testNodebs())
+	neighbours := g.Neighbours(nrCgiA)
+	assert.Equal(t, [][2]string{{nrCgiA, nrCgiB}, {nrCgiA, nrCgiC}}, relationPairs(neighbours))
+	assert.Equal(t, "gnb_1", neighbours[0].To.InventoryName)
+	assert.Equal(t, "gnb_2", neighbours[1].To.InventoryName)
+	assert.Equal(t, uint32(101), neighbours[0].Pci)
+	assert.Empty(t, g.Neighbours(ecgiF))
+	assert.Empty(t, g.Neighbours("unknown"))
+}
+
+func TestReverseNeighbours(t *testing.T) {
+	g := BuildFromNodebs(testNodebs())
+	assert.Equal(t, [][2]string{{nrCgiB, nrCgiA}}, relationPairs(g.ReverseNeighbours(nrCgiA)))
+	assert.Equal(t, [][2]string{{nrCgiC, nrCgiD}}, relationPairs(g.ReverseNeighbours(nrCgiD)))
+	assert.Equal(t, [][2]string{{ecgiE, ecgiF}}, relationPairs(g.ReverseNeighbours("02f829007a8200")))
+}
+
+func TestDanglingRelations(t *testing.T) {
+	g := BuildFromNodebs(testNodebs())
+	dangling := g.DanglingRelations()
+	assert.Equal(t, [][2]string{{nrCgiC, nrCgiD}}, relationPairs(dangling))
+	assert.True(t, dangling[0].Dangling())
+	assert.Equal(t, "gnb_2", dangling[0].From.InventoryName)
+}
+
+func TestMissingReciprocalRelations(t *testing.T) {
+	g := BuildFromNodebs(testNodebs())
+	assert.Equal(t, [][2]string{{ecgiE, ecgiF}, {nrCgiA, nrCgiC}}, relationPairs(g.MissingReciprocalRelations()))
+	assert.Len(t, g.Relations(), 5)
+}
+
+func TestDuplicateCells(t *testing.T) {
+	nodebs := append(testNodebs(), gnb("gnb_3", nrCell("02F8294A952A0A20", 6, nrCgiD)))
+	g := BuildFromNodebs(nodebs)
+	assert.Len(t, g.Cells(), 6)
+	duplicates := g.DuplicateCells()
+	assert.Len(t, duplicates, 2)
+	assert.Equal(t, []string{nrCgiB, nrCgiB}, []string{duplicates[0].Cgi, duplicates[1].Cgi})
+	assert.Equal(t, []string{"gnb_1", "gnb_3"}, []string{duplicates[0].InventoryName, duplicates[1].InventoryName})
+	cell, ok := g.Cell(nrCgiB)
+	assert.True(t, ok)
+	assert.Equal(t, "gnb_1", cell.InventoryName)
+
+	neighbours := g.Neighbours(nrCgiB)
+	assert.Equal(t, [][2]string{{nrCgiB, nrCgiA}, {nrCgiB, nrCgiD}}, relationPairs(neighbours))
+	assert.Equal(t, "gnb_3", neighbours[1].From.InventoryName)
+	assert.Equal(t, [][2]string{{nrCgiB, nrCgiD}, {nrCgiC, nrCgiD}}, relationPairs(g.ReverseNeighbours(nrCgiD)))
+	assert.Empty(t, BuildFromNodebs(testNodebs()).DuplicateCells())
+}
+
+func TestBuild(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	w := writer.GetNewRNibWriter(storage)
+	for _, nodeb := range testNodebs() {
+		assert.Nil(t, w.SaveNodeb(nodeb))
+		assert.Nil(t, w.AddNbIdentity(nodeb.GetNodeType(), &entities.NbIdentity{InventoryName: nodeb.GetRanName()}))
+	}
+	g, err := Build(reader.GetNewRNibReader(storage))
+	assert.Nil(t, err)
+	assert.Len(t, g.Cells(), 5)
+	assert.Len(t, g.DanglingRelations(), 1)
+}
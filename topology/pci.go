@@ -0,0 +1,136 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package topology
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"sort"
+)
+
+/*
+PciCell is a cell of a PCI issue with the PCI and downlink ARFCN it is known by: the ones it serves with,
+or the ones its declaring cell gives for a neighbour not served by any nodeb, whose InventoryName is "".
+*/
+type PciCell struct {
+	Cgi           string `json:"cgi"`
+	InventoryName string `json:"inventoryName"`
+	Pci           uint32 `json:"pci"`
+	Arfcn         uint64 `json:"arfcn"`
+}
+
+// PciCollision is a pair of neighbouring cells sharing their PCI and ARFCN
+type PciCollision struct {
+	Cell      PciCell `json:"cell"`
+	Neighbour PciCell `json:"neighbour"`
+}
+
+// PciConfusion is a cell having several neighbours sharing a PCI and ARFCN
+type PciConfusion struct {
+	Cell       PciCell   `json:"cell"`
+	Pci        uint32    `json:"pci"`
+	Arfcn      uint64    `json:"arfcn"`
+	Neighbours []PciCell `json:"neighbours"`
+}
+
+// PciReport holds the PCI collisions and confusions of the graph
+type PciReport struct {
+	Collisions []PciCollision `json:"collisions"`
+	Confusions []PciConfusion `json:"confusions"`
+}
+
+type pciArfcn struct {
+	pci   uint32
+	arfcn uint64
+}
+
+// AnalyzePci loads every nodeb through the reader and reports the PCI collisions and confusions of their cells
+func AnalyzePci(r reader.RNibReader) (*PciReport, error) {
+	g, err := Build(r)
+	if err != nil {
+		return nil, err
+	}
+	return g.AnalyzePci(), nil
+}
+
+/*
+AnalyzePci reports the PCI collisions and confusions of the graph. A relation declared by both cells yields a single collision.
+Collisions are sorted by cell and neighbour CGIs, confusions by cell CGI, PCI and ARFCN.
+*/
+func (g *Graph) AnalyzePci() *PciReport {
+	report := &PciReport{Collisions: []PciCollision{}, Confusions: []PciConfusion{}}
+	collided := map[[2]PciCell]bool{}
+	for _, relation := range g.Relations() {
+		cell, neighbour := relation.fromPciCell(), relation.toPciCell()
+		if cell.Pci == neighbour.Pci && cell.Arfcn == neighbour.Arfcn {
+			pair := [2]PciCell{cell, neighbour}
+			if pair[0].Cgi > pair[1].Cgi || pair[0].Cgi == pair[1].Cgi && pair[0].InventoryName > pair[1].InventoryName {
+				pair[0], pair[1] = pair[1], pair[0]
+			}
+			if !collided[pair] {
+				collided[pair] = true
+				report.Collisions = append(report.Collisions, PciCollision{Cell: cell, Neighbour: neighbour})
+			}
+		}
+	}
+	for _, cell := range g.Cells() {
+		neighbours := map[pciArfcn][]PciCell{}
+		for _, relation := range g.Neighbours(cell.Cgi) {
+			if relation.From != cell {
+				continue
+			}
+			neighbour := relation.toPciCell()
+			key := pciArfcn{pci: neighbour.Pci, arfcn: neighbour.Arfcn}
+			neighbours[key] = append(neighbours[key], neighbour)
+		}
+		for key, sharing := range neighbours {
+			if len(sharing) > 1 {
+				report.Confusions = append(report.Confusions, PciConfusion{Cell: newPciCell(cell), Pci: key.pci, Arfcn: key.arfcn, Neighbours: sharing})
+			}
+		}
+	}
+	sort.Slice(report.Confusions, func(i, j int) bool {
+		a, b := report.Confusions[i], report.Confusions[j]
+		if a.Cell.Cgi != b.Cell.Cgi {
+			return a.Cell.Cgi < b.Cell.Cgi
+		}
+		if a.Pci != b.Pci {
+			return a.Pci < b.Pci
+		}
+		return a.Arfcn < b.Arfcn
+	})
+	return report
+}
+
+func newPciCell(cell *Cell) PciCell {
+	return PciCell{Cgi: cell.Cgi, InventoryName: cell.InventoryName, Pci: cell.Pci(), Arfcn: cell.Arfcn()}
+}
+
+func (r *Relation) fromPciCell() PciCell {
+	return newPciCell(r.From)
+}
+
+// toPciCell returns the neighbour as it serves when it is known, as declared otherwise
+func (r *Relation) toPciCell() PciCell {
+	if r.To != nil {
+		return newPciCell(r.To)
+	}
+	return PciCell{Cgi: r.ToCgi, Pci: r.Pci, Arfcn: r.Arfcn}
+}
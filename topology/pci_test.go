@@ -0,0 +1,127 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package topology
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/writer"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func tddNrCell(cgi string, pci uint32, arfcn uint64, neighbours ...*entities.NrNeighbourInformation) *entities.ServedNRCell {
+	return &entities.ServedNRCell{
+		ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: cgi, NrPci: pci, ChoiceNrMode: &entities.ServedNRCellInformation_ChoiceNRMode{
+			Tdd: &entities.ServedNRCellInformation_ChoiceNRMode_TddInfo{NrFreqInfo: &entities.NrFrequencyInfo{NrArFcn: arfcn}},
+		}},
+		NrNeighbourInfos: neighbours,
+	}
+}
+
+func tddNrNeighbour(cgi string, pci uint32, arfcn uint64) *entities.NrNeighbourInformation {
+	return &entities.NrNeighbourInformation{NrCgi: cgi, NrPci: pci, ChoiceNrMode: &entities.NrNeighbourInformation_ChoiceNRMode{
+		Tdd: &entities.NrNeighbourInformation_ChoiceNRMode_TddInfo{ArFcnNrFreqInfo: &entities.NrFrequencyInfo{NrArFcn: arfcn}},
+	}}
+}
+
+func pciNodebs() []*entities.NodebInfo {
+	return []*entities.NodebInfo{
+		gnb("gnb_1",
+			tddNrCell(nrCgiA, 1, 632628,
+				tddNrNeighbour(nrCgiB, 1, 632628),
+				tddNrNeighbour(nrCgiC, 1, 632628),
+				tddNrNeighbour(nrCgiD, 7, 632628),
+				tddNrNeighbour("02f829:4a952d0a10", 7, 632628),
+			),
+			tddNrCell(nrCgiB, 1, 632628, tddNrNeighbour(nrCgiA, 1, 632628)),
+		),
+		gnb("gnb_2", tddNrCell(nrCgiC, 1, 640000)),
+		enb("enb_1", &entities.ServedCellInfo{
+			CellId:          ecgiE,
+			Pci:             10,
+			ChoiceEutraMode: &entities.ChoiceEUTRAMode{Fdd: &entities.FddInfo{DlearFcn: 300}},
+			NeighbourInfos:  []*entities.NeighbourInformation{{Ecgi: ecgiF, Pci: 10, EarFcn: 300}},
+		}),
+	}
+}
+
+func TestAnalyzePciCollisions(t *testing.T) {
+	report := BuildFromNodebs(pciNodebs()).AnalyzePci()
+	assert.Equal(t, []PciCollision{
+		{
+			Cell:      PciCell{Cgi: ecgiE, InventoryName: "enb_1", Pci: 10, Arfcn: 300},
+			Neighbour: PciCell{Cgi: ecgiF, Pci: 10, Arfcn: 300},
+		},
+		{
+			Cell:      PciCell{Cgi: nrCgiA, InventoryName: "gnb_1", Pci: 1, Arfcn: 632628},
+			Neighbour: PciCell{Cgi: nrCgiB, InventoryName: "gnb_1", Pci: 1, Arfcn: 632628},
+		},
+	}, report.Collisions)
+}
+
+func TestAnalyzePciConfusions(t *testing.T) {
+	report := BuildFromNodebs(pciNodebs()).AnalyzePci()
+	assert.Len(t, report.Confusions, 1)
+	confusion := report.Confusions[0]
+	assert.Equal(t, nrCgiA, confusion.Cell.Cgi)
+	assert.Equal(t, uint32(7), confusion.Pci)
+	assert.Equal(t, uint64(632628), confusion.Arfcn)
+	assert.Equal(t, []string{nrCgiD, "02f829:4a952d0a10"}, []string{confusion.Neighbours[0].Cgi, confusion.Neighbours[1].Cgi})
+}
+
+func TestAnalyzePciDuplicateCells(t *testing.T) {
+	nodebs := append(pciNodebs(), gnb("gnb_3", tddNrCell(nrCgiB, 5, 632628,
+		tddNrNeighbour(nrCgiD, 5, 632628),
+		tddNrNeighbour("02f829:4a952e0a10", 5, 632628),
+	)))
+	report := BuildFromNodebs(nodebs).AnalyzePci()
+	assert.Len(t, report.Collisions, 4)
+	assert.Equal(t, PciCollision{
+		Cell:      PciCell{Cgi: nrCgiB, InventoryName: "gnb_3", Pci: 5, Arfcn: 632628},
+		Neighbour: PciCell{Cgi: nrCgiD, Pci: 5, Arfcn: 632628},
+	}, report.Collisions[2])
+	assert.Len(t, report.Confusions, 2)
+	confusion := report.Confusions[1]
+	assert.Equal(t, PciCell{Cgi: nrCgiB, InventoryName: "gnb_3", Pci: 5, Arfcn: 632628}, confusion.Cell)
+	assert.Equal(t, uint32(5), confusion.Pci)
+	assert.Len(t, confusion.Neighbours, 2)
+}
+
+func TestAnalyzePci(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	w := writer.GetNewRNibWriter(storage)
+	for _, nodeb := range pciNodebs() {
+		assert.Nil(t, w.SaveNodeb(nodeb))
+		assert.Nil(t, w.AddNbIdentity(nodeb.GetNodeType(), &entities.NbIdentity{InventoryName: nodeb.GetRanName()}))
+	}
+	report, err := AnalyzePci(reader.GetNewRNibReader(storage))
+	assert.Nil(t, err)
+	assert.Len(t, report.Collisions, 2)
+	assert.Len(t, report.Confusions, 1)
+}
+
+func TestAnalyzePciNoIssues(t *testing.T) {
+	report := BuildFromNodebs(testNodebs()).AnalyzePci()
+	assert.Empty(t, report.Collisions)
+	assert.Empty(t, report.Confusions)
+}
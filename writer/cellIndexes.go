@@ -0,0 +1,145 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+
+package writer
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+)
+
+/*
+Cells are indexed when they are written:
+the cell entity is also stored under its NR CGI / ECGI key, and the cell key is added to the group of every PLMN
+and tracking area the cell serves. Cells whose global identities do not parse are left out of the CGI index.
+*/
+
+func buildEcgiKey(cell *entities.ServedCellInfo) (string, bool) {
+	ecgi, err := cell.ParseECGI()
+	if err != nil {
+		return "", false
+	}
+	key, err := common.ValidateAndBuildEcgiKey(ecgi.String())
+	return key, err == nil
+}
+
+func buildNrCgiKey(cell *entities.ServedNRCell) (string, bool) {
+	nrCgi, err := cell.GetServedNrCellInformation().ParseNRCGI()
+	if err != nil {
+		return "", false
+	}
+	key, err := common.ValidateAndBuildNrCgiKey(nrCgi.String())
+	return key, err == nil
+}
+
+func buildNodebCellIndexMembers(nodebInfo *entities.NodebInfo) map[string][]interface{} {
+	members := map[string][]interface{}{}
+	buildEnbCellIndexMembers(nodebInfo.GetEnb().GetServedCells(), members)
+	buildGnbCellIndexMembers(nodebInfo.GetGnb().GetServedNrCells(), members)
+	return members
+}
+
+// BuildNodebCellIndexMembers returns the cell keys SaveNodeb adds to each PLMN and TAC group of the nodeb served cells
+func BuildNodebCellIndexMembers(nodebInfo *entities.NodebInfo) map[string][]string {
+	members := map[string][]string{}
+	for group, cellKeys := range buildNodebCellIndexMembers(nodebInfo) {
+		for _, cellKey := range cellKeys {
+			members[group] = append(members[group], cellKey.(string))
+		}
+	}
+	return members
+}
+
+func buildEnbCellIndexMembers(cells []*entities.ServedCellInfo, members map[string][]interface{}) map[string][]interface{} {
+	for _, cell := range cells {
+		cellKey, err := common.ValidateAndBuildCellIdKey(cell.GetCellId())
+		if err != nil {
+			continue
+		}
+		appendCellIndexMember(members, cellKey, cell.GetBroadcastPlmns(), []string{cell.GetTac()})
+	}
+	return members
+}
+
+func buildGnbCellIndexMembers(cells []*entities.ServedNRCell, members map[string][]interface{}) map[string][]interface{} {
+	for _, cell := range cells {
+		cellInfo := cell.GetServedNrCellInformation()
+		cellKey, err := common.ValidateAndBuildNrCellIdKey(cellInfo.GetCellId())
+		if err != nil {
+			continue
+		}
+		appendCellIndexMember(members, cellKey, cellInfo.GetServedPlmns(), []string{cellInfo.GetConfiguredStac(), cellInfo.GetStac5G()})
+	}
+	return members
+}
+
+func appendCellIndexMember(members map[string][]interface{}, cellKey string, plmns []string, tacs []string) {
+	groups := map[string]bool{}
+	for _, plmn := range plmns {
+		if group, err := common.ValidateAndBuildPlmnCellsKey(plmn); err == nil {
+			groups[group] = true
+		}
+	}
+	for _, tac := range tacs {
+		if group, err := common.ValidateAndBuildTacCellsKey(tac); err == nil {
+			groups[group] = true
+		}
+	}
+	for group := range groups {
+		members[group] = append(members[group], cellKey)
+	}
+}
+
+// diffCellIndexMembers returns the members of previous missing from current
+func diffCellIndexMembers(previous map[string][]interface{}, current map[string][]interface{}) map[string][]interface{} {
+	diff := map[string][]interface{}{}
+	for group, cellKeys := range previous {
+		kept := map[interface{}]bool{}
+		for _, cellKey := range current[group] {
+			kept[cellKey] = true
+		}
+		for _, cellKey := range cellKeys {
+			if !kept[cellKey] {
+				diff[group] = append(diff[group], cellKey)
+			}
+		}
+	}
+	return diff
+}
+
+func (w *rNibWriterInstance) addCellIndexMembers(members map[string][]interface{}) error {
+	for group, cellKeys := range members {
+		err := w.sdlStorage.AddMember(w.ns, group, cellKeys...)
+		if err != nil {
+			return common.NewStorageUnavailableError(err)
+		}
+	}
+	return nil
+}
+
+func (w *rNibWriterInstance) removeCellIndexMembers(members map[string][]interface{}) error {
+	for group, cellKeys := range members {
+		err := w.sdlStorage.RemoveMember(w.ns, group, cellKeys...)
+		if err != nil {
+			return common.NewStorageUnavailableError(err)
+		}
+	}
+	return nil
+}
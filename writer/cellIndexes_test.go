@@ -0,0 +1,199 @@
+//
+// Copyright 2019 AT&T Intellectual Property
+// Copyright 2019 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package writer
+
+import (
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func generateIndexedGnb(name string) *entities.NodebInfo {
+	nb := generateGnb(name)
+	nb.GetGnb().ServedNrCells = []*entities.ServedNRCell{
+		{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: "02f829:4a952a0a50", NrPci: 3, ServedPlmns: []string{"02f829", "13f184"}, ConfiguredStac: "0001", Stac5G: "000002"}},
+		{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: "02f8294a952a0a60", NrPci: 4, ServedPlmns: []string{"02F829"}, Stac5G: "000002"}},
+	}
+	return nb
+}
+
+func generateIndexedEnb(name string) *entities.NodebInfo {
+	nb := generateEnb(name)
+	nb.GetEnb().ServedCells = []*entities.ServedCellInfo{
+		{CellId: "02f829:007a81f0", Pci: 1, BroadcastPlmns: []string{"02f829"}, Tac: "0001"},
+	}
+	return nb
+}
+
+func cellIds(cells []*entities.Cell) []string {
+	var ids []string
+	for _, cell := range cells {
+		if cell.GetServedNrCell() != nil {
+			ids = append(ids, cell.GetServedNrCell().GetServedNrCellInformation().GetCellId())
+		} else {
+			ids = append(ids, cell.GetServedCellInfo().GetCellId())
+		}
+	}
+	return ids
+}
+
+func TestCellIndexesInMemory(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	w := GetNewRNibWriter(storage)
+	r := reader.GetNewRNibReader(storage)
+	gnb := generateIndexedGnb("gnb")
+	enb := generateIndexedEnb("enb")
+	assert.Nil(t, w.SaveNodeb(gnb))
+	assert.Nil(t, w.SaveNodeb(enb))
+
+	cell, err := r.GetCellByCgi(entities.Cell_NR_CELL, "02f8294a952a0a60")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(4), cell.GetServedNrCell().GetServedNrCellInformation().GetNrPci())
+	cell, err = r.GetCellByCgi(entities.Cell_LTE_CELL, "02F829:007A81F0")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), cell.GetServedCellInfo().GetPci())
+
+	cells, err := r.GetCellsByPlmn("02f829")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"02f829:007a81f0", "02f8294a952a0a60", "02f829:4a952a0a50"}, cellIds(cells))
+	cells, err = r.GetCellsByPlmn("13f184")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"02f829:4a952a0a50"}, cellIds(cells))
+	cells, err = r.GetCellsByTac("0001")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"02f829:007a81f0", "02f829:4a952a0a50"}, cellIds(cells))
+	cells, err = r.GetCellsByTac("000002")
+	assert.Nil(t, err)
+	assert.Len(t, cells, 2)
+
+	gnb.GetGnb().GetServedNrCells()[0].GetServedNrCellInformation().ConfiguredStac = "0003"
+	assert.Nil(t, w.UpdateNodebInfo(gnb))
+	cells, err = r.GetCellsByTac("0001")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"02f829:007a81f0"}, cellIds(cells))
+
+	assert.Nil(t, w.RemoveServedNrCells("gnb", gnb.GetGnb().GetServedNrCells()[1:]))
+	_, err = r.GetCellByCgi(entities.Cell_NR_CELL, "02f8294a952a0a60")
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+	cells, err = r.GetCellsByTac("000002")
+	assert.Nil(t, err)
+	assert.Len(t, cells, 1)
+
+	assert.Nil(t, w.RemoveNodeb(enb))
+	_, err = r.GetCellByCgi(entities.Cell_LTE_CELL, "02f829007a81f0")
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+	members, err := storage.GetMembers(common.GetRNibNamespace(), "TAC_CELLS:0001")
+	assert.Nil(t, err)
+	assert.NotContains(t, members, "CELL:02f829:007a81f0")
+}
+
+func TestSaveNodebCellIndexSdlFailure(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	expectNoSavedNodeb(sdlStorageMock, "enb")
+	sdlStorageMock.On("SetAndPublish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	sdlStorageMock.On("AddMember", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("expected error"))
+	err := w.SaveNodeb(generateIndexedEnb("enb"))
+	assert.IsType(t, &common.InternalError{}, err)
+}
+
+func TestUpdateNodebConnectionStatusKeepsCellIndexes(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	nb := generateIndexedGnb("gnb")
+	nb.ConnectionStatus = entities.ConnectionStatus_CONNECTED
+	sdlStorageMock.On("SetAndPublish", common.GetRNibNamespace(), mock.Anything, mock.Anything).Return(nil)
+	assert.Nil(t, w.UpdateNodebConnectionStatus(nb))
+	nb.ConnectionStatus = entities.ConnectionStatus_SHUTTING_DOWN
+	sdlStorageMock.On("Set", common.GetRNibNamespace(), mock.Anything).Return(nil)
+	assert.Nil(t, w.UpdateNodebConnectionStatus(nb))
+	sdlStorageMock.AssertNotCalled(t, "AddMember", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBuildNodebKeyValuesMatchesSavedKeys(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	w := GetNewRNibWriter(storage)
+	gnb := generateIndexedGnb("gnb")
+	assert.Nil(t, w.SaveNodeb(gnb))
+
+	keyValues, err := BuildNodebKeyValues(gnb)
+	assert.Nil(t, err)
+	members := BuildNodebCellIndexMembers(gnb)
+	var expected []string
+	for key := range keyValues {
+		expected = append(expected, key)
+	}
+	for group := range members {
+		expected = append(expected, group)
+	}
+	keys, err := storage.GetAll(common.GetRNibNamespace())
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, expected, keys)
+
+	values, err := storage.Get(common.GetRNibNamespace(), []string{"NRCGI:02f829:4a952a0a60"})
+	assert.Nil(t, err)
+	assert.Equal(t, string(keyValues["NRCGI:02f829:4a952a0a60"]), values["NRCGI:02f829:4a952a0a60"])
+	groupMembers, err := storage.GetMembers(common.GetRNibNamespace(), "TAC_CELLS:000002")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, members["TAC_CELLS:000002"], groupMembers)
+}
+
+func TestUpdateNodebInfoRemovesDroppedCells(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	w := GetNewRNibWriter(storage)
+	gnb := generateIndexedGnb("gnb")
+	assert.Nil(t, w.SaveNodeb(gnb))
+	assert.Nil(t, w.AddNbIdentity(entities.Node_GNB, &entities.NbIdentity{InventoryName: "gnb", GlobalNbId: gnb.GetGlobalNbId()}))
+
+	gnb.GetGnb().ServedNrCells = gnb.GetGnb().GetServedNrCells()[:1]
+	gnb.GetGnb().GetServedNrCells()[0].GetServedNrCellInformation().ConfiguredStac = "0003"
+	assert.Nil(t, w.UpdateNodebInfo(gnb))
+	keys, err := storage.GetAll(common.GetRNibNamespace())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GNB", "GNB:02f829:4a952a0a", "NRCELL:02f829:4a952a0a50", "NRCGI:02f829:4a952a0a50", "PCI:gnb:03",
+		"PLMN_CELLS:02f829", "PLMN_CELLS:13f184", "RAN:gnb", "TAC_CELLS:000002", "TAC_CELLS:0003"}, keys)
+	members, err := storage.GetMembers(common.GetRNibNamespace(), "PLMN_CELLS:02f829")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"NRCELL:02f829:4a952a0a50"}, members)
+
+	assert.Nil(t, w.RemoveNodeb(gnb))
+	keys, err = storage.GetAll(common.GetRNibNamespace())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GNB"}, keys)
+	assert.Nil(t, w.RemoveNbIdentity(entities.Node_GNB, &entities.NbIdentity{InventoryName: "gnb", GlobalNbId: gnb.GetGlobalNbId()}))
+	keys, err = storage.GetAll(common.GetRNibNamespace())
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestUpdateNodebInfoCorruptPreviousNodeb(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	w := GetNewRNibWriter(storage)
+	assert.Nil(t, storage.Set(common.GetRNibNamespace(), "RAN:gnb", []byte("\xff")))
+
+	err := w.UpdateNodebInfo(generateIndexedGnb("gnb"))
+	assert.IsType(t, &common.InternalError{}, err)
+	assert.Equal(t, common.CorruptData, common.GetErrorCode(err))
+	keys, err := storage.GetAll(common.GetRNibNamespace())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"RAN:gnb"}, keys)
+}
@@ -0,0 +1,513 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package writer
+
+import (
+	"encoding/json"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"github.com/golang/protobuf/proto"
+)
+
+const E2TAddressesKey = "E2TAddresses"
+
+type rNibWriterInstance struct {
+	sdlStorage common.ISdlSyncStorage
+	ns         string
+	config     RNibWriterConfig
+}
+
+/*
+RNibWriterConfig holds the channels on which the writer publishes nodeb events
+*/
+type RNibWriterConfig struct {
+	StateChangeMessageChannel     string
+	RanManipulationMessageChannel string
+}
+
+/*
+RNibWriter interface allows saving data to redis DB using the same keys and encodings RNibReader expects.
+The nodeb identity lists are not touched by the nodeb methods and are managed with AddNbIdentity and RemoveNbIdentity.
+*/
+type RNibWriter interface {
+	// SaveNodeb saves the nodeb, its served cells and cell indexes and publishes a RAN added event
+	SaveNodeb(nodebInfo *entities.NodebInfo) error
+	// UpdateNodebInfo saves the nodeb, its served cells and cell indexes and publishes a RAN updated event
+	UpdateNodebInfo(nodebInfo *entities.NodebInfo) error
+	// UpdateNodebConnectionStatus saves the nodeb and publishes its connection status event
+	UpdateNodebConnectionStatus(nodebInfo *entities.NodebInfo) error
+	// RemoveNodeb removes the nodeb, its served cells and cell indexes and publishes a RAN deleted event
+	RemoveNodeb(nodebInfo *entities.NodebInfo) error
+	// RemoveServedCells removes the given LTE cells of the nodeb
+	RemoveServedCells(inventoryName string, servedCells []*entities.ServedCellInfo) error
+	// RemoveServedNrCells removes the given NR cells of the nodeb
+	RemoveServedNrCells(inventoryName string, servedNrCells []*entities.ServedNRCell) error
+	// AddNbIdentity adds the nodeb identity to the identity list of the node type
+	AddNbIdentity(nodeType entities.Node_Type, nbIdentity *entities.NbIdentity) error
+	// RemoveNbIdentity removes the nodeb identity from the identity list of the node type
+	RemoveNbIdentity(nodeType entities.Node_Type, nbIdentity *entities.NbIdentity) error
+	// UpdateNbIdentity replaces the nodeb identity in the identity list of the node type
+	UpdateNbIdentity(nodeType entities.Node_Type, oldNbIdentity *entities.NbIdentity, newNbIdentity *entities.NbIdentity) error
+	// SaveRanLoadInformation saves the load information of the nodeb
+	SaveRanLoadInformation(inventoryName string, ranLoadInformation *entities.RanLoadInformation) error
+	// RemoveRanLoadInformation removes the load information of the nodeb
+	RemoveRanLoadInformation(inventoryName string) error
+	// SaveE2TInstance saves the E2T instance under its address
+	SaveE2TInstance(e2tInstance *entities.E2TInstance) error
+	// RemoveE2TInstance removes the E2T instance of the address
+	RemoveE2TInstance(address string) error
+	// SaveE2TAddresses saves the list of E2T instance addresses
+	SaveE2TAddresses(addresses []string) error
+	// SaveGeneralConfiguration saves the general configuration
+	SaveGeneralConfiguration(config *entities.GeneralConfiguration) error
+}
+
+// GetNewRNibWriter returns reference to RNibWriter publishing on the default RAN channels
+func GetNewRNibWriter(storage common.ISdlSyncStorage) RNibWriter {
+	return GetNewRNibWriterWithConfig(storage, RNibWriterConfig{
+		StateChangeMessageChannel:     common.StateChangeMessageChannel,
+		RanManipulationMessageChannel: common.RanManipulationMessageChannel,
+	})
+}
+
+// GetNewRNibWriterWithConfig returns reference to RNibWriter publishing on the configured channels
+func GetNewRNibWriterWithConfig(storage common.ISdlSyncStorage, config RNibWriterConfig) RNibWriter {
+	return &rNibWriterInstance{
+		sdlStorage: storage,
+		ns:         common.GetRNibNamespace(),
+		config:     config,
+	}
+}
+
+func (w *rNibWriterInstance) SaveNodeb(nodebInfo *entities.NodebInfo) error {
+	return w.replaceNodebAndPublish(nodebInfo, common.RanAddedEvent)
+}
+
+func (w *rNibWriterInstance) UpdateNodebInfo(nodebInfo *entities.NodebInfo) error {
+	return w.replaceNodebAndPublish(nodebInfo, common.RanUpdatedEvent)
+}
+
+func (w *rNibWriterInstance) UpdateNodebConnectionStatus(nodebInfo *entities.NodebInfo) error {
+	switch nodebInfo.GetConnectionStatus() {
+	case entities.ConnectionStatus_CONNECTED:
+		return w.setNodebAndPublish(nodebInfo, w.config.StateChangeMessageChannel, common.RanConnectedEvent)
+	case entities.ConnectionStatus_DISCONNECTED:
+		return w.setNodebAndPublish(nodebInfo, w.config.StateChangeMessageChannel, common.RanDisconnectedEvent)
+	}
+	pairs, err := buildNodebPairs(nodebInfo)
+	if err != nil {
+		return err
+	}
+	err = w.sdlStorage.Set(w.ns, pairs...)
+	if err != nil {
+		return common.NewStorageUnavailableError(err)
+	}
+	return nil
+}
+
+func (w *rNibWriterInstance) RemoveNodeb(nodebInfo *entities.NodebInfo) error {
+	keys, err := buildNodebKeys(nodebInfo)
+	if err != nil {
+		return err
+	}
+	if nodebInfo.GetEnb() != nil {
+		cellKeys, err := buildCellKeys(nodebInfo.GetRanName(), nodebInfo.GetEnb().GetServedCells())
+		if err != nil {
+			return err
+		}
+		keys = append(keys, cellKeys...)
+	}
+	if nodebInfo.GetGnb() != nil {
+		cellKeys, err := buildNrCellKeys(nodebInfo.GetRanName(), nodebInfo.GetGnb().GetServedNrCells())
+		if err != nil {
+			return err
+		}
+		keys = append(keys, cellKeys...)
+	}
+	channelsAndEvents := []string{w.config.RanManipulationMessageChannel, common.BuildRanEvent(nodebInfo.GetRanName(), common.RanDeletedEvent)}
+	err = w.sdlStorage.RemoveAndPublish(w.ns, channelsAndEvents, keys)
+	if err != nil {
+		return common.NewStorageUnavailableError(err)
+	}
+	return w.removeCellIndexMembers(buildNodebCellIndexMembers(nodebInfo))
+}
+
+func (w *rNibWriterInstance) RemoveServedCells(inventoryName string, servedCells []*entities.ServedCellInfo) error {
+	keys, err := buildCellKeys(inventoryName, servedCells)
+	if err != nil {
+		return err
+	}
+	err = w.removeKeys(keys)
+	if err != nil {
+		return err
+	}
+	return w.removeCellIndexMembers(buildEnbCellIndexMembers(servedCells, map[string][]interface{}{}))
+}
+
+func (w *rNibWriterInstance) RemoveServedNrCells(inventoryName string, servedNrCells []*entities.ServedNRCell) error {
+	keys, err := buildNrCellKeys(inventoryName, servedNrCells)
+	if err != nil {
+		return err
+	}
+	err = w.removeKeys(keys)
+	if err != nil {
+		return err
+	}
+	return w.removeCellIndexMembers(buildGnbCellIndexMembers(servedNrCells, map[string][]interface{}{}))
+}
+
+func (w *rNibWriterInstance) AddNbIdentity(nodeType entities.Node_Type, nbIdentity *entities.NbIdentity) error {
+	data, err := proto.Marshal(nbIdentity)
+	if err != nil {
+		return common.NewInternalError(err)
+	}
+	err = w.sdlStorage.AddMember(w.ns, nodeType.String(), data)
+	if err != nil {
+		return common.NewStorageUnavailableError(err)
+	}
+	return nil
+}
+
+func (w *rNibWriterInstance) RemoveNbIdentity(nodeType entities.Node_Type, nbIdentity *entities.NbIdentity) error {
+	data, err := proto.Marshal(nbIdentity)
+	if err != nil {
+		return common.NewInternalError(err)
+	}
+	err = w.sdlStorage.RemoveMember(w.ns, nodeType.String(), data)
+	if err != nil {
+		return common.NewStorageUnavailableError(err)
+	}
+	return nil
+}
+
+func (w *rNibWriterInstance) UpdateNbIdentity(nodeType entities.Node_Type, oldNbIdentity *entities.NbIdentity, newNbIdentity *entities.NbIdentity) error {
+	err := w.RemoveNbIdentity(nodeType, oldNbIdentity)
+	if err != nil {
+		return err
+	}
+	return w.AddNbIdentity(nodeType, newNbIdentity)
+}
+
+func (w *rNibWriterInstance) SaveRanLoadInformation(inventoryName string, ranLoadInformation *entities.RanLoadInformation) error {
+	key, rNibErr := common.ValidateAndBuildRanLoadInformationKey(inventoryName)
+	if rNibErr != nil {
+		return rNibErr
+	}
+	data, err := proto.Marshal(ranLoadInformation)
+	if err != nil {
+		return common.NewInternalError(err)
+	}
+	return w.setKeyValue(key, data)
+}
+
+func (w *rNibWriterInstance) RemoveRanLoadInformation(inventoryName string) error {
+	key, rNibErr := common.ValidateAndBuildRanLoadInformationKey(inventoryName)
+	if rNibErr != nil {
+		return rNibErr
+	}
+	return w.removeKeys([]string{key})
+}
+
+func (w *rNibWriterInstance) SaveE2TInstance(e2tInstance *entities.E2TInstance) error {
+	key, rNibErr := common.ValidateAndBuildE2TInstanceKey(e2tInstance.Address)
+	if rNibErr != nil {
+		return rNibErr
+	}
+	data, err := json.Marshal(e2tInstance)
+	if err != nil {
+		return common.NewInternalError(err)
+	}
+	return w.setKeyValue(key, data)
+}
+
+func (w *rNibWriterInstance) RemoveE2TInstance(address string) error {
+	key, rNibErr := common.ValidateAndBuildE2TInstanceKey(address)
+	if rNibErr != nil {
+		return rNibErr
+	}
+	return w.removeKeys([]string{key})
+}
+
+func (w *rNibWriterInstance) SaveE2TAddresses(addresses []string) error {
+	data, err := json.Marshal(addresses)
+	if err != nil {
+		return common.NewInternalError(err)
+	}
+	return w.setKeyValue(E2TAddressesKey, data)
+}
+
+func (w *rNibWriterInstance) SaveGeneralConfiguration(config *entities.GeneralConfiguration) error {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return common.NewInternalError(err)
+	}
+	return w.setKeyValue(common.BuildGeneralConfigurationKey(), data)
+}
+
+/*
+replaceNodebAndPublish sets the nodeb, its cells and their cell index members, then removes the keys and cell index members
+of the cells the previously saved nodeb served and the new one does not
+*/
+func (w *rNibWriterInstance) replaceNodebAndPublish(nodebInfo *entities.NodebInfo, event string) error {
+	pairs, err := buildNodebPairs(nodebInfo)
+	if err != nil {
+		return err
+	}
+	previous, err := w.getNodeb(nodebInfo.GetRanName())
+	if err != nil {
+		return err
+	}
+	err = w.setNodebAndPublish(nodebInfo, w.config.RanManipulationMessageChannel, event)
+	if err != nil {
+		return err
+	}
+	err = w.addCellIndexMembers(buildNodebCellIndexMembers(nodebInfo))
+	if err != nil {
+		return err
+	}
+	if previous == nil {
+		return nil
+	}
+	previousPairs, err := buildNodebPairs(previous)
+	if err != nil {
+		// The previous nodeb cannot have been saved with these keys
+		return nil
+	}
+	current := map[string]bool{}
+	for i := 0; i < len(pairs); i += 2 {
+		current[pairs[i].(string)] = true
+	}
+	var staleKeys []string
+	for i := 0; i < len(previousPairs); i += 2 {
+		if key := previousPairs[i].(string); !current[key] {
+			staleKeys = append(staleKeys, key)
+		}
+	}
+	if len(staleKeys) > 0 {
+		err = w.removeKeys(staleKeys)
+		if err != nil {
+			return err
+		}
+	}
+	return w.removeCellIndexMembers(diffCellIndexMembers(buildNodebCellIndexMembers(previous), buildNodebCellIndexMembers(nodebInfo)))
+}
+
+// getNodeb returns the saved nodeb, nil when there is none, and a corrupt data error when it cannot be decoded
+func (w *rNibWriterInstance) getNodeb(inventoryName string) (*entities.NodebInfo, error) {
+	key, rNibErr := common.ValidateAndBuildNodeBNameKey(inventoryName)
+	if rNibErr != nil {
+		return nil, rNibErr
+	}
+	values, err := w.sdlStorage.Get(w.ns, []string{key})
+	if err != nil {
+		return nil, common.NewStorageUnavailableError(err)
+	}
+	data, ok := values[key].(string)
+	if !ok {
+		return nil, nil
+	}
+	nodebInfo := &entities.NodebInfo{}
+	err = proto.Unmarshal([]byte(data), nodebInfo)
+	if err != nil {
+		return nil, common.NewCorruptDataError("*entities.NodebInfo", key, err)
+	}
+	return nodebInfo, nil
+}
+
+func (w *rNibWriterInstance) setNodebAndPublish(nodebInfo *entities.NodebInfo, channel string, event string) error {
+	pairs, err := buildNodebPairs(nodebInfo)
+	if err != nil {
+		return err
+	}
+	channelsAndEvents := []string{channel, common.BuildRanEvent(nodebInfo.GetRanName(), event)}
+	err = w.sdlStorage.SetAndPublish(w.ns, channelsAndEvents, pairs...)
+	if err != nil {
+		return common.NewStorageUnavailableError(err)
+	}
+	return nil
+}
+
+func (w *rNibWriterInstance) setKeyValue(key string, data []byte) error {
+	err := w.sdlStorage.Set(w.ns, key, data)
+	if err != nil {
+		return common.NewStorageUnavailableError(err)
+	}
+	return nil
+}
+
+func (w *rNibWriterInstance) removeKeys(keys []string) error {
+	err := w.sdlStorage.Remove(w.ns, keys)
+	if err != nil {
+		return common.NewStorageUnavailableError(err)
+	}
+	return nil
+}
+
+func buildNodebKeys(nodebInfo *entities.NodebInfo) ([]string, error) {
+	key, rNibErr := common.ValidateAndBuildNodeBNameKey(nodebInfo.GetRanName())
+	if rNibErr != nil {
+		return nil, rNibErr
+	}
+	keys := []string{key}
+	if nodebInfo.GetGlobalNbId() != nil {
+		globalNbId := nodebInfo.GetGlobalNbId()
+		key, rNibErr = common.ValidateAndBuildNodeBIdKey(nodebInfo.GetNodeType().String(), globalNbId.GetPlmnId(), globalNbId.GetNbId(), nodebInfo.GetCuUpId(), nodebInfo.GetDuId())
+		if rNibErr != nil {
+			return nil, rNibErr
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func buildNodebPairs(nodebInfo *entities.NodebInfo) ([]interface{}, error) {
+	keys, rNibErr := buildNodebKeys(nodebInfo)
+	if rNibErr != nil {
+		return nil, rNibErr
+	}
+	data, err := proto.Marshal(nodebInfo)
+	if err != nil {
+		return nil, common.NewInternalError(err)
+	}
+	var pairs []interface{}
+	for _, key := range keys {
+		pairs = append(pairs, key, data)
+	}
+	if nodebInfo.GetEnb() != nil {
+		pairs, rNibErr = appendEnbCells(nodebInfo.GetRanName(), nodebInfo.GetEnb().GetServedCells(), pairs)
+		if rNibErr != nil {
+			return nil, rNibErr
+		}
+	}
+	if nodebInfo.GetGnb() != nil {
+		pairs, rNibErr = appendGnbCells(nodebInfo.GetRanName(), nodebInfo.GetGnb().GetServedNrCells(), pairs)
+		if rNibErr != nil {
+			return nil, rNibErr
+		}
+	}
+	return pairs, nil
+}
+
+/*
+BuildNodebKeyValues returns every key SaveNodeb sets for the nodeb together with the value it stores there:
+the nodeb entity under its inventory name and global Id keys, and the cell entities under their Id, PCI and CGI keys
+*/
+func BuildNodebKeyValues(nodebInfo *entities.NodebInfo) (map[string][]byte, error) {
+	pairs, err := buildNodebPairs(nodebInfo)
+	if err != nil {
+		return nil, err
+	}
+	keyValues := make(map[string][]byte, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		keyValues[pairs[i].(string)] = pairs[i+1].([]byte)
+	}
+	return keyValues, nil
+}
+
+func appendEnbCells(inventoryName string, cells []*entities.ServedCellInfo, pairs []interface{}) ([]interface{}, error) {
+	for _, cell := range cells {
+		cellEntity := entities.Cell{Type: entities.Cell_LTE_CELL, Cell: &entities.Cell_ServedCellInfo{ServedCellInfo: cell}}
+		data, err := proto.Marshal(&cellEntity)
+		if err != nil {
+			return nil, common.NewInternalError(err)
+		}
+		key, rNibErr := common.ValidateAndBuildCellIdKey(cell.GetCellId())
+		if rNibErr != nil {
+			return nil, rNibErr
+		}
+		pairs = append(pairs, key, data)
+		key, rNibErr = common.ValidateAndBuildCellNamePciKey(inventoryName, cell.GetPci())
+		if rNibErr != nil {
+			return nil, rNibErr
+		}
+		pairs = append(pairs, key, data)
+		if ecgiKey, ok := buildEcgiKey(cell); ok {
+			pairs = append(pairs, ecgiKey, data)
+		}
+	}
+	return pairs, nil
+}
+
+func appendGnbCells(inventoryName string, cells []*entities.ServedNRCell, pairs []interface{}) ([]interface{}, error) {
+	for _, cell := range cells {
+		cellEntity := entities.Cell{Type: entities.Cell_NR_CELL, Cell: &entities.Cell_ServedNrCell{ServedNrCell: cell}}
+		data, err := proto.Marshal(&cellEntity)
+		if err != nil {
+			return nil, common.NewInternalError(err)
+		}
+		key, rNibErr := common.ValidateAndBuildNrCellIdKey(cell.GetServedNrCellInformation().GetCellId())
+		if rNibErr != nil {
+			return nil, rNibErr
+		}
+		pairs = append(pairs, key, data)
+		key, rNibErr = common.ValidateAndBuildCellNamePciKey(inventoryName, cell.GetServedNrCellInformation().GetNrPci())
+		if rNibErr != nil {
+			return nil, rNibErr
+		}
+		pairs = append(pairs, key, data)
+		if nrCgiKey, ok := buildNrCgiKey(cell); ok {
+			pairs = append(pairs, nrCgiKey, data)
+		}
+	}
+	return pairs, nil
+}
+
+func buildCellKeys(inventoryName string, cells []*entities.ServedCellInfo) ([]string, error) {
+	var keys []string
+	for _, cell := range cells {
+		key, rNibErr := common.ValidateAndBuildCellIdKey(cell.GetCellId())
+		if rNibErr != nil {
+			return nil, rNibErr
+		}
+		pciKey, rNibErr := common.ValidateAndBuildCellNamePciKey(inventoryName, cell.GetPci())
+		if rNibErr != nil {
+			return nil, rNibErr
+		}
+		keys = append(keys, key, pciKey)
+		if ecgiKey, ok := buildEcgiKey(cell); ok {
+			keys = append(keys, ecgiKey)
+		}
+	}
+	return keys, nil
+}
+
+func buildNrCellKeys(inventoryName string, cells []*entities.ServedNRCell) ([]string, error) {
+	var keys []string
+	for _, cell := range cells {
+		key, rNibErr := common.ValidateAndBuildNrCellIdKey(cell.GetServedNrCellInformation().GetCellId())
+		if rNibErr != nil {
+			return nil, rNibErr
+		}
+		pciKey, rNibErr := common.ValidateAndBuildCellNamePciKey(inventoryName, cell.GetServedNrCellInformation().GetNrPci())
+		if rNibErr != nil {
+			return nil, rNibErr
+		}
+		keys = append(keys, key, pciKey)
+		if nrCgiKey, ok := buildNrCgiKey(cell); ok {
+			keys = append(keys, nrCgiKey)
+		}
+	}
+	return keys, nil
+}
+
+// Close the writer
+func Close() {
+	// Nothing to do
+}
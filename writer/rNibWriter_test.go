@@ -0,0 +1,377 @@
+//
+// Copyright 2026 AT&T Intellectual Property
+// Copyright 2026 Nokia
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//  This source code is part of the near-RT RIC (RAN Intelligent Controller)
+//  platform project (RICP).
+
+package writer
+
+import (
+	"encoding/json"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/common"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/entities"
+	"gerrit.o-ran-sc.org/r/ric-plt/nodeb-rnib.git/reader"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func initSdlSyncStorageMock() (w RNibWriter, sdlStorageMock *reader.MockSdlSyncStorage) {
+	sdlStorageMock = new(reader.MockSdlSyncStorage)
+	w = GetNewRNibWriter(sdlStorageMock)
+	return
+}
+
+func generateGnb(name string) *entities.NodebInfo {
+	cell := &entities.ServedNRCell{ServedNrCellInformation: &entities.ServedNRCellInformation{CellId: "cell1", NrPci: 3}}
+	return &entities.NodebInfo{
+		RanName:          name,
+		NodeType:         entities.Node_GNB,
+		ConnectionStatus: entities.ConnectionStatus_CONNECTED,
+		GlobalNbId:       &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"},
+		Configuration:    &entities.NodebInfo_Gnb{Gnb: &entities.Gnb{ServedNrCells: []*entities.ServedNRCell{cell}}},
+	}
+}
+
+func generateEnb(name string) *entities.NodebInfo {
+	cell := &entities.ServedCellInfo{CellId: "cell1", Pci: 1}
+	return &entities.NodebInfo{
+		RanName:          name,
+		NodeType:         entities.Node_ENB,
+		ConnectionStatus: entities.ConnectionStatus_CONNECTED,
+		GlobalNbId:       &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"},
+		Configuration:    &entities.NodebInfo_Enb{Enb: &entities.Enb{ServedCells: []*entities.ServedCellInfo{cell}}},
+	}
+}
+
+func expectNoSavedNodeb(sdlStorageMock *reader.MockSdlSyncStorage, name string) {
+	sdlStorageMock.On("Get", common.GetRNibNamespace(), []string{"RAN:" + name}).Return(map[string]interface{}{}, nil)
+}
+
+func toStringMap(pairs []interface{}) map[string]interface{} {
+	data := map[string]interface{}{}
+	for i := 0; i < len(pairs); i += 2 {
+		data[pairs[i].(string)] = string(pairs[i+1].([]byte))
+	}
+	return data
+}
+
+func TestSaveGnb(t *testing.T) {
+	name := "name"
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	nb := generateGnb(name)
+	nbData, err := proto.Marshal(nb)
+	if err != nil {
+		t.Errorf("#rNibWriter_test.TestSaveGnb - Failed to marshal NodeB entity. Error: %v", err)
+	}
+	cellEntity := entities.Cell{Type: entities.Cell_NR_CELL, Cell: &entities.Cell_ServedNrCell{ServedNrCell: nb.GetGnb().GetServedNrCells()[0]}}
+	cellData, err := proto.Marshal(&cellEntity)
+	if err != nil {
+		t.Errorf("#rNibWriter_test.TestSaveGnb - Failed to marshal Cell entity. Error: %v", err)
+	}
+	setExpected := []interface{}{"RAN:name", nbData, "GNB:02f829:4a952a0a", nbData, "NRCELL:cell1", cellData, "PCI:name:03", cellData}
+	channelsAndEvents := []string{common.RanManipulationMessageChannel, "name_ADDED"}
+	expectNoSavedNodeb(sdlStorageMock, "name")
+	sdlStorageMock.On("SetAndPublish", common.GetRNibNamespace(), channelsAndEvents, setExpected).Return(nil)
+	rNibErr := w.SaveNodeb(nb)
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestSaveEnb(t *testing.T) {
+	name := "name"
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	nb := generateEnb(name)
+	nbData, err := proto.Marshal(nb)
+	if err != nil {
+		t.Errorf("#rNibWriter_test.TestSaveEnb - Failed to marshal NodeB entity. Error: %v", err)
+	}
+	cellEntity := entities.Cell{Type: entities.Cell_LTE_CELL, Cell: &entities.Cell_ServedCellInfo{ServedCellInfo: nb.GetEnb().GetServedCells()[0]}}
+	cellData, err := proto.Marshal(&cellEntity)
+	if err != nil {
+		t.Errorf("#rNibWriter_test.TestSaveEnb - Failed to marshal Cell entity. Error: %v", err)
+	}
+	setExpected := []interface{}{"RAN:name", nbData, "ENB:02f829:4a952a0a", nbData, "CELL:cell1", cellData, "PCI:name:01", cellData}
+	channelsAndEvents := []string{common.RanManipulationMessageChannel, "name_ADDED"}
+	expectNoSavedNodeb(sdlStorageMock, "name")
+	sdlStorageMock.On("SetAndPublish", common.GetRNibNamespace(), channelsAndEvents, setExpected).Return(nil)
+	rNibErr := w.SaveNodeb(nb)
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestSaveNodebEmptyNameFailure(t *testing.T) {
+	w, _ := initSdlSyncStorageMock()
+	rNibErr := w.SaveNodeb(generateGnb(""))
+	assert.IsType(t, &common.ValidationError{}, rNibErr)
+}
+
+func TestSaveNodebEmptyCellIdFailure(t *testing.T) {
+	w, _ := initSdlSyncStorageMock()
+	nb := generateEnb("name")
+	nb.GetEnb().GetServedCells()[0].CellId = ""
+	rNibErr := w.SaveNodeb(nb)
+	assert.IsType(t, &common.ValidationError{}, rNibErr)
+}
+
+func TestSaveNodebSdlFailure(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	expectedErr := errors.New("expected error")
+	expectNoSavedNodeb(sdlStorageMock, "name")
+	sdlStorageMock.On("SetAndPublish", common.GetRNibNamespace(), mock.Anything, mock.Anything).Return(expectedErr)
+	rNibErr := w.SaveNodeb(generateGnb("name"))
+	assert.IsType(t, &common.InternalError{}, rNibErr)
+	assert.EqualValues(t, expectedErr.Error(), rNibErr.Error())
+}
+
+func TestUpdateNodebInfoPublishesUpdatedEvent(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	channelsAndEvents := []string{common.RanManipulationMessageChannel, "name_UPDATED"}
+	expectNoSavedNodeb(sdlStorageMock, "name")
+	sdlStorageMock.On("SetAndPublish", common.GetRNibNamespace(), channelsAndEvents, mock.Anything).Return(nil)
+	rNibErr := w.UpdateNodebInfo(generateGnb("name"))
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestUpdateNodebConnectionStatusDisconnected(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	nb := generateGnb("name")
+	nb.ConnectionStatus = entities.ConnectionStatus_DISCONNECTED
+	channelsAndEvents := []string{common.StateChangeMessageChannel, "name_DISCONNECTED"}
+	sdlStorageMock.On("SetAndPublish", common.GetRNibNamespace(), channelsAndEvents, mock.Anything).Return(nil)
+	rNibErr := w.UpdateNodebConnectionStatus(nb)
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestUpdateNodebConnectionStatusWithoutEvent(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	nb := generateGnb("name")
+	nb.ConnectionStatus = entities.ConnectionStatus_SHUTTING_DOWN
+	sdlStorageMock.On("Set", common.GetRNibNamespace(), mock.Anything).Return(nil)
+	rNibErr := w.UpdateNodebConnectionStatus(nb)
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestRemoveNodeb(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	keys := []string{"RAN:name", "GNB:02f829:4a952a0a", "NRCELL:cell1", "PCI:name:03"}
+	channelsAndEvents := []string{common.RanManipulationMessageChannel, "name_DELETED"}
+	sdlStorageMock.On("RemoveAndPublish", common.GetRNibNamespace(), channelsAndEvents, keys).Return(nil)
+	rNibErr := w.RemoveNodeb(generateGnb("name"))
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestRemoveServedCells(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	nb := generateEnb("name")
+	sdlStorageMock.On("Remove", common.GetRNibNamespace(), []string{"CELL:cell1", "PCI:name:01"}).Return(nil)
+	rNibErr := w.RemoveServedCells("name", nb.GetEnb().GetServedCells())
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestRemoveServedNrCellsSdlFailure(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	nb := generateGnb("name")
+	sdlStorageMock.On("Remove", common.GetRNibNamespace(), []string{"NRCELL:cell1", "PCI:name:03"}).Return(errors.New("expected error"))
+	rNibErr := w.RemoveServedNrCells("name", nb.GetGnb().GetServedNrCells())
+	assert.IsType(t, &common.InternalError{}, rNibErr)
+}
+
+func TestAddNbIdentity(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	nbIdentity := &entities.NbIdentity{InventoryName: "name", GlobalNbId: &entities.GlobalNbId{PlmnId: "02f829", NbId: "4a952a0a"}}
+	data, err := proto.Marshal(nbIdentity)
+	if err != nil {
+		t.Errorf("#rNibWriter_test.TestAddNbIdentity - Failed to marshal NbIdentity entity. Error: %v", err)
+	}
+	sdlStorageMock.On("AddMember", common.GetRNibNamespace(), entities.Node_GNB.String(), []interface{}{data}).Return(nil)
+	rNibErr := w.AddNbIdentity(entities.Node_GNB, nbIdentity)
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestUpdateNbIdentity(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	oldNbIdentity := &entities.NbIdentity{InventoryName: "name", ConnectionStatus: entities.ConnectionStatus_CONNECTED}
+	newNbIdentity := &entities.NbIdentity{InventoryName: "name", ConnectionStatus: entities.ConnectionStatus_DISCONNECTED}
+	oldData, _ := proto.Marshal(oldNbIdentity)
+	newData, _ := proto.Marshal(newNbIdentity)
+	sdlStorageMock.On("RemoveMember", common.GetRNibNamespace(), entities.Node_ENB.String(), []interface{}{oldData}).Return(nil)
+	sdlStorageMock.On("AddMember", common.GetRNibNamespace(), entities.Node_ENB.String(), []interface{}{newData}).Return(nil)
+	rNibErr := w.UpdateNbIdentity(entities.Node_ENB, oldNbIdentity, newNbIdentity)
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestRemoveNbIdentitySdlFailure(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	sdlStorageMock.On("RemoveMember", common.GetRNibNamespace(), entities.Node_ENB.String(), mock.Anything).Return(errors.New("expected error"))
+	rNibErr := w.RemoveNbIdentity(entities.Node_ENB, &entities.NbIdentity{InventoryName: "name"})
+	assert.IsType(t, &common.InternalError{}, rNibErr)
+}
+
+func TestSaveRanLoadInformation(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	loadInfo := &entities.RanLoadInformation{LoadTimestamp: 1}
+	data, err := proto.Marshal(loadInfo)
+	if err != nil {
+		t.Errorf("#rNibWriter_test.TestSaveRanLoadInformation - Failed to marshal RanLoadInformation entity. Error: %v", err)
+	}
+	sdlStorageMock.On("Set", common.GetRNibNamespace(), []interface{}{"LOAD:name", data}).Return(nil)
+	rNibErr := w.SaveRanLoadInformation("name", loadInfo)
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestSaveRanLoadInformationEmptyNameFailure(t *testing.T) {
+	w, _ := initSdlSyncStorageMock()
+	rNibErr := w.SaveRanLoadInformation("", &entities.RanLoadInformation{})
+	assert.IsType(t, &common.ValidationError{}, rNibErr)
+}
+
+func TestRemoveRanLoadInformation(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	sdlStorageMock.On("Remove", common.GetRNibNamespace(), []string{"LOAD:name"}).Return(nil)
+	rNibErr := w.RemoveRanLoadInformation("name")
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestSaveE2TInstance(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	e2tInstance := entities.NewE2TInstance("10.0.2.15:3213", "pod")
+	data, err := json.Marshal(e2tInstance)
+	if err != nil {
+		t.Errorf("#rNibWriter_test.TestSaveE2TInstance - Failed to marshal E2TInstance entity. Error: %v", err)
+	}
+	sdlStorageMock.On("Set", common.GetRNibNamespace(), []interface{}{"E2TInstance:10.0.2.15:3213", data}).Return(nil)
+	rNibErr := w.SaveE2TInstance(e2tInstance)
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestSaveE2TInstanceEmptyAddressFailure(t *testing.T) {
+	w, _ := initSdlSyncStorageMock()
+	rNibErr := w.SaveE2TInstance(&entities.E2TInstance{})
+	assert.IsType(t, &common.ValidationError{}, rNibErr)
+}
+
+func TestRemoveE2TInstance(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	sdlStorageMock.On("Remove", common.GetRNibNamespace(), []string{"E2TInstance:10.0.2.15:3213"}).Return(nil)
+	rNibErr := w.RemoveE2TInstance("10.0.2.15:3213")
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestSaveE2TAddresses(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	sdlStorageMock.On("Set", common.GetRNibNamespace(), []interface{}{E2TAddressesKey, []byte(`["10.0.2.15:3213"]`)}).Return(nil)
+	rNibErr := w.SaveE2TAddresses([]string{"10.0.2.15:3213"})
+	assert.Nil(t, rNibErr)
+	sdlStorageMock.AssertExpectations(t)
+}
+
+func TestSaveGeneralConfigurationSdlFailure(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	expectedErr := errors.New("expected error")
+	sdlStorageMock.On("Set", common.GetRNibNamespace(), []interface{}{"GENERAL", []byte(`{"enableRic":true}`)}).Return(expectedErr)
+	rNibErr := w.SaveGeneralConfiguration(&entities.GeneralConfiguration{EnableRic: true})
+	assert.IsType(t, &common.InternalError{}, rNibErr)
+}
+
+func TestSavedNodebReadableByReader(t *testing.T) {
+	w, sdlStorageMock := initSdlSyncStorageMock()
+	nb := generateGnb("name")
+	var saved map[string]interface{}
+	expectNoSavedNodeb(sdlStorageMock, "name")
+	sdlStorageMock.On("SetAndPublish", common.GetRNibNamespace(), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		saved = toStringMap(args.Get(2).([]interface{}))
+	}).Return(nil)
+	rNibErr := w.SaveNodeb(nb)
+	assert.Nil(t, rNibErr)
+
+	readerStorageMock := new(reader.MockSdlSyncStorage)
+	r := reader.GetNewRNibReader(readerStorageMock)
+	readerStorageMock.On("Get", common.GetRNibNamespace(), mock.Anything).Return(saved, nil)
+	getNb, err := r.GetNodeb("name")
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(nb, getNb))
+	getNb, err = r.GetNodebByGlobalNbId(entities.Node_GNB, nb.GetGlobalNbId(), "", "")
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(nb, getNb))
+	cell, err := r.GetCell("name", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "cell1", cell.GetServedNrCell().GetServedNrCellInformation().GetCellId())
+	cell, err = r.GetCellById(entities.Cell_NR_CELL, "cell1")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), cell.GetServedNrCell().GetServedNrCellInformation().GetNrPci())
+}
+
+func TestWriterReaderRoundTripInMemory(t *testing.T) {
+	storage := common.NewInMemorySdlSyncStorage()
+	w := GetNewRNibWriter(storage)
+	r := reader.GetNewRNibReader(storage)
+	nb := generateEnb("name")
+	nbIdentity := &entities.NbIdentity{InventoryName: "name", GlobalNbId: nb.GetGlobalNbId()}
+
+	assert.Nil(t, w.SaveNodeb(nb))
+	assert.Nil(t, w.AddNbIdentity(entities.Node_ENB, nbIdentity))
+	assert.Nil(t, w.SaveRanLoadInformation("name", &entities.RanLoadInformation{LoadTimestamp: 1}))
+	assert.Nil(t, w.SaveE2TInstance(entities.NewE2TInstance("10.0.2.15:3213", "pod")))
+	assert.Nil(t, w.SaveE2TAddresses([]string{"10.0.2.15:3213"}))
+	assert.Nil(t, w.SaveGeneralConfiguration(&entities.GeneralConfiguration{EnableRic: true}))
+
+	getNb, err := r.GetNodeb("name")
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(nb, getNb))
+	cell, err := r.GetCellById(entities.Cell_LTE_CELL, "cell1")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), cell.GetServedCellInfo().GetPci())
+	ids, err := r.GetListEnbIds()
+	assert.Nil(t, err)
+	assert.Len(t, ids, 1)
+	assert.True(t, proto.Equal(nbIdentity, ids[0]))
+	loadInfo, err := r.GetRanLoadInformation("name")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), loadInfo.GetLoadTimestamp())
+	e2tInstance, err := r.GetE2TInstance("10.0.2.15:3213")
+	assert.Nil(t, err)
+	assert.Equal(t, "pod", e2tInstance.PodName)
+	addresses, err := r.GetE2TAddresses()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"10.0.2.15:3213"}, addresses)
+	config, err := r.GetGeneralConfiguration()
+	assert.Nil(t, err)
+	assert.True(t, config.EnableRic)
+
+	assert.Nil(t, w.RemoveNodeb(nb))
+	assert.Nil(t, w.RemoveNbIdentity(entities.Node_ENB, nbIdentity))
+	_, err = r.GetNodeb("name")
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+	_, err = r.GetCell("name", 1)
+	assert.IsType(t, &common.ResourceNotFoundError{}, err)
+	ids, err = r.GetListEnbIds()
+	assert.Nil(t, err)
+	assert.Empty(t, ids)
+}